@@ -1,22 +1,53 @@
 package alphavantage
 
 import (
+	"github.com/jay9909/alphavantage/api"
 	"github.com/jay9909/alphavantage/net"
+	"time"
 )
 
-//go:generate go run cmd/apigen/main.go
+//go:generate go run ./cmd/apigen -fetch -spec-out spec/alphavantage.json -openapi-out spec/openapi.json
 
 type Alphavantage struct {
 	client *net.Client
+
+	ttl    time.Duration // Overrides the client's TTLPolicy if hasTTL
+	hasTTL bool
 }
 
-func New(apiKey string, rateLimit int, dayCap int) *Alphavantage {
+// New returns a client for the given API key and limits.  Options such as net.WithCache configure the underlying
+// net.Client.
+func New(apiKey string, rateLimit int, dayCap int, options ...net.Option) *Alphavantage {
 	this := &Alphavantage{
-		client: net.NewClient(apiKey, rateLimit, dayCap),
+		client: net.NewClient(apiKey, rateLimit, dayCap, options...),
 	}
 	return this
 }
 
+// WithTTL returns a view of av whose requests only use cached responses fetched less than ttl ago, instead of
+// following the client's TTLPolicy, e.g. av.WithTTL(time.Hour).GetGlobalQuote("IBM", "").  A ttl of 0 or less
+// always sends the request.  The view shares av's client, so closing either closes both.
+func (av *Alphavantage) WithTTL(ttl time.Duration) *Alphavantage {
+	view := *av
+	view.ttl = ttl
+	view.hasTTL = true
+	return &view
+}
+
 func (av *Alphavantage) Close() {
 	av.client.Close()
 }
+
+// query validates the request parameters and, if they pass, sends the request.  Invalid requests are answered
+// with an api.ValidationError without being queued, so they do not spend any of the API quota.
+func (av *Alphavantage) query(function string, params map[string]string) api.Response {
+	err := validate(function, params)
+	if err != nil {
+		return api.Response{Error: err}
+	}
+
+	if av.hasTTL {
+		return av.client.QueryWithTTL(function, params, av.ttl)
+	}
+	return av.client.Query(function, params)
+}
@@ -0,0 +1,68 @@
+package alphavantage
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jay9909/alphavantage/net"
+)
+
+// stubTransport answers every request with an empty JSON object, recording the query strings it was sent.
+type stubTransport struct {
+	mux     sync.Mutex
+	queries []string
+}
+
+func (s *stubTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.queries = append(s.queries, request.URL.RawQuery)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    request,
+	}, nil
+}
+
+func (s *stubTransport) sent() []string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return append([]string(nil), s.queries...)
+}
+
+func newStubClient(t *testing.T) (*Alphavantage, *stubTransport) {
+	transport := &stubTransport{}
+	av := New("demo", 0, 0, net.WithBaseURL("http://avtest/query"), net.WithTransport(transport))
+	t.Cleanup(av.Close)
+	return av, transport
+}
+
+func TestTypedQueryPassesValidation(t *testing.T) {
+	av, transport := newStubClient(t)
+
+	response := av.QueryBbands(BbandsParams{
+		Symbol:     "IBM",
+		Interval:   IntervalDaily,
+		TimePeriod: 20,
+		SeriesType: SeriesClose,
+		Nbdevup:    3,
+		Nbdevdn:    2,
+		Matype:     MATypeEMA,
+	})
+	if response.Error != nil {
+		t.Fatalf("QueryBbands error = %v", response.Error)
+	}
+	_ = response.Response.Body.Close()
+
+	sent := transport.sent()
+	want := "function=BBANDS&interval=daily&matype=1&nbdevdn=2&nbdevup=3&series_type=close&symbol=IBM&time_period=20"
+	if len(sent) != 1 || !strings.HasPrefix(sent[0], want) {
+		t.Errorf("sent %q, want one query starting with %q", sent, want)
+	}
+}
@@ -5,21 +5,45 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 const freeApiLimitReached = `{
     "Note": "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute and 500 calls per day. Please visit https://www.alphavantage.co/premium/ if you would like to target a higher API call frequency."
 }`
 
+// StaleWarning is the Warning header of a cached response served after it expired, as HTTP caches mark them.
+const StaleWarning = `110 - "Response is Stale"`
+
 type Response struct {
 	Error    error
 	Response *http.Response
 }
 
+// Age returns how long ago a response answered from the cache was fetched, to the second.  It is 0 for a response
+// that was just fetched.
+func (resp *Response) Age() time.Duration {
+	if resp.Response == nil {
+		return 0
+	}
+	seconds, err := strconv.ParseInt(resp.Response.Header.Get("Age"), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// IsStale reports whether a response was answered from the cache after it expired, which a client configured with
+// net.StaleWhileRevalidate or net.Offline does rather than wait for a fresh one.
+func (resp *Response) IsStale() bool {
+	return resp.Response != nil && resp.Response.Header.Get("Warning") == StaleWarning
+}
+
 // GetJson populates the provided reference with a decoded JSON response.
 func (resp *Response) GetJson(result interface{}) error {
 	if resp.Error != nil {
-		return fmt.Errorf("response represents an error and cannot be parsed: %v", resp.Error)
+		return fmt.Errorf("response represents an error and cannot be parsed: %w", resp.Error)
 	}
 
 	body, err := io.ReadAll(resp.Response.Body)
@@ -28,7 +52,7 @@ func (resp *Response) GetJson(result interface{}) error {
 	}
 
 	if freeApiLimitReached == string(body) {
-		return fmt.Errorf("exceeded free api rate limit: %v", body)
+		return fmt.Errorf("exceeded free api rate limit: %s", body)
 	}
 
 	err = json.Unmarshal(body, &result)
@@ -43,7 +67,7 @@ func (resp *Response) GetJson(result interface{}) error {
 // GetCsv returns the text body of the response with no modifications.
 func (resp *Response) GetCsv() (string, error) {
 	if resp.Error != nil {
-		return "", fmt.Errorf("response represents an error and cannot be parsed: %v", resp.Error)
+		return "", fmt.Errorf("response represents an error and cannot be parsed: %w", resp.Error)
 	}
 
 	body, err := io.ReadAll(resp.Response.Body)
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// EarningsEvent is one row of the EARNINGS_CALENDAR response.
+type EarningsEvent struct {
+	Symbol           string
+	Name             string
+	ReportDate       time.Time
+	FiscalDateEnding time.Time
+	Estimate         *float64 // Consensus EPS estimate; nil if analysts have not published one
+	Currency         string
+}
+
+// IPOEvent is one row of the IPO_CALENDAR response.
+type IPOEvent struct {
+	Symbol         string
+	Name           string
+	IPODate        time.Time
+	PriceRangeLow  *float64 // nil if the offering price has not been announced
+	PriceRangeHigh *float64
+	Currency       string
+	Exchange       string
+}
+
+// GetEarningsEvents decodes every row of an EARNINGS_CALENDAR response.
+func (resp *Response) GetEarningsEvents() ([]EarningsEvent, error) {
+	table, err := newCsvTable(resp, "symbol", "name", "reportDate", "fiscalDateEnding", "estimate", "currency")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = table.close() }()
+
+	var events []EarningsEvent
+	for {
+		err = table.next()
+		if err == io.EOF {
+			return events, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("could not read earnings calendar row: %w", err)
+		}
+
+		event := EarningsEvent{
+			Symbol:   table.field("symbol"),
+			Name:     table.field("name"),
+			Currency: table.field("currency"),
+		}
+
+		event.ReportDate, err = parseDate(table.field("reportDate"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid reportDate for %v on line %d: %w", event.Symbol, table.line(), err)
+		}
+		event.FiscalDateEnding, err = parseDate(table.field("fiscalDateEnding"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid fiscalDateEnding for %v on line %d: %w", event.Symbol, table.line(), err)
+		}
+		event.Estimate, err = parseOptionalFloat(table.field("estimate"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid estimate for %v on line %d: %w", event.Symbol, table.line(), err)
+		}
+
+		events = append(events, event)
+	}
+}
+
+// GetIPOEvents decodes every row of an IPO_CALENDAR response.
+func (resp *Response) GetIPOEvents() ([]IPOEvent, error) {
+	table, err := newCsvTable(resp, "symbol", "name", "ipoDate", "priceRangeLow", "priceRangeHigh", "currency",
+		"exchange")
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = table.close() }()
+
+	var events []IPOEvent
+	for {
+		err = table.next()
+		if err == io.EOF {
+			return events, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("could not read IPO calendar row: %w", err)
+		}
+
+		event := IPOEvent{
+			Symbol:   table.field("symbol"),
+			Name:     table.field("name"),
+			Currency: table.field("currency"),
+			Exchange: table.field("exchange"),
+		}
+
+		event.IPODate, err = parseDate(table.field("ipoDate"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ipoDate for %v on line %d: %w", event.Symbol, table.line(), err)
+		}
+		event.PriceRangeLow, err = parseOptionalFloat(table.field("priceRangeLow"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid priceRangeLow for %v on line %d: %w", event.Symbol, table.line(), err)
+		}
+		event.PriceRangeHigh, err = parseOptionalFloat(table.field("priceRangeHigh"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid priceRangeHigh for %v on line %d: %w", event.Symbol, table.line(), err)
+		}
+
+		events = append(events, event)
+	}
+}
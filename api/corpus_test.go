@@ -0,0 +1,476 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The corpus in testdata/responses holds response bodies as the service sends them, one directory per category of
+// the documentation, plus errors for the messages it answers with instead of data.  When a format changes, add a
+// fixture showing the new one and a case below: every fixture must be decoded by a typed decoder, unless it is one
+// of the rawFixtures.
+const corpus = "testdata/responses"
+
+// rawFixtures are the formats the library has no typed decoder for yet.  Their cases only check that GetJson or
+// GetCsv parse them and that the odd values are where they are expected, so that a typed decoder written for one of
+// them has a fixture to start from.
+var rawFixtures = map[string]bool{
+	"time_series/daily_adjusted.json":    true,
+	"time_series/intraday.csv":           true,
+	"forex/exchange_rate.json":           true,
+	"forex/fx_weekly.csv":                true,
+	"crypto/digital_currency_daily.json": true,
+	"crypto/digital_currency_daily.csv":  true,
+	"fundamentals/overview.json":         true,
+	"fundamentals/income_statement.json": true,
+	"fundamentals/earnings.json":         true,
+	"economic/treasury_yield.json":       true,
+	"economic/federal_funds_rate.csv":    true,
+	"commodities/natural_gas.json":       true,
+	"indicators/sma.json":                true,
+	"indicators/macd.csv":                true,
+	"intelligence/news_sentiment.json":   true,
+}
+
+// decoders decode a response with each of the typed decoders, and with GetJson and GetCsv for the responses that have
+// none.
+var decoders = map[string]func(*Response) (any, error){
+	"GlobalQuote":    func(r *Response) (any, error) { return r.GetGlobalQuote() },
+	"SymbolMatches":  func(r *Response) (any, error) { return r.GetSymbolMatches() },
+	"MarketStatus":   func(r *Response) (any, error) { return r.GetMarketStatus() },
+	"Listings":       func(r *Response) (any, error) { return r.GetListings() },
+	"ListingReader":  readListings,
+	"EarningsEvents": func(r *Response) (any, error) { return r.GetEarningsEvents() },
+	"IPOEvents":      func(r *Response) (any, error) { return r.GetIPOEvents() },
+	"Json": func(r *Response) (any, error) {
+		var body map[string]any
+		err := r.GetJson(&body)
+		return body, err
+	},
+	"Csv": func(r *Response) (any, error) {
+		text, err := r.GetCsv()
+		if err != nil {
+			return nil, err
+		}
+		return csv.NewReader(strings.NewReader(text)).ReadAll()
+	},
+}
+
+// typedDecoders are the decoders that understand the shape of a response rather than only its syntax.
+var typedDecoders = []string{"GlobalQuote", "SymbolMatches", "MarketStatus", "Listings", "ListingReader",
+	"EarningsEvents", "IPOEvents"}
+
+func readListings(r *Response) (any, error) {
+	reader, err := r.GetListingReader()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = reader.Close() }()
+
+	var listings []Listing
+	for {
+		listing, err := reader.Next()
+		if err == io.EOF {
+			return listings, nil
+		} else if err != nil {
+			return nil, err
+		}
+		listings = append(listings, listing)
+	}
+}
+
+type corpusCase struct {
+	fixture string
+	decoder string
+	wantErr string // Part of the error expected, if any
+	check   func(t *testing.T, decoded any)
+}
+
+var corpusCases = []corpusCase{
+	// Time series
+	{fixture: "time_series/global_quote.json", decoder: "GlobalQuote", check: func(t *testing.T, decoded any) {
+		quote := decoded.(GlobalQuote)
+		want := GlobalQuote{Symbol: "IBM", Open: 129.2, High: 129.91, Low: 128.36, Price: 128.97, Volume: 3298632,
+			LatestTradingDay: date("2023-05-19"), PreviousClose: 129.21, Change: -0.24, ChangePercent: -0.1857}
+		if quote != want {
+			t.Errorf("quote = %+v, want %+v", quote, want)
+		}
+	}},
+	// Unknown symbols are answered with an empty quote rather than an error message.
+	{fixture: "time_series/global_quote_unknown_symbol.json", decoder: "GlobalQuote",
+		wantErr: "did not contain a quote"},
+	{fixture: "time_series/global_quote.csv", decoder: "GlobalQuote", wantErr: "could not parse JSON"},
+	{fixture: "time_series/global_quote.csv", decoder: "Csv", check: csvHeader("symbol", "open", "high", "low",
+		"price", "volume", "latestDay", "previousClose", "change", "changePercent")},
+	{fixture: "time_series/symbol_search.json", decoder: "SymbolMatches", check: func(t *testing.T, decoded any) {
+		matches := decoded.([]SymbolMatch)
+		if len(matches) != 3 {
+			t.Fatalf("%d matches, want 3", len(matches))
+		}
+		want := SymbolMatch{Symbol: "TSCO.LON", Name: "Tesco PLC", Type: "Equity", Region: "United Kingdom",
+			MarketOpen: "08:00", MarketClose: "16:30", Timezone: "UTC+01", Currency: "GBX", MatchScore: 0.7273}
+		if matches[0] != want {
+			t.Errorf("first match = %+v, want %+v", matches[0], want)
+		}
+	}},
+	{fixture: "time_series/symbol_search_no_matches.json", decoder: "SymbolMatches",
+		check: func(t *testing.T, decoded any) {
+			if matches := decoded.([]SymbolMatch); len(matches) != 0 {
+				t.Errorf("matches = %+v, want none", matches)
+			}
+		}},
+	{fixture: "time_series/market_status.json", decoder: "MarketStatus", check: func(t *testing.T, decoded any) {
+		status := decoded.(MarketStatus)
+		if len(status.Markets) != 4 {
+			t.Fatalf("%d markets, want 4", len(status.Markets))
+		}
+		china := status.Markets[1]
+		if china.Location == nil || china.Location.String() != "Asia/Shanghai" || china.LocalClose.Hour() != 15 {
+			t.Errorf("Mainland China market = %+v", china)
+		}
+		// Forex has no trading hours, so whether it is open is as reported.
+		forex := status.Markets[2]
+		if !forex.LocalOpen.IsZero() || !forex.IsOpen(time.Date(2023, 5, 20, 12, 0, 0, 0, time.UTC)) {
+			t.Errorf("forex market = %+v, want no hours and open", forex)
+		}
+	}},
+	{fixture: "time_series/daily_adjusted.json", decoder: "Json", check: func(t *testing.T, decoded any) {
+		day := lookup(t, decoded, "Time Series (Daily)", "2023-05-09")
+		if day["7. dividend amount"] != "1.6600" || day["8. split coefficient"] != "1.0" {
+			t.Errorf("dividend day = %v", day)
+		}
+	}},
+	{fixture: "time_series/intraday.csv", decoder: "Csv", check: csvHeader("timestamp", "open", "high", "low",
+		"close", "volume")},
+	// A CSV body given to a JSON decoder, as when datatype=csv was asked for by mistake.
+	{fixture: "time_series/intraday.csv", decoder: "Json", wantErr: "could not parse JSON"},
+
+	// Forex
+	{fixture: "forex/exchange_rate.json", decoder: "Json", check: func(t *testing.T, decoded any) {
+		rate := lookup(t, decoded, "Realtime Currency Exchange Rate")
+		if rate["5. Exchange Rate"] != "137.98000000" || rate["8. Bid Price"] != "137.97500000" {
+			t.Errorf("exchange rate = %v", rate)
+		}
+	}},
+	{fixture: "forex/fx_weekly.csv", decoder: "Csv", check: csvHeader("timestamp", "open", "high", "low", "close")},
+
+	// Crypto: every price is given in the market currency and in USD.  There is no typed decoder yet.
+	{fixture: "crypto/digital_currency_daily.json", decoder: "Json", check: func(t *testing.T, decoded any) {
+		day := lookup(t, decoded, "Time Series (Digital Currency Daily)", "2023-05-20")
+		for _, key := range []string{"1a. open (CNY)", "1b. open (USD)", "4a. close (CNY)", "4b. close (USD)",
+			"5. volume", "6. market cap (USD)"} {
+			if _, found := day[key]; !found {
+				t.Errorf("no %q in %v", key, day)
+			}
+		}
+	}},
+	{fixture: "crypto/digital_currency_daily.csv", decoder: "Csv", check: csvHeader("timestamp", "open (CNY)",
+		"high (CNY)", "low (CNY)", "close (CNY)", "open (USD)", "high (USD)", "low (USD)", "close (USD)", "volume",
+		"market cap (USD)")},
+
+	// Fundamentals: "None" where a figure is not reported, and "-" now and then.  Only the listings and calendars
+	// have typed decoders.
+	{fixture: "fundamentals/overview.json", decoder: "Json", check: func(t *testing.T, decoded any) {
+		overview := lookup(t, decoded)
+		if overview["PERatio"] != "None" || overview["DividendDate"] != "None" || overview["TrailingPE"] != "-" {
+			t.Errorf("overview = %v", overview)
+		}
+	}},
+	{fixture: "fundamentals/income_statement.json", decoder: "Json", check: func(t *testing.T, decoded any) {
+		quarter := lookup(t, decoded, "quarterlyReports", "0")
+		if quarter["depreciation"] != "None" || quarter["netIncome"] != "927000000" {
+			t.Errorf("quarterly report = %v", quarter)
+		}
+	}},
+	{fixture: "fundamentals/earnings.json", decoder: "Json", check: func(t *testing.T, decoded any) {
+		quarter := lookup(t, decoded, "quarterlyEarnings", "1")
+		if quarter["estimatedEPS"] != "None" || quarter["surprisePercentage"] != "None" {
+			t.Errorf("quarter without an estimate = %v", quarter)
+		}
+	}},
+	{fixture: "fundamentals/listing_status.csv", decoder: "Listings", check: func(t *testing.T, decoded any) {
+		listings := decoded.([]Listing)
+		if len(listings) != 3 {
+			t.Fatalf("%d listings, want 3", len(listings))
+		}
+		if etf := listings[1]; etf.AssetType != "ETF" || etf.Name != "AXS FIRST PRIORITY CLO BOND ETF" ||
+			!etf.DelistingDate.IsZero() {
+			t.Errorf("ETF listing = %+v", etf)
+		}
+		if units := listings[2]; units.Symbol != "AAC-U" || !strings.Contains(units.Name, "A & 1/5 War") {
+			t.Errorf("listing with an & in its name = %+v", units)
+		}
+	}},
+	{fixture: "fundamentals/listing_status_delisted.csv", decoder: "ListingReader",
+		check: func(t *testing.T, decoded any) {
+			listings := decoded.([]Listing)
+			if len(listings) != 2 || listings[0].DelistingDate != date("2002-05-13") || listings[0].Status != "Delisted" {
+				t.Errorf("delisted listings = %+v", listings)
+			}
+			if !listings[1].IPODate.IsZero() {
+				t.Errorf("listing without an IPO date = %+v", listings[1])
+			}
+		}},
+	{fixture: "fundamentals/listing_status.csv", decoder: "EarningsEvents", wantErr: `missing the "reportDate" column`},
+	{fixture: "fundamentals/earnings_calendar.csv", decoder: "EarningsEvents", check: func(t *testing.T, decoded any) {
+		events := decoded.([]EarningsEvent)
+		if len(events) != 3 || events[0].Estimate == nil || *events[0].Estimate != 1.27 {
+			t.Fatalf("earnings events = %+v", events)
+		}
+		if events[1].Estimate != nil || events[2].Currency != "GBP" || events[2].ReportDate != date("2023-06-15") {
+			t.Errorf("earnings events = %+v", events)
+		}
+	}},
+	{fixture: "fundamentals/ipo_calendar.csv", decoder: "IPOEvents", check: func(t *testing.T, decoded any) {
+		events := decoded.([]IPOEvent)
+		if len(events) != 3 || events[0].PriceRangeLow == nil || *events[0].PriceRangeHigh != 19 {
+			t.Fatalf("IPO events = %+v", events)
+		}
+		// Some offerings not yet priced are given a range of 0 rather than none, and are decoded as given.
+		if events[1].PriceRangeLow == nil || *events[1].PriceRangeLow != 0 {
+			t.Errorf("IPO with a zero price range = %+v", events[1])
+		}
+		if events[2].PriceRangeLow != nil || events[2].PriceRangeHigh != nil {
+			t.Errorf("IPO without a price range = %+v", events[2])
+		}
+	}},
+	{fixture: "fundamentals/ipo_calendar_empty.csv", decoder: "IPOEvents", check: func(t *testing.T, decoded any) {
+		if events := decoded.([]IPOEvent); len(events) != 0 {
+			t.Errorf("IPO events = %+v, want none", events)
+		}
+	}},
+
+	// Economic indicators and commodities: "." where the source has no value, e.g. on holidays, which isNull
+	// recognizes for the typed decoder they are still waiting for.
+	{fixture: "economic/treasury_yield.json", decoder: "Json", check: func(t *testing.T, decoded any) {
+		holiday := lookup(t, decoded, "data", "1")
+		if holiday["date"] != "2023-05-29" || holiday["value"] != "." {
+			t.Errorf("holiday = %v", holiday)
+		}
+	}},
+	{fixture: "economic/federal_funds_rate.csv", decoder: "Csv", check: func(t *testing.T, decoded any) {
+		rows := decoded.([][]string)
+		if len(rows) != 3 || rows[1][1] != "." {
+			t.Errorf("rows = %v", rows)
+		}
+	}},
+	{fixture: "commodities/natural_gas.json", decoder: "Json", check: func(t *testing.T, decoded any) {
+		if unit := lookup(t, decoded)["unit"]; unit != "dollars per million BTU" {
+			t.Errorf("unit = %v", unit)
+		}
+	}},
+
+	// Technical indicators
+	{fixture: "indicators/sma.json", decoder: "Json", check: func(t *testing.T, decoded any) {
+		// Unlike the time series, the metadata keys are numbered with colons, and the time period is a number.
+		meta := lookup(t, decoded, "Meta Data")
+		if meta["5: Time Period"] != 10.0 {
+			t.Errorf("metadata = %v", meta)
+		}
+		if week := lookup(t, decoded, "Technical Analysis: SMA", "2023-05-19"); week["SMA"] != "125.2390" {
+			t.Errorf("SMA = %v", week)
+		}
+	}},
+	{fixture: "indicators/macd.csv", decoder: "Csv", check: csvHeader("time", "MACD", "MACD_Hist", "MACD_Signal")},
+
+	// Alpha Intelligence
+	{fixture: "intelligence/news_sentiment.json", decoder: "Json", check: func(t *testing.T, decoded any) {
+		article := lookup(t, decoded, "feed", "0")
+		if article["overall_sentiment_score"] != 0.174261 || article["title"] != "Apple & Microsoft Lead the Cloud Race" {
+			t.Errorf("article = %v", article)
+		}
+	}},
+
+	// The throttle note is recognized by GetJson itself.
+	{fixture: "errors/throttle_note.json", decoder: "Json", wantErr: "exceeded free api rate limit"},
+	{fixture: "errors/invalid_call.json", decoder: "Json", check: func(t *testing.T, decoded any) {
+		if _, found := lookup(t, decoded)["Error Message"]; !found {
+			t.Errorf("body = %v", decoded)
+		}
+	}},
+}
+
+func TestCorpus(t *testing.T) {
+	for _, test := range corpusCases {
+		t.Run(test.fixture+"/"+test.decoder, func(t *testing.T) {
+			decoded, err := decoders[test.decoder](fixtureResponse(t, test.fixture))
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("error = %v, want one containing %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.check(t, decoded)
+		})
+	}
+}
+
+// TestCorpusErrorBodies checks that every typed decoder turns each message the service answers with instead of data
+// into an error that carries the message.
+func TestCorpusErrorBodies(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join(corpus, "errors", "*.json"))
+	if err != nil || len(fixtures) == 0 {
+		t.Fatalf("no error bodies found: %v", err)
+	}
+
+	for _, fixture := range fixtures {
+		fixture, _ = filepath.Rel(corpus, fixture)
+		message := fixtureMessage(t, fixture)
+		for _, decoder := range typedDecoders {
+			t.Run(fixture+"/"+decoder, func(t *testing.T) {
+				_, err := decoders[decoder](fixtureResponse(t, fixture))
+				if err == nil || !strings.Contains(err.Error(), message) {
+					t.Errorf("error = %v, want one containing %q", err, message)
+				}
+			})
+		}
+	}
+}
+
+// TestCorpusCoverage fails for fixtures no typed decoder decodes, other than the rawFixtures, so that a fixture added
+// for a format change is tested.
+func TestCorpusCoverage(t *testing.T) {
+	typed := map[string]bool{}
+	for _, decoder := range typedDecoders {
+		typed[decoder] = true
+	}
+
+	covered := map[string]bool{} // Whether each fixture with a case is decoded by a typed decoder
+	for _, test := range corpusCases {
+		if _, found := decoders[test.decoder]; !found {
+			t.Errorf("case for %v uses unknown decoder %q", test.fixture, test.decoder)
+		}
+		covered[test.fixture] = covered[test.fixture] || typed[test.decoder]
+	}
+	for fixture := range rawFixtures {
+		if covered[fixture] {
+			t.Errorf("%v is decoded by a typed decoder; remove it from rawFixtures", fixture)
+		}
+	}
+
+	err := filepath.WalkDir(corpus, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		fixture, _ := filepath.Rel(corpus, path)
+		fixture = filepath.ToSlash(fixture)
+		_, hasCase := covered[fixture]
+		switch {
+		case strings.HasPrefix(fixture, "errors/"):
+		case !hasCase:
+			t.Errorf("no case decodes %v", fixture)
+		case !covered[fixture] && !rawFixtures[fixture]:
+			t.Errorf("no typed decoder decodes %v", fixture)
+		}
+		delete(covered, fixture)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for fixture := range covered {
+		t.Errorf("case for missing fixture %v", fixture)
+	}
+}
+
+// fixtureResponse returns a response with the body of the fixture at path, relative to the corpus.
+func fixtureResponse(t *testing.T, path string) *Response {
+	t.Helper()
+	body, err := os.Open(filepath.Join(corpus, path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = body.Close() })
+
+	contentType := "application/json"
+	if strings.HasSuffix(path, ".csv") {
+		contentType = "application/x-download"
+	}
+	return &Response{Response: &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{contentType}},
+		Body:       body,
+	}}
+}
+
+// fixtureMessage returns the message of an error body.
+func fixtureMessage(t *testing.T, path string) string {
+	t.Helper()
+	contents, err := os.ReadFile(filepath.Join(corpus, path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw serviceMessage
+	err = json.Unmarshal(contents, &raw)
+	if err != nil {
+		t.Fatalf("%v: %v", path, err)
+	}
+	for _, message := range []string{raw.ErrorMessage, raw.Information, raw.Note} {
+		if message != "" {
+			return message
+		}
+	}
+	t.Fatalf("%v has no message", path)
+	return ""
+}
+
+// lookup walks decoded JSON down the given object keys or array indexes, and returns the object found there.
+func lookup(t *testing.T, decoded any, path ...string) map[string]any {
+	t.Helper()
+	value := decoded
+	for _, step := range path {
+		switch node := value.(type) {
+		case map[string]any:
+			value = node[step]
+		case []any:
+			index, err := strconv.Atoi(step)
+			if err != nil || index >= len(node) {
+				t.Fatalf("no %v in %v", step, path)
+			}
+			value = node[index]
+		}
+	}
+	object, ok := value.(map[string]any)
+	if !ok {
+		t.Fatalf("%v is %T, not an object", path, value)
+	}
+	return object
+}
+
+// csvHeader checks the header row of a decoded CSV body.
+func csvHeader(columns ...string) func(t *testing.T, decoded any) {
+	return func(t *testing.T, decoded any) {
+		rows := decoded.([][]string)
+		if len(rows) < 2 {
+			t.Fatalf("%d rows, want a header and data", len(rows))
+		}
+		if strings.Join(rows[0], ",") != strings.Join(columns, ",") {
+			t.Errorf("header = %v, want %v", rows[0], columns)
+		}
+		for _, row := range rows[1:] {
+			if len(row) != len(columns) {
+				t.Errorf("row %v has %d fields, want %d", row, len(row), len(columns))
+			}
+		}
+	}
+}
+
+func date(value string) time.Time {
+	parsed, err := time.Parse(DateLayout, value)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateLayout is the format Alpha Vantage uses for calendar dates such as IPO and delisting dates.
+const DateLayout = "2006-01-02"
+
+// csvTable reads a CSV response body row by row, giving access to fields by their header name.
+type csvTable struct {
+	body    io.ReadCloser
+	reader  *csv.Reader
+	columns map[string]int
+	row     []string
+}
+
+// newCsvTable prepares the response body for reading and checks that the header row contains all the required
+// columns.  The body is closed if an error is returned.
+func newCsvTable(resp *Response, required ...string) (*csvTable, error) {
+	if resp.Error != nil {
+		return nil, fmt.Errorf("response represents an error and cannot be parsed: %w", resp.Error)
+	}
+
+	body := resp.Response.Body
+	buffered := bufio.NewReader(body)
+
+	// A failed CSV request is answered with a JSON object (e.g. `{"Information": "..."}`) rather than CSV.
+	start, _ := buffered.Peek(64)
+	if bytes.HasPrefix(bytes.TrimSpace(start), []byte("{")) {
+		message, err := io.ReadAll(buffered)
+		_ = body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not read response body: %w", err)
+		}
+		return nil, fmt.Errorf("expected CSV response but received: %v", strings.TrimSpace(string(message)))
+	}
+
+	reader := csv.NewReader(buffered)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		_ = body.Close()
+		if err == io.EOF {
+			return nil, fmt.Errorf("CSV response was empty")
+		}
+		return nil, fmt.Errorf("could not read CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range required {
+		if _, ok := columns[name]; !ok {
+			_ = body.Close()
+			return nil, fmt.Errorf("CSV response is missing the %q column: %v", name, header)
+		}
+	}
+
+	return &csvTable{
+		body:    body,
+		reader:  reader,
+		columns: columns,
+	}, nil
+}
+
+// next advances to the following row.  It returns io.EOF once every row has been read.
+func (t *csvTable) next() error {
+	row, err := t.reader.Read()
+	if err != nil {
+		return err
+	}
+	t.row = row
+	return nil
+}
+
+// field returns the value of the named column in the current row, or "" if the row has no such column.
+func (t *csvTable) field(name string) string {
+	i, ok := t.columns[name]
+	if !ok || i >= len(t.row) {
+		return ""
+	}
+	return strings.TrimSpace(t.row[i])
+}
+
+// line returns the line number of the current row, for use in error messages.
+func (t *csvTable) line() int {
+	line, _ := t.reader.FieldPos(0)
+	return line
+}
+
+func (t *csvTable) close() error {
+	return t.body.Close()
+}
+
+// isNull reports whether value is one of the placeholders Alpha Vantage uses for missing data, including the "." of
+// economic indicators on days without a value.
+func isNull(value string) bool {
+	switch value {
+	case "", "null", "None", "-", ".":
+		return true
+	}
+	return false
+}
+
+// parseDate parses a YYYY-MM-DD date.  Missing values are returned as the zero time.
+func parseDate(value string) (time.Time, error) {
+	if isNull(value) {
+		return time.Time{}, nil
+	}
+	return time.Parse(DateLayout, value)
+}
+
+// parseFloat parses a decimal number, which may carry a trailing percent sign.  Missing values are returned as zero.
+func parseFloat(value string) (float64, error) {
+	if isNull(value) {
+		return 0, nil
+	}
+	return strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+}
+
+// parseOptionalFloat parses a decimal number.  Missing values are returned as nil.
+func parseOptionalFloat(value string) (*float64, error) {
+	if isNull(value) {
+		return nil, nil
+	}
+	number, err := parseFloat(value)
+	if err != nil {
+		return nil, err
+	}
+	return &number, nil
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsNull(t *testing.T) {
+	for _, value := range []string{"", "null", "None", "-", "."} {
+		if !isNull(value) {
+			t.Errorf("isNull(%q) = false", value)
+		}
+	}
+	for _, value := range []string{"0", "0.", ".5", "none", "NULL", "N/A", "2023-05-19"} {
+		if isNull(value) {
+			t.Errorf("isNull(%q) = true", value)
+		}
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"2023-05-19", time.Date(2023, 5, 19, 0, 0, 0, 0, time.UTC), false},
+		{"null", time.Time{}, false},
+		{"", time.Time{}, false},
+		{"2023-5-19", time.Time{}, true},
+		{"2023-05-19 16:00:00", time.Time{}, true},
+	}
+
+	for _, test := range tests {
+		got, err := parseDate(test.value)
+		if (err != nil) != test.wantErr || !got.Equal(test.want) {
+			t.Errorf("parseDate(%q) = %v, %v, want %v and error %v", test.value, got, err, test.want, test.wantErr)
+		}
+	}
+}
+
+func TestParseOptionalFloat(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    *float64
+		wantErr bool
+	}{
+		{"1.27", float(1.27), false},
+		{"0", float(0), false},
+		{"-0.1857%", float(-0.1857), false},
+		{"", nil, false},
+		{"None", nil, false},
+		{"-", nil, false},
+		{".", nil, false},
+		{"1,27", nil, true},
+	}
+
+	for _, test := range tests {
+		got, err := parseOptionalFloat(test.value)
+		if (err != nil) != test.wantErr {
+			t.Errorf("parseOptionalFloat(%q) error = %v, want error %v", test.value, err, test.wantErr)
+			continue
+		}
+		if (got == nil) != (test.want == nil) || (got != nil && *got != *test.want) {
+			t.Errorf("parseOptionalFloat(%q) = %v, want %v", test.value, deref(got), deref(test.want))
+		}
+	}
+}
+
+func float(value float64) *float64 {
+	return &value
+}
+
+func deref(value *float64) any {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// Listing is one row of the LISTING_STATUS response.
+type Listing struct {
+	Symbol        string
+	Name          string
+	Exchange      string
+	AssetType     string    // "Stock" or "ETF"
+	IPODate       time.Time // Zero if unknown
+	DelistingDate time.Time // Zero for assets that are still active
+	Status        string    // "Active" or "Delisted"
+}
+
+var listingColumns = []string{"symbol", "name", "exchange", "assetType", "ipoDate", "delistingDate", "status"}
+
+// ListingReader decodes a LISTING_STATUS response one row at a time.  The full listing runs to several megabytes,
+// so prefer this over GetListings when the rows can be processed as they arrive.
+type ListingReader struct {
+	table *csvTable
+}
+
+// GetListingReader returns a reader over the rows of a LISTING_STATUS response.  The caller must Close it.
+func (resp *Response) GetListingReader() (*ListingReader, error) {
+	table, err := newCsvTable(resp, listingColumns...)
+	if err != nil {
+		return nil, err
+	}
+	return &ListingReader{table: table}, nil
+}
+
+// Next returns the next listing.  It returns io.EOF once every row has been read.
+func (r *ListingReader) Next() (Listing, error) {
+	var listing Listing
+
+	err := r.table.next()
+	if err != nil {
+		if err == io.EOF {
+			return listing, err
+		}
+		return listing, fmt.Errorf("could not read listing row: %w", err)
+	}
+
+	listing.Symbol = r.table.field("symbol")
+	listing.Name = r.table.field("name")
+	listing.Exchange = r.table.field("exchange")
+	listing.AssetType = r.table.field("assetType")
+	listing.Status = r.table.field("status")
+
+	listing.IPODate, err = parseDate(r.table.field("ipoDate"))
+	if err != nil {
+		return listing, fmt.Errorf("invalid ipoDate for %v on line %d: %w", listing.Symbol, r.table.line(), err)
+	}
+	listing.DelistingDate, err = parseDate(r.table.field("delistingDate"))
+	if err != nil {
+		return listing, fmt.Errorf("invalid delistingDate for %v on line %d: %w", listing.Symbol, r.table.line(), err)
+	}
+
+	return listing, nil
+}
+
+// Close releases the underlying response body.
+func (r *ListingReader) Close() error {
+	return r.table.close()
+}
+
+// GetListings decodes every row of a LISTING_STATUS response.
+func (resp *Response) GetListings() ([]Listing, error) {
+	reader, err := resp.GetListingReader()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = reader.Close() }()
+
+	var listings []Listing
+	for {
+		listing, err := reader.Next()
+		if err == io.EOF {
+			return listings, nil
+		} else if err != nil {
+			return nil, err
+		}
+		listings = append(listings, listing)
+	}
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// closeRecorder is a response body that records whether it was closed.
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (b *closeRecorder) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestListingReaderStreams(t *testing.T) {
+	// The body is only written as it is read, so rows must be decoded before the rest of the listing arrives.
+	bodyReader, bodyWriter := io.Pipe()
+	body := &closeRecorder{Reader: bodyReader}
+	go func() {
+		_, _ = io.WriteString(bodyWriter, "symbol,name,exchange,assetType,ipoDate,delistingDate,status\r\n"+
+			"A,Agilent Technologies Inc,NYSE,Stock,1999-11-18,null,Active\r\n")
+	}()
+
+	response := &Response{Response: &http.Response{StatusCode: http.StatusOK, Body: body}}
+	reader, err := response.GetListingReader()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listing, err := reader.Next()
+	if err != nil || listing.Symbol != "A" || listing.IPODate != date("1999-11-18") || !listing.DelistingDate.IsZero() {
+		t.Fatalf("first listing = %+v, %v", listing, err)
+	}
+
+	go func() {
+		_, _ = io.WriteString(bodyWriter, "AAB,ABERDEEN ASIA PACIFIC INCOME FUND INC,NYSE MKT,Stock,,2004-05-26,Delisted\r\n")
+		_ = bodyWriter.Close()
+	}()
+	listing, err = reader.Next()
+	if err != nil || listing.Symbol != "AAB" || listing.Status != "Delisted" {
+		t.Fatalf("second listing = %+v, %v", listing, err)
+	}
+	if _, err = reader.Next(); err != io.EOF {
+		t.Errorf("error after the last row = %v, want io.EOF", err)
+	}
+
+	if body.closed {
+		t.Error("the body was closed before Close")
+	}
+	if err = reader.Close(); err != nil || !body.closed {
+		t.Errorf("Close() = %v, body closed %v", err, body.closed)
+	}
+}
+
+func TestListingReaderErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{"missing column", "symbol,name,exchange,assetType,ipoDate,status\r\n", `missing the "delistingDate" column`},
+		{"empty", "", "CSV response was empty"},
+		{"message", `{"Information": "The **demo** API key is for demo purposes only."}`, "expected CSV response"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body := &closeRecorder{Reader: strings.NewReader(test.body)}
+			response := &Response{Response: &http.Response{StatusCode: http.StatusOK, Body: body}}
+
+			_, err := response.GetListingReader()
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("error = %v, want one containing %q", err, test.wantErr)
+			}
+			if !body.closed {
+				t.Error("the body of a failed response was not closed")
+			}
+		})
+	}
+
+	// A bad date fails that row only.
+	body := &closeRecorder{Reader: strings.NewReader("symbol,name,exchange,assetType,ipoDate,delistingDate,status\r\n" +
+		"A,Agilent,NYSE,Stock,18/11/1999,null,Active\r\n")}
+	reader, err := (&Response{Response: &http.Response{Body: body}}).GetListingReader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = reader.Close() }()
+	if _, err = reader.Next(); err == nil || !strings.Contains(err.Error(), "invalid ipoDate for A on line 2") {
+		t.Errorf("bad date error = %v", err)
+	}
+}
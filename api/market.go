@@ -0,0 +1,175 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+	_ "time/tzdata" // Market zones must resolve even on hosts without a zoneinfo database.
+)
+
+// marketZones maps the regions reported by MARKET_STATUS to their time zone.
+var marketZones = map[string]string{
+	"United States":  "America/New_York",
+	"Canada":         "America/Toronto",
+	"United Kingdom": "Europe/London",
+	"Germany":        "Europe/Berlin",
+	"France":         "Europe/Paris",
+	"Spain":          "Europe/Madrid",
+	"Portugal":       "Europe/Lisbon",
+	"Japan":          "Asia/Tokyo",
+	"India":          "Asia/Kolkata",
+	"Mainland China": "Asia/Shanghai",
+	"Hong Kong":      "Asia/Hong_Kong",
+	"Brazil":         "America/Sao_Paulo",
+	"Mexico":         "America/Mexico_City",
+	"South Africa":   "Africa/Johannesburg",
+	"Global":         "UTC",
+}
+
+// MarketStatus is the decoded MARKET_STATUS response.
+type MarketStatus struct {
+	Endpoint string
+	Markets  []Market
+}
+
+// Market is one trading venue listed by MARKET_STATUS.
+type Market struct {
+	MarketType       string // "Equity" or "Forex"
+	Region           string
+	PrimaryExchanges string
+	// Location is the venue's time zone.  It is nil for regions this package does not know, in which case
+	// LocalOpen and LocalClose are expressed in UTC.
+	Location *time.Location
+	// LocalOpen and LocalClose are the venue's trading hours on the day the response was sent, in Location.  They are
+	// zero for venues the service gives no hours for, such as forex, which trades around the clock.
+	LocalOpen     time.Time
+	LocalClose    time.Time
+	CurrentStatus string // "open" or "closed" at the time of the request
+	Notes         string
+}
+
+type rawMarketStatus struct {
+	serviceMessage
+	Endpoint string `json:"endpoint"`
+	Markets  []struct {
+		MarketType       string `json:"market_type"`
+		Region           string `json:"region"`
+		PrimaryExchanges string `json:"primary_exchanges"`
+		LocalOpen        string `json:"local_open"`
+		LocalClose       string `json:"local_close"`
+		CurrentStatus    string `json:"current_status"`
+		Notes            string `json:"notes"`
+	} `json:"markets"`
+}
+
+// GetMarketStatus decodes a MARKET_STATUS response.  Trading hours are placed on the day of the response's Date
+// header, which a cached response keeps, or on the current day if it has none.
+func (resp *Response) GetMarketStatus() (MarketStatus, error) {
+	now := time.Now()
+	if resp.Response != nil {
+		if date, err := http.ParseTime(resp.Response.Header.Get("Date")); err == nil {
+			now = date
+		}
+	}
+	return resp.GetMarketStatusAt(now)
+}
+
+// GetMarketStatusAt decodes a MARKET_STATUS response with trading hours placed on the calendar day of now in each
+// market's time zone.
+func (resp *Response) GetMarketStatusAt(now time.Time) (MarketStatus, error) {
+	var status MarketStatus
+	var raw rawMarketStatus
+
+	err := resp.GetJson(&raw)
+	if err != nil {
+		return status, err
+	}
+	if err = raw.err(); err != nil {
+		return status, err
+	}
+
+	status.Endpoint = raw.Endpoint
+	for _, rawMarket := range raw.Markets {
+		market := Market{
+			MarketType:       rawMarket.MarketType,
+			Region:           rawMarket.Region,
+			PrimaryExchanges: rawMarket.PrimaryExchanges,
+			CurrentStatus:    rawMarket.CurrentStatus,
+			Notes:            rawMarket.Notes,
+		}
+
+		if zone, ok := marketZones[market.Region]; ok {
+			market.Location, err = time.LoadLocation(zone)
+			if err != nil {
+				return status, fmt.Errorf("could not load time zone for %v: %w", market.Region, err)
+			}
+		}
+
+		market.LocalOpen, err = clockOn(now, rawMarket.LocalOpen, market.location())
+		if err != nil {
+			return status, fmt.Errorf("invalid local_open for %v: %w", market.Region, err)
+		}
+		market.LocalClose, err = clockOn(now, rawMarket.LocalClose, market.location())
+		if err != nil {
+			return status, fmt.Errorf("invalid local_close for %v: %w", market.Region, err)
+		}
+
+		status.Markets = append(status.Markets, market)
+	}
+
+	return status, nil
+}
+
+// IsOpen reports whether the market's regular session is in progress at now.  Sessions starting on a weekend are
+// treated as closed, so a session that runs past midnight on Friday is open early on Saturday.  Exchange holidays
+// are not known, so a holiday reports as open.  For markets in an unknown region IsOpen falls back to the
+// CurrentStatus reported by the service, as it does for markets without trading hours.
+func (m Market) IsOpen(now time.Time) bool {
+	if m.Location == nil || m.LocalOpen.IsZero() || m.LocalClose.IsZero() {
+		return strings.EqualFold(m.CurrentStatus, "open")
+	}
+
+	local := now.In(m.Location)
+	open := onDay(local, m.LocalOpen)
+	closing := onDay(local, m.LocalClose)
+
+	if !closing.After(open) {
+		// The session runs past midnight.  Early in the day, it is the one that started the day before.
+		if local.Before(closing) {
+			return isWeekday(local.AddDate(0, 0, -1))
+		}
+		return isWeekday(local) && !local.Before(open)
+	}
+	return isWeekday(local) && !local.Before(open) && local.Before(closing)
+}
+
+func isWeekday(day time.Time) bool {
+	weekday := day.Weekday()
+	return weekday != time.Saturday && weekday != time.Sunday
+}
+
+func (m Market) location() *time.Location {
+	if m.Location == nil {
+		return time.UTC
+	}
+	return m.Location
+}
+
+// clockOn parses an HH:MM wall clock time and places it on the same calendar day as day, in loc.  Missing times,
+// which the service gives as "N/A", are returned as the zero time.
+func clockOn(day time.Time, clock string, loc *time.Location) (time.Time, error) {
+	if isNull(clock) || clock == "N/A" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse("15:04", clock)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return onDay(day.In(loc), parsed), nil
+}
+
+// onDay returns the wall clock time of clock on the calendar day of day, in day's location.
+func onDay(day time.Time, clock time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, day.Location())
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarketIsOpen(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	at := func(loc *time.Location, day, hour, minute int) time.Time {
+		return time.Date(2023, 5, day, hour, minute, 0, 0, loc) // May 19, 2023 is a Friday
+	}
+	clock := func(hour, minute int) time.Time {
+		return time.Date(0, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	regular := Market{Location: newYork, LocalOpen: clock(9, 30), LocalClose: clock(16, 0), CurrentStatus: "closed"}
+	overnight := Market{Location: newYork, LocalOpen: clock(20, 0), LocalClose: clock(4, 0), CurrentStatus: "closed"}
+	noHours := Market{Location: newYork, CurrentStatus: "Open"}
+	unknownRegion := Market{LocalOpen: clock(9, 30), LocalClose: clock(16, 0), CurrentStatus: "closed"}
+
+	tests := []struct {
+		name   string
+		market Market
+		now    time.Time
+		want   bool
+	}{
+		{"before the open", regular, at(newYork, 19, 9, 29), false},
+		{"at the open", regular, at(newYork, 19, 9, 30), true},
+		{"before the close", regular, at(newYork, 19, 15, 59), true},
+		{"at the close", regular, at(newYork, 19, 16, 0), false},
+		{"in another zone", regular, at(tokyo, 20, 0, 0), true}, // 11 am Friday in New York
+		{"on Saturday", regular, at(newYork, 20, 12, 0), false},
+		{"on Sunday", regular, at(newYork, 21, 12, 0), false},
+
+		{"overnight before the open", overnight, at(newYork, 18, 19, 59), false},
+		{"overnight after the open", overnight, at(newYork, 18, 23, 0), true},
+		{"overnight after midnight", overnight, at(newYork, 19, 3, 59), true},
+		{"overnight after the close", overnight, at(newYork, 19, 4, 0), false},
+		{"overnight from Friday into Saturday", overnight, at(newYork, 20, 2, 0), true},
+		{"overnight on Saturday evening", overnight, at(newYork, 20, 21, 0), false},
+		{"overnight early on Monday", overnight, at(newYork, 22, 2, 0), false},
+		{"overnight on Monday evening", overnight, at(newYork, 22, 21, 0), true},
+
+		{"without hours", noHours, at(newYork, 20, 12, 0), true},
+		{"in an unknown region", unknownRegion, at(newYork, 19, 12, 0), false},
+	}
+
+	for _, test := range tests {
+		if got := test.market.IsOpen(test.now); got != test.want {
+			t.Errorf("%s: IsOpen(%v) = %v, want %v", test.name, test.now, got, test.want)
+		}
+	}
+}
+
+func TestGetMarketStatusDay(t *testing.T) {
+	body := `{"endpoint": "Global Market Open & Close Status", "markets": [{"market_type": "Equity",
+		"region": "Japan", "local_open": "09:00", "local_close": "15:00", "current_status": "open"}]}`
+	response := func(date string) *Response {
+		header := http.Header{}
+		if date != "" {
+			header.Set("Date", date)
+		}
+		return &Response{Response: &http.Response{Header: header, Body: io.NopCloser(strings.NewReader(body))}}
+	}
+
+	// Friday evening in UTC is already Saturday in Tokyo.
+	status, err := response("Fri, 19 May 2023 20:00:00 GMT").GetMarketStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	japan := status.Markets[0]
+	if open := japan.LocalOpen.Format(time.DateTime); open != "2023-05-20 09:00:00" {
+		t.Errorf("LocalOpen = %v, want on the day of the Date header in Tokyo", open)
+	}
+
+	at := time.Date(2023, 5, 22, 3, 0, 0, 0, time.UTC)
+	status, err = response("").GetMarketStatusAt(at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	japan = status.Markets[0]
+	if open := japan.LocalOpen.Format(time.DateTime); open != "2023-05-22 09:00:00" || !japan.IsOpen(at) {
+		t.Errorf("LocalOpen = %v, open %v, want on Monday and open", open, japan.IsOpen(at))
+	}
+
+	status, err = response("").GetMarketStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	today := time.Now().In(status.Markets[0].Location).Format(time.DateOnly)
+	if open := status.Markets[0].LocalOpen.Format(time.DateOnly); open != today {
+		t.Errorf("LocalOpen without a Date header is on %v, want today, %v", open, today)
+	}
+}
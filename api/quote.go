@@ -0,0 +1,174 @@
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// serviceMessage captures the informational keys Alpha Vantage returns in place of data when a request is
+// rejected or throttled.  Embed it in the raw form of a JSON response to detect those cases.
+type serviceMessage struct {
+	ErrorMessage string `json:"Error Message"`
+	Information  string `json:"Information"`
+	Note         string `json:"Note"`
+}
+
+// err returns the service's message as an error, or nil if there was none.
+func (m serviceMessage) err() error {
+	switch {
+	case m.ErrorMessage != "":
+		return fmt.Errorf("alphavantage error: %v", m.ErrorMessage)
+	case m.Information != "":
+		return fmt.Errorf("alphavantage information: %v", m.Information)
+	case m.Note != "":
+		return fmt.Errorf("alphavantage note: %v", m.Note)
+	}
+	return nil
+}
+
+// GlobalQuote is the decoded GLOBAL_QUOTE response.
+type GlobalQuote struct {
+	Symbol           string
+	Open             float64
+	High             float64
+	Low              float64
+	Price            float64
+	Volume           int64
+	LatestTradingDay time.Time
+	PreviousClose    float64
+	Change           float64
+	ChangePercent    float64 // In percent, so "1.23%" is decoded as 1.23
+}
+
+type rawGlobalQuote struct {
+	serviceMessage
+	Quote struct {
+		Symbol           string `json:"01. symbol"`
+		Open             string `json:"02. open"`
+		High             string `json:"03. high"`
+		Low              string `json:"04. low"`
+		Price            string `json:"05. price"`
+		Volume           string `json:"06. volume"`
+		LatestTradingDay string `json:"07. latest trading day"`
+		PreviousClose    string `json:"08. previous close"`
+		Change           string `json:"09. change"`
+		ChangePercent    string `json:"10. change percent"`
+	} `json:"Global Quote"`
+}
+
+// GetGlobalQuote decodes a JSON GLOBAL_QUOTE response.
+func (resp *Response) GetGlobalQuote() (GlobalQuote, error) {
+	var quote GlobalQuote
+	var raw rawGlobalQuote
+
+	err := resp.GetJson(&raw)
+	if err != nil {
+		return quote, err
+	}
+	if err = raw.err(); err != nil {
+		return quote, err
+	}
+	if raw.Quote.Symbol == "" {
+		return quote, fmt.Errorf("response did not contain a quote")
+	}
+
+	quote.Symbol = raw.Quote.Symbol
+	numbers := []struct {
+		name   string
+		value  string
+		target *float64
+	}{
+		{"open", raw.Quote.Open, &quote.Open},
+		{"high", raw.Quote.High, &quote.High},
+		{"low", raw.Quote.Low, &quote.Low},
+		{"price", raw.Quote.Price, &quote.Price},
+		{"previous close", raw.Quote.PreviousClose, &quote.PreviousClose},
+		{"change", raw.Quote.Change, &quote.Change},
+		{"change percent", raw.Quote.ChangePercent, &quote.ChangePercent},
+	}
+	for _, number := range numbers {
+		*number.target, err = parseFloat(number.value)
+		if err != nil {
+			return quote, fmt.Errorf("invalid %v for %v: %w", number.name, quote.Symbol, err)
+		}
+	}
+
+	if !isNull(raw.Quote.Volume) {
+		quote.Volume, err = strconv.ParseInt(raw.Quote.Volume, 10, 64)
+		if err != nil {
+			return quote, fmt.Errorf("invalid volume for %v: %w", quote.Symbol, err)
+		}
+	}
+
+	quote.LatestTradingDay, err = parseDate(raw.Quote.LatestTradingDay)
+	if err != nil {
+		return quote, fmt.Errorf("invalid latest trading day for %v: %w", quote.Symbol, err)
+	}
+
+	return quote, nil
+}
+
+// SymbolMatch is one of the best matches returned by SYMBOL_SEARCH.
+type SymbolMatch struct {
+	Symbol      string
+	Name        string
+	Type        string // e.g. "Equity", "ETF", "Mutual Fund"
+	Region      string
+	MarketOpen  string // Local HH:MM
+	MarketClose string // Local HH:MM
+	Timezone    string // UTC offset, e.g. "UTC-04"
+	Currency    string
+	MatchScore  float64 // Between 0 and 1, higher is better
+}
+
+type rawSymbolSearch struct {
+	serviceMessage
+	BestMatches []struct {
+		Symbol      string `json:"1. symbol"`
+		Name        string `json:"2. name"`
+		Type        string `json:"3. type"`
+		Region      string `json:"4. region"`
+		MarketOpen  string `json:"5. marketOpen"`
+		MarketClose string `json:"6. marketClose"`
+		Timezone    string `json:"7. timezone"`
+		Currency    string `json:"8. currency"`
+		MatchScore  string `json:"9. matchScore"`
+	} `json:"bestMatches"`
+}
+
+// GetSymbolMatches decodes a JSON SYMBOL_SEARCH response.
+func (resp *Response) GetSymbolMatches() ([]SymbolMatch, error) {
+	var raw rawSymbolSearch
+
+	err := resp.GetJson(&raw)
+	if err != nil {
+		return nil, err
+	}
+	if err = raw.err(); err != nil {
+		return nil, err
+	}
+
+	matches := make([]SymbolMatch, 0, len(raw.BestMatches))
+	for _, rawMatch := range raw.BestMatches {
+		match := SymbolMatch{
+			Symbol:      rawMatch.Symbol,
+			Name:        rawMatch.Name,
+			Type:        rawMatch.Type,
+			Region:      rawMatch.Region,
+			MarketOpen:  rawMatch.MarketOpen,
+			MarketClose: rawMatch.MarketClose,
+			Timezone:    rawMatch.Timezone,
+			Currency:    rawMatch.Currency,
+		}
+
+		match.MatchScore, err = parseFloat(rawMatch.MatchScore)
+		if err != nil {
+			return nil, fmt.Errorf("invalid match score for %v: %w", match.Symbol, err)
+		}
+
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
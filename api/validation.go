@@ -0,0 +1,19 @@
+package api
+
+import "fmt"
+
+// ValidationError reports a request parameter that was rejected on the client side, before the request spent
+// any of the API quota.
+type ValidationError struct {
+	Function string // Endpoint function, e.g. TIME_SERIES_DAILY
+	Param    string // Offending parameter name
+	Value    string // Offending value; empty for a missing required parameter
+	Reason   string // What the value should have been
+}
+
+func (e *ValidationError) Error() string {
+	if e.Value == "" {
+		return fmt.Sprintf("invalid %v request: parameter %v %v", e.Function, e.Param, e.Reason)
+	}
+	return fmt.Sprintf("invalid %v request: parameter %v=%q %v", e.Function, e.Param, e.Value, e.Reason)
+}
@@ -4,922 +4,2237 @@
 
 package alphavantage
 
-import "github.com/jay9909/alphavantage/api"
+import (
+	"github.com/jay9909/alphavantage/api"
+	"time"
+)
 
 // Endpoint Category: Commodities
 // https://www.alphavantage.co/documentation/#commodities
 //
-// APIs under this section provide historical data for major commodities such as crude oil, natural gas, copper, wheat, etc., spanning across various temporal horizons (daily, weekly, monthly, quarterly, etc.)
+// APIs under this section provide historical data for major commodities such as crude oil, natural
+// gas, copper, wheat, etc., spanning across various temporal horizons (daily, weekly, monthly,
+// quarterly, etc.)
 
 // Crude Oil Prices: West Texas Intermediate (WTI)
-// This API returns the West Texas Intermediate (WTI) crude oil prices in daily, weekly, and monthly horizons.
-// Source: U.S. Energy Information Administration, Crude Oil Prices: West Texas Intermediate (WTI) - Cushing, Oklahoma, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// This API returns the West Texas Intermediate (WTI) crude oil prices in daily, weekly, and monthly
+// horizons.
+//
+// Source: U.S. Energy Information Administration, Crude Oil Prices: West Texas Intermediate (WTI) -
+// Cushing, Oklahoma, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the
+// FRED® API but is not endorsed or certified by the Federal Reserve Bank of St. Louis. By using this
+// data feed, you agree to be bound by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#wti
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>daily</code>, <code>weekly</code>, and <code>monthly</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings daily, weekly, and monthly are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetWti(opt_interval, opt_datatype string) api.Response {
-	function := "WTI"
-	params := map[string]string{
+	return a.query("WTI", wtiParams(opt_interval, opt_datatype))
+}
+
+// WtiParams holds the parameters of [Alphavantage.QueryWti].
+// See [Alphavantage.GetWti] for their documentation.
+type WtiParams struct {
+	Interval Interval // Optional; one of monthly, daily, weekly; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryWti is the typed form of [Alphavantage.GetWti].
+func (a *Alphavantage) QueryWti(p WtiParams) api.Response {
+	return a.query("WTI", wtiParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// wtiParams maps the arguments of [Alphavantage.GetWti] onto their query parameters.
+func wtiParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Crude Oil Prices (Brent)
+//
 // This API returns the Brent (Europe) crude oil prices in daily, weekly, and monthly horizons.
-// Source: U.S. Energy Information Administration, Crude Oil Prices: Brent - Europe, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: U.S. Energy Information Administration, Crude Oil Prices: Brent - Europe, retrieved from
+// FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is not endorsed or
+// certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound
+// by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#brent
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>daily</code>, <code>weekly</code>, and <code>monthly</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings daily, weekly, and monthly are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetBrent(opt_interval, opt_datatype string) api.Response {
-	function := "BRENT"
-	params := map[string]string{
+	return a.query("BRENT", brentParams(opt_interval, opt_datatype))
+}
+
+// BrentParams holds the parameters of [Alphavantage.QueryBrent].
+// See [Alphavantage.GetBrent] for their documentation.
+type BrentParams struct {
+	Interval Interval // Optional; one of monthly, daily, weekly; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryBrent is the typed form of [Alphavantage.GetBrent].
+func (a *Alphavantage) QueryBrent(p BrentParams) api.Response {
+	return a.query("BRENT", brentParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// brentParams maps the arguments of [Alphavantage.GetBrent] onto their query parameters.
+func brentParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Natural Gas
+//
 // This API returns the Henry Hub natural gas spot prices in daily, weekly, and monthly horizons.
-// Source: U.S. Energy Information Administration, Henry Hub Natural Gas Spot Price, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: U.S. Energy Information Administration, Henry Hub Natural Gas Spot Price, retrieved from
+// FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is not endorsed or
+// certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound
+// by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#natural-gas
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>daily</code>, <code>weekly</code>, and <code>monthly</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings daily, weekly, and monthly are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetNaturalGas(opt_interval, opt_datatype string) api.Response {
-	function := "NATURAL_GAS"
-	params := map[string]string{
+	return a.query("NATURAL_GAS", naturalGasParams(opt_interval, opt_datatype))
+}
+
+// NaturalGasParams holds the parameters of [Alphavantage.QueryNaturalGas].
+// See [Alphavantage.GetNaturalGas] for their documentation.
+type NaturalGasParams struct {
+	Interval Interval // Optional; one of monthly, daily, weekly; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryNaturalGas is the typed form of [Alphavantage.GetNaturalGas].
+func (a *Alphavantage) QueryNaturalGas(p NaturalGasParams) api.Response {
+	return a.query("NATURAL_GAS", naturalGasParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// naturalGasParams maps the arguments of [Alphavantage.GetNaturalGas] onto their query parameters.
+func naturalGasParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Global Price of Copper
+//
 // This API returns the global price of copper in monthly, quarterly, and annual horizons.
-// Source: International Monetary Fund (<a href="https://www.imf.org/external/terms.htm" target="_blank">IMF Terms of Use</a>), Global price of Copper, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: International Monetary Fund ([IMF Terms of Use]), Global price of Copper, retrieved from
+// FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is not endorsed or
+// certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound
+// by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#copper
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>monthly</code>, <code>quarterly</code>, and <code>annual</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings monthly, quarterly, and annual are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [IMF Terms of Use]: https://www.imf.org/external/terms.htm
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetCopper(opt_interval, opt_datatype string) api.Response {
-	function := "COPPER"
-	params := map[string]string{
+	return a.query("COPPER", copperParams(opt_interval, opt_datatype))
+}
+
+// CopperParams holds the parameters of [Alphavantage.QueryCopper].
+// See [Alphavantage.GetCopper] for their documentation.
+type CopperParams struct {
+	Interval Interval // Optional; one of monthly, quarterly, annual; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryCopper is the typed form of [Alphavantage.GetCopper].
+func (a *Alphavantage) QueryCopper(p CopperParams) api.Response {
+	return a.query("COPPER", copperParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// copperParams maps the arguments of [Alphavantage.GetCopper] onto their query parameters.
+func copperParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Global Price of Aluminum
+//
 // This API returns the global price of aluminum in monthly, quarterly, and annual horizons.
-// Source: International Monetary Fund (<a href="https://www.imf.org/external/terms.htm" target="_blank">IMF Terms of Use</a>), Global price of Aluminum, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: International Monetary Fund ([IMF Terms of Use]), Global price of Aluminum, retrieved from
+// FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is not endorsed or
+// certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound
+// by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#aluminum
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>monthly</code>, <code>quarterly</code>, and <code>annual</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings monthly, quarterly, and annual are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [IMF Terms of Use]: https://www.imf.org/external/terms.htm
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetAluminum(opt_interval, opt_datatype string) api.Response {
-	function := "ALUMINUM"
-	params := map[string]string{
+	return a.query("ALUMINUM", aluminumParams(opt_interval, opt_datatype))
+}
+
+// AluminumParams holds the parameters of [Alphavantage.QueryAluminum].
+// See [Alphavantage.GetAluminum] for their documentation.
+type AluminumParams struct {
+	Interval Interval // Optional; one of monthly, quarterly, annual; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryAluminum is the typed form of [Alphavantage.GetAluminum].
+func (a *Alphavantage) QueryAluminum(p AluminumParams) api.Response {
+	return a.query("ALUMINUM", aluminumParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// aluminumParams maps the arguments of [Alphavantage.GetAluminum] onto their query parameters.
+func aluminumParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Global Price of Wheat
+//
 // This API returns the global price of wheat in monthly, quarterly, and annual horizons.
-// Source: International Monetary Fund (<a href="https://www.imf.org/external/terms.htm" target="_blank">IMF Terms of Use</a>), Global price of Wheat, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: International Monetary Fund ([IMF Terms of Use]), Global price of Wheat, retrieved from
+// FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is not endorsed or
+// certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound
+// by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#wheat
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>monthly</code>, <code>quarterly</code>, and <code>annual</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings monthly, quarterly, and annual are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [IMF Terms of Use]: https://www.imf.org/external/terms.htm
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetWheat(opt_interval, opt_datatype string) api.Response {
-	function := "WHEAT"
-	params := map[string]string{
+	return a.query("WHEAT", wheatParams(opt_interval, opt_datatype))
+}
+
+// WheatParams holds the parameters of [Alphavantage.QueryWheat].
+// See [Alphavantage.GetWheat] for their documentation.
+type WheatParams struct {
+	Interval Interval // Optional; one of monthly, quarterly, annual; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryWheat is the typed form of [Alphavantage.GetWheat].
+func (a *Alphavantage) QueryWheat(p WheatParams) api.Response {
+	return a.query("WHEAT", wheatParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// wheatParams maps the arguments of [Alphavantage.GetWheat] onto their query parameters.
+func wheatParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Global Price of Corn
+//
 // This API returns the global price of corn in monthly, quarterly, and annual horizons.
-// Source: International Monetary Fund (<a href="https://www.imf.org/external/terms.htm" target="_blank">IMF Terms of Use</a>), Global price of Corn, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: International Monetary Fund ([IMF Terms of Use]), Global price of Corn, retrieved from FRED,
+// Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is not endorsed or
+// certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound
+// by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#corn
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>monthly</code>, <code>quarterly</code>, and <code>annual</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings monthly, quarterly, and annual are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [IMF Terms of Use]: https://www.imf.org/external/terms.htm
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetCorn(opt_interval, opt_datatype string) api.Response {
-	function := "CORN"
-	params := map[string]string{
+	return a.query("CORN", cornParams(opt_interval, opt_datatype))
+}
+
+// CornParams holds the parameters of [Alphavantage.QueryCorn].
+// See [Alphavantage.GetCorn] for their documentation.
+type CornParams struct {
+	Interval Interval // Optional; one of monthly, quarterly, annual; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryCorn is the typed form of [Alphavantage.GetCorn].
+func (a *Alphavantage) QueryCorn(p CornParams) api.Response {
+	return a.query("CORN", cornParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// cornParams maps the arguments of [Alphavantage.GetCorn] onto their query parameters.
+func cornParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Global Price of Cotton
+//
 // This API returns the global price of cotton in monthly, quarterly, and annual horizons.
-// Source: International Monetary Fund (<a href="https://www.imf.org/external/terms.htm" target="_blank">IMF Terms of Use</a>), Global price of Cotton, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: International Monetary Fund ([IMF Terms of Use]), Global price of Cotton, retrieved from
+// FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is not endorsed or
+// certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound
+// by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#cotton
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>monthly</code>, <code>quarterly</code>, and <code>annual</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings monthly, quarterly, and annual are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [IMF Terms of Use]: https://www.imf.org/external/terms.htm
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetCotton(opt_interval, opt_datatype string) api.Response {
-	function := "COTTON"
-	params := map[string]string{
+	return a.query("COTTON", cottonParams(opt_interval, opt_datatype))
+}
+
+// CottonParams holds the parameters of [Alphavantage.QueryCotton].
+// See [Alphavantage.GetCotton] for their documentation.
+type CottonParams struct {
+	Interval Interval // Optional; one of monthly, quarterly, annual; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryCotton is the typed form of [Alphavantage.GetCotton].
+func (a *Alphavantage) QueryCotton(p CottonParams) api.Response {
+	return a.query("COTTON", cottonParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// cottonParams maps the arguments of [Alphavantage.GetCotton] onto their query parameters.
+func cottonParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Global Price of Sugar
+//
 // This API returns the global price of sugar in monthly, quarterly, and annual horizons.
-// Source: International Monetary Fund (<a href="https://www.imf.org/external/terms.htm" target="_blank">IMF Terms of Use</a>), Global price of Sugar, No. 11, World, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: International Monetary Fund ([IMF Terms of Use]), Global price of Sugar, No. 11, World,
+// retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is
+// not endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you
+// agree to be bound by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#sugar
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>monthly</code>, <code>quarterly</code>, and <code>annual</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings monthly, quarterly, and annual are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [IMF Terms of Use]: https://www.imf.org/external/terms.htm
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetSugar(opt_interval, opt_datatype string) api.Response {
-	function := "SUGAR"
-	params := map[string]string{
+	return a.query("SUGAR", sugarParams(opt_interval, opt_datatype))
+}
+
+// SugarParams holds the parameters of [Alphavantage.QuerySugar].
+// See [Alphavantage.GetSugar] for their documentation.
+type SugarParams struct {
+	Interval Interval // Optional; one of monthly, quarterly, annual; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QuerySugar is the typed form of [Alphavantage.GetSugar].
+func (a *Alphavantage) QuerySugar(p SugarParams) api.Response {
+	return a.query("SUGAR", sugarParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// sugarParams maps the arguments of [Alphavantage.GetSugar] onto their query parameters.
+func sugarParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Global Price of Coffee
+//
 // This API returns the global price of coffee in monthly, quarterly, and annual horizons.
-// Source: International Monetary Fund (<a href="https://www.imf.org/external/terms.htm" target="_blank">IMF Terms of Use</a>), Global price of Coffee, Other Mild Arabica, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: International Monetary Fund ([IMF Terms of Use]), Global price of Coffee, Other Mild
+// Arabica, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API
+// but is not endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed,
+// you agree to be bound by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#coffee
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>monthly</code>, <code>quarterly</code>, and <code>annual</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings monthly, quarterly, and annual are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [IMF Terms of Use]: https://www.imf.org/external/terms.htm
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetCoffee(opt_interval, opt_datatype string) api.Response {
-	function := "COFFEE"
-	params := map[string]string{
+	return a.query("COFFEE", coffeeParams(opt_interval, opt_datatype))
+}
+
+// CoffeeParams holds the parameters of [Alphavantage.QueryCoffee].
+// See [Alphavantage.GetCoffee] for their documentation.
+type CoffeeParams struct {
+	Interval Interval // Optional; one of monthly, quarterly, annual; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryCoffee is the typed form of [Alphavantage.GetCoffee].
+func (a *Alphavantage) QueryCoffee(p CoffeeParams) api.Response {
+	return a.query("COFFEE", coffeeParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// coffeeParams maps the arguments of [Alphavantage.GetCoffee] onto their query parameters.
+func coffeeParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Global Price Index of All Commodities
-// This API returns the global price index of all commodities in monthly, quarterly, and annual temporal dimensions.
-// Source: International Monetary Fund (<a href="https://www.imf.org/external/terms.htm" target="_blank">IMF Terms of Use</a>), Global Price Index of All Commodities, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// This API returns the global price index of all commodities in monthly, quarterly, and annual
+// temporal dimensions.
+//
+// Source: International Monetary Fund ([IMF Terms of Use]), Global Price Index of All Commodities,
+// retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is
+// not endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you
+// agree to be bound by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#all-commodities
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>monthly</code>, <code>quarterly</code>, and <code>annual</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings monthly, quarterly, and annual are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [IMF Terms of Use]: https://www.imf.org/external/terms.htm
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetAllCommodities(opt_interval, opt_datatype string) api.Response {
-	function := "ALL_COMMODITIES"
-	params := map[string]string{
+	return a.query("ALL_COMMODITIES", allCommoditiesParams(opt_interval, opt_datatype))
+}
+
+// AllCommoditiesParams holds the parameters of [Alphavantage.QueryAllCommodities].
+// See [Alphavantage.GetAllCommodities] for their documentation.
+type AllCommoditiesParams struct {
+	Interval Interval // Optional; one of monthly, quarterly, annual; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryAllCommodities is the typed form of [Alphavantage.GetAllCommodities].
+func (a *Alphavantage) QueryAllCommodities(p AllCommoditiesParams) api.Response {
+	return a.query("ALL_COMMODITIES", allCommoditiesParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// allCommoditiesParams maps the arguments of [Alphavantage.GetAllCommodities] onto their query parameters.
+func allCommoditiesParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Endpoint Category: Digital & Crypto Currencies
 // https://www.alphavantage.co/documentation/#digital-currency
 //
-// APIs under this section provide a wide range of data feed for digital and crypto currencies such as Bitcoin.
+// APIs under this section provide a wide range of data feed for digital and crypto currencies such as
+// Bitcoin.
 
 // [PREMIUM] CRYPTO_INTRADAY
-// This API returns intraday time series (timestamp, open, high, low, close, volume) of the cryptocurrency specified, updated realtime.
+//
+// This API returns intraday time series (timestamp, open, high, low, close, volume) of the
+// cryptocurrency specified, updated realtime.
+//
 // https://www.alphavantage.co/documentation/#crypto-intraday
 //
 // Parameters:
-// -	symbol: The digital/crypto currency of your choice. It can be any of the currencies in the <a href="https://www.alphavantage.co/digital_currency_list/" target="_blank"> digital currency list</a>. For example: <code>symbol=ETH</code>.
-// -	market: The exchange market of your choice. It can be any of the market in the <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> market list</a>. For example: <code>market=USD</code>.
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>
-// -	opt_outputsize: By default, <code>outputsize=compact</code>. Strings <code>compact</code> and <code>full</code> are accepted with the following specifications: <code>compact</code> returns only the latest 100 data points in the intraday time series; <code>full</code> returns the full-length intraday time series. The &#34;compact&#34; option is recommended if you would like to reduce the data size of each API call.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the intraday time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The digital/crypto currency of your choice. It can be any of the currencies in the
+//     [digital currency list]. For example: symbol=ETH.
+//   - market: The exchange market of your choice. It can be any of the market in the [market list].
+//     For example: market=USD.
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min
+//   - opt_outputsize: By default, outputsize=compact. Strings compact and full are accepted with the
+//     following specifications: compact returns only the latest 100 data points in the intraday time
+//     series; full returns the full-length intraday time series. The "compact" option is recommended
+//     if you would like to reduce the data size of each API call.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the intraday time series in JSON format; csv returns the time
+//     series as a CSV (comma separated value) file.
+//
+// [digital currency list]: https://www.alphavantage.co/digital_currency_list/
+// [market list]: https://www.alphavantage.co/physical_currency_list/
 func (a *Alphavantage) GetCryptoIntraday(symbol, market, interval, opt_outputsize, opt_datatype string) api.Response {
-	function := "CRYPTO_INTRADAY"
-	params := map[string]string{
+	return a.query("CRYPTO_INTRADAY", cryptoIntradayParams(symbol, market, interval, opt_outputsize, opt_datatype))
+}
+
+// CryptoIntradayParams holds the parameters of [Alphavantage.QueryCryptoIntraday].
+// See [Alphavantage.GetCryptoIntraday] for their documentation.
+type CryptoIntradayParams struct {
+	Symbol     string
+	Market     string
+	Interval   Interval   // One of 1min, 5min, 15min, 30min, 60min
+	Outputsize OutputSize // Optional; one of compact, full; default compact
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryCryptoIntraday is the typed form of [Alphavantage.GetCryptoIntraday].
+func (a *Alphavantage) QueryCryptoIntraday(p CryptoIntradayParams) api.Response {
+	return a.query("CRYPTO_INTRADAY", cryptoIntradayParams(
+		p.Symbol,
+		p.Market,
+		string(p.Interval),
+		string(p.Outputsize),
+		string(p.Datatype),
+	))
+}
+
+// cryptoIntradayParams maps the arguments of [Alphavantage.GetCryptoIntraday] onto their query parameters.
+func cryptoIntradayParams(symbol, market, interval, opt_outputsize, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":     symbol,
 		"market":     market,
 		"interval":   interval,
 		"outputsize": opt_outputsize,
 		"datatype":   opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // DIGITAL_CURRENCY_DAILY
-// This API returns the daily historical time series for a digital currency (e.g., BTC) traded on a specific market (e.g., CNY/Chinese Yuan), refreshed daily at midnight (UTC). Prices and volumes are quoted in both the market-specific currency and USD.
+//
+// This API returns the daily historical time series for a digital currency (e.g., BTC) traded on a
+// specific market (e.g., CNY/Chinese Yuan), refreshed daily at midnight (UTC). Prices and volumes are
+// quoted in both the market-specific currency and USD.
+//
 // https://www.alphavantage.co/documentation/#currency-daily
 //
 // Parameters:
-// -	symbol: The digital/crypto currency of your choice. It can be any of the currencies in the <a href="https://www.alphavantage.co/digital_currency_list/" target="_blank"> digital currency list</a>. For example: <code>symbol=BTC</code>.
-// -	market: The exchange market of your choice. It can be any of the market in the <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> market list</a>. For example: <code>market=CNY</code>.
+//   - symbol: The digital/crypto currency of your choice. It can be any of the currencies in the
+//     [digital currency list]. For example: symbol=BTC.
+//   - market: The exchange market of your choice. It can be any of the market in the [market list].
+//     For example: market=CNY.
+//
+// [digital currency list]: https://www.alphavantage.co/digital_currency_list/
+// [market list]: https://www.alphavantage.co/physical_currency_list/
 func (a *Alphavantage) GetDigitalCurrencyDaily(symbol, market string) api.Response {
-	function := "DIGITAL_CURRENCY_DAILY"
-	params := map[string]string{
+	return a.query("DIGITAL_CURRENCY_DAILY", digitalCurrencyDailyParams(symbol, market))
+}
+
+// DigitalCurrencyDailyParams holds the parameters of [Alphavantage.QueryDigitalCurrencyDaily].
+// See [Alphavantage.GetDigitalCurrencyDaily] for their documentation.
+type DigitalCurrencyDailyParams struct {
+	Symbol string
+	Market string
+}
+
+// QueryDigitalCurrencyDaily is the typed form of [Alphavantage.GetDigitalCurrencyDaily].
+func (a *Alphavantage) QueryDigitalCurrencyDaily(p DigitalCurrencyDailyParams) api.Response {
+	return a.query("DIGITAL_CURRENCY_DAILY", digitalCurrencyDailyParams(
+		p.Symbol,
+		p.Market,
+	))
+}
+
+// digitalCurrencyDailyParams maps the arguments of [Alphavantage.GetDigitalCurrencyDaily] onto their query parameters.
+func digitalCurrencyDailyParams(symbol, market string) map[string]string {
+	return map[string]string{
 		"symbol": symbol,
 		"market": market,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // DIGITAL_CURRENCY_WEEKLY
-// This API returns the weekly historical time series for a digital currency (e.g., BTC) traded on a specific market (e.g., CNY/Chinese Yuan), refreshed daily at midnight (UTC). Prices and volumes are quoted in both the market-specific currency and USD.
+//
+// This API returns the weekly historical time series for a digital currency (e.g., BTC) traded on a
+// specific market (e.g., CNY/Chinese Yuan), refreshed daily at midnight (UTC). Prices and volumes are
+// quoted in both the market-specific currency and USD.
+//
 // https://www.alphavantage.co/documentation/#currency-weekly
 //
 // Parameters:
-// -	symbol: The digital/crypto currency of your choice. It can be any of the currencies in the <a href="https://www.alphavantage.co/digital_currency_list/" target="_blank"> digital currency list</a>. For example: <code>symbol=BTC</code>.
-// -	market: The exchange market of your choice. It can be any of the market in the <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> market list</a>. For example: <code>market=CNY</code>.
+//   - symbol: The digital/crypto currency of your choice. It can be any of the currencies in the
+//     [digital currency list]. For example: symbol=BTC.
+//   - market: The exchange market of your choice. It can be any of the market in the [market list].
+//     For example: market=CNY.
+//
+// [digital currency list]: https://www.alphavantage.co/digital_currency_list/
+// [market list]: https://www.alphavantage.co/physical_currency_list/
 func (a *Alphavantage) GetDigitalCurrencyWeekly(symbol, market string) api.Response {
-	function := "DIGITAL_CURRENCY_WEEKLY"
-	params := map[string]string{
+	return a.query("DIGITAL_CURRENCY_WEEKLY", digitalCurrencyWeeklyParams(symbol, market))
+}
+
+// DigitalCurrencyWeeklyParams holds the parameters of [Alphavantage.QueryDigitalCurrencyWeekly].
+// See [Alphavantage.GetDigitalCurrencyWeekly] for their documentation.
+type DigitalCurrencyWeeklyParams struct {
+	Symbol string
+	Market string
+}
+
+// QueryDigitalCurrencyWeekly is the typed form of [Alphavantage.GetDigitalCurrencyWeekly].
+func (a *Alphavantage) QueryDigitalCurrencyWeekly(p DigitalCurrencyWeeklyParams) api.Response {
+	return a.query("DIGITAL_CURRENCY_WEEKLY", digitalCurrencyWeeklyParams(
+		p.Symbol,
+		p.Market,
+	))
+}
+
+// digitalCurrencyWeeklyParams maps the arguments of [Alphavantage.GetDigitalCurrencyWeekly] onto their query parameters.
+func digitalCurrencyWeeklyParams(symbol, market string) map[string]string {
+	return map[string]string{
 		"symbol": symbol,
 		"market": market,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // DIGITAL_CURRENCY_MONTHLY
-// This API returns the monthly historical time series for a digital currency (e.g., BTC) traded on a specific market (e.g., CNY/Chinese Yuan), refreshed daily at midnight (UTC). Prices and volumes are quoted in both the market-specific currency and USD.
+//
+// This API returns the monthly historical time series for a digital currency (e.g., BTC) traded on a
+// specific market (e.g., CNY/Chinese Yuan), refreshed daily at midnight (UTC). Prices and volumes are
+// quoted in both the market-specific currency and USD.
+//
 // https://www.alphavantage.co/documentation/#currency-monthly
 //
 // Parameters:
-// -	symbol: The digital/crypto currency of your choice. It can be any of the currencies in the <a href="https://www.alphavantage.co/digital_currency_list/" target="_blank"> digital currency list</a>. For example: <code>symbol=BTC</code>.
-// -	market: The exchange market of your choice. It can be any of the market in the <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> market list</a>. For example: <code>market=CNY</code>.
+//   - symbol: The digital/crypto currency of your choice. It can be any of the currencies in the
+//     [digital currency list]. For example: symbol=BTC.
+//   - market: The exchange market of your choice. It can be any of the market in the [market list].
+//     For example: market=CNY.
+//
+// [digital currency list]: https://www.alphavantage.co/digital_currency_list/
+// [market list]: https://www.alphavantage.co/physical_currency_list/
 func (a *Alphavantage) GetDigitalCurrencyMonthly(symbol, market string) api.Response {
-	function := "DIGITAL_CURRENCY_MONTHLY"
-	params := map[string]string{
+	return a.query("DIGITAL_CURRENCY_MONTHLY", digitalCurrencyMonthlyParams(symbol, market))
+}
+
+// DigitalCurrencyMonthlyParams holds the parameters of [Alphavantage.QueryDigitalCurrencyMonthly].
+// See [Alphavantage.GetDigitalCurrencyMonthly] for their documentation.
+type DigitalCurrencyMonthlyParams struct {
+	Symbol string
+	Market string
+}
+
+// QueryDigitalCurrencyMonthly is the typed form of [Alphavantage.GetDigitalCurrencyMonthly].
+func (a *Alphavantage) QueryDigitalCurrencyMonthly(p DigitalCurrencyMonthlyParams) api.Response {
+	return a.query("DIGITAL_CURRENCY_MONTHLY", digitalCurrencyMonthlyParams(
+		p.Symbol,
+		p.Market,
+	))
+}
+
+// digitalCurrencyMonthlyParams maps the arguments of [Alphavantage.GetDigitalCurrencyMonthly] onto their query parameters.
+func digitalCurrencyMonthlyParams(symbol, market string) map[string]string {
+	return map[string]string{
 		"symbol": symbol,
 		"market": market,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Endpoint Category: Economic Indicators
 // https://www.alphavantage.co/documentation/#economic-indicators
 //
-// APIs under this section provide key US economic indicators frequently used for investment strategy formulation and application development.
+// APIs under this section provide key US economic indicators frequently used for investment strategy
+// formulation and application development.
 
 // REAL_GDP
+//
 // This API returns the annual and quarterly Real GDP of the United States.
-// Source: U.S. Bureau of Economic Analysis, Real Gross Domestic Product, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: U.S. Bureau of Economic Analysis, Real Gross Domestic Product, retrieved from FRED, Federal
+// Reserve Bank of St. Louis. This data feed uses the FRED® API but is not endorsed or certified by
+// the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the
+// [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#real-gdp
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=annual</code>. Strings <code>quarterly</code> and <code>annual</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=annual. Strings quarterly and annual are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetRealGdp(opt_interval, opt_datatype string) api.Response {
-	function := "REAL_GDP"
-	params := map[string]string{
+	return a.query("REAL_GDP", realGdpParams(opt_interval, opt_datatype))
+}
+
+// RealGdpParams holds the parameters of [Alphavantage.QueryRealGdp].
+// See [Alphavantage.GetRealGdp] for their documentation.
+type RealGdpParams struct {
+	Interval Interval // Optional; one of annual, quarterly; default annual
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryRealGdp is the typed form of [Alphavantage.GetRealGdp].
+func (a *Alphavantage) QueryRealGdp(p RealGdpParams) api.Response {
+	return a.query("REAL_GDP", realGdpParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// realGdpParams maps the arguments of [Alphavantage.GetRealGdp] onto their query parameters.
+func realGdpParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // REAL_GDP_PER_CAPITA
+//
 // This API returns the quarterly Real GDP per Capita data of the United States.
-// Source: U.S. Bureau of Economic Analysis, Real gross domestic product per capita, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: U.S. Bureau of Economic Analysis, Real gross domestic product per capita, retrieved from
+// FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is not endorsed or
+// certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound
+// by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#real-gdp-per-capita
 //
 // Parameters:
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetRealGdpPerCapita(opt_datatype string) api.Response {
-	function := "REAL_GDP_PER_CAPITA"
-	params := map[string]string{
+	return a.query("REAL_GDP_PER_CAPITA", realGdpPerCapitaParams(opt_datatype))
+}
+
+// RealGdpPerCapitaParams holds the parameters of [Alphavantage.QueryRealGdpPerCapita].
+// See [Alphavantage.GetRealGdpPerCapita] for their documentation.
+type RealGdpPerCapitaParams struct {
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryRealGdpPerCapita is the typed form of [Alphavantage.GetRealGdpPerCapita].
+func (a *Alphavantage) QueryRealGdpPerCapita(p RealGdpPerCapitaParams) api.Response {
+	return a.query("REAL_GDP_PER_CAPITA", realGdpPerCapitaParams(
+		string(p.Datatype),
+	))
+}
+
+// realGdpPerCapitaParams maps the arguments of [Alphavantage.GetRealGdpPerCapita] onto their query parameters.
+func realGdpPerCapitaParams(opt_datatype string) map[string]string {
+	return map[string]string{
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // TREASURY_YIELD
-// This API returns the daily, weekly, and monthly US treasury yield of a given maturity timeline (e.g., 5 year, 30 year, etc).
-// Source: Board of Governors of the Federal Reserve System (US), Market Yield on U.S. Treasury Securities at 3-month, 2-year, 5-year, 7-year, 10-year, and 30-year Constant Maturities, Quoted on an Investment Basis, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// This API returns the daily, weekly, and monthly US treasury yield of a given maturity timeline
+// (e.g., 5 year, 30 year, etc).
+//
+// Source: Board of Governors of the Federal Reserve System (US), Market Yield on U.S. Treasury
+// Securities at 3-month, 2-year, 5-year, 7-year, 10-year, and 30-year Constant Maturities, Quoted on
+// an Investment Basis, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the
+// FRED® API but is not endorsed or certified by the Federal Reserve Bank of St. Louis. By using this
+// data feed, you agree to be bound by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#treasury-yield
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>daily</code>, <code>weekly</code>, and <code>monthly</code> are accepted.
-// -	opt_maturity: By default, <code>maturity=10year</code>. Strings <code>3month</code>, <code>2year</code>, <code>5year</code>, <code>7year</code>, <code>10year</code>, and <code>30year</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings daily, weekly, and monthly are accepted.
+//   - opt_maturity: By default, maturity=10year. Strings 3month, 2year, 5year, 7year, 10year, and
+//     30year are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetTreasuryYield(opt_interval, opt_maturity, opt_datatype string) api.Response {
-	function := "TREASURY_YIELD"
-	params := map[string]string{
+	return a.query("TREASURY_YIELD", treasuryYieldParams(opt_interval, opt_maturity, opt_datatype))
+}
+
+// TreasuryYieldParams holds the parameters of [Alphavantage.QueryTreasuryYield].
+// See [Alphavantage.GetTreasuryYield] for their documentation.
+type TreasuryYieldParams struct {
+	Interval Interval // Optional; one of monthly, daily, weekly; default monthly
+	Maturity string   // Optional; one of 10year, 3month, 2year, 5year, 7year, 30year; default 10year
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryTreasuryYield is the typed form of [Alphavantage.GetTreasuryYield].
+func (a *Alphavantage) QueryTreasuryYield(p TreasuryYieldParams) api.Response {
+	return a.query("TREASURY_YIELD", treasuryYieldParams(
+		string(p.Interval),
+		p.Maturity,
+		string(p.Datatype),
+	))
+}
+
+// treasuryYieldParams maps the arguments of [Alphavantage.GetTreasuryYield] onto their query parameters.
+func treasuryYieldParams(opt_interval, opt_maturity, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"maturity": opt_maturity,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // FEDERAL_FUNDS_RATE
-// This API returns the daily, weekly, and monthly federal funds rate (interest rate) of the United States.
-// Source: Board of Governors of the Federal Reserve System (US), Federal Funds Effective Rate, retrieved from FRED, Federal Reserve Bank of St. Louis (https://fred.stlouisfed.org/series/FEDFUNDS). This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// This API returns the daily, weekly, and monthly federal funds rate (interest rate) of the United
+// States.
+//
+// Source: Board of Governors of the Federal Reserve System (US), Federal Funds Effective Rate,
+// retrieved from FRED, Federal Reserve Bank of St. Louis
+// (https://fred.stlouisfed.org/series/FEDFUNDS). This data feed uses the FRED® API but is not
+// endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree
+// to be bound by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#interest-rate
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>daily</code>, <code>weekly</code>, and <code>monthly</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings daily, weekly, and monthly are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetFederalFundsRate(opt_interval, opt_datatype string) api.Response {
-	function := "FEDERAL_FUNDS_RATE"
-	params := map[string]string{
+	return a.query("FEDERAL_FUNDS_RATE", federalFundsRateParams(opt_interval, opt_datatype))
+}
+
+// FederalFundsRateParams holds the parameters of [Alphavantage.QueryFederalFundsRate].
+// See [Alphavantage.GetFederalFundsRate] for their documentation.
+type FederalFundsRateParams struct {
+	Interval Interval // Optional; one of monthly, daily, weekly; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryFederalFundsRate is the typed form of [Alphavantage.GetFederalFundsRate].
+func (a *Alphavantage) QueryFederalFundsRate(p FederalFundsRateParams) api.Response {
+	return a.query("FEDERAL_FUNDS_RATE", federalFundsRateParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// federalFundsRateParams maps the arguments of [Alphavantage.GetFederalFundsRate] onto their query parameters.
+func federalFundsRateParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // CPI
-// This API returns the monthly and semiannual consumer price index (CPI) of the United States. CPI is widely regarded as the barometer of inflation levels in the broader economy.
-// Source: U.S. Bureau of Labor Statistics, Consumer Price Index for All Urban Consumers: All Items in U.S. City Average, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// This API returns the monthly and semiannual consumer price index (CPI) of the United States. CPI is
+// widely regarded as the barometer of inflation levels in the broader economy.
+//
+// Source: U.S. Bureau of Labor Statistics, Consumer Price Index for All Urban Consumers: All Items in
+// U.S. City Average, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the
+// FRED® API but is not endorsed or certified by the Federal Reserve Bank of St. Louis. By using this
+// data feed, you agree to be bound by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#cpi
 //
 // Parameters:
-// -	opt_interval: By default, <code>interval=monthly</code>. Strings <code>monthly</code> and <code>semiannual</code> are accepted.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_interval: By default, interval=monthly. Strings monthly and semiannual are accepted.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetCpi(opt_interval, opt_datatype string) api.Response {
-	function := "CPI"
-	params := map[string]string{
+	return a.query("CPI", cpiParams(opt_interval, opt_datatype))
+}
+
+// CpiParams holds the parameters of [Alphavantage.QueryCpi].
+// See [Alphavantage.GetCpi] for their documentation.
+type CpiParams struct {
+	Interval Interval // Optional; one of monthly, semiannual; default monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryCpi is the typed form of [Alphavantage.GetCpi].
+func (a *Alphavantage) QueryCpi(p CpiParams) api.Response {
+	return a.query("CPI", cpiParams(
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// cpiParams maps the arguments of [Alphavantage.GetCpi] onto their query parameters.
+func cpiParams(opt_interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"interval": opt_interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // INFLATION
+//
 // This API returns the annual inflation rates (consumer prices) of the United States.
-// Source: World Bank, Inflation, consumer prices for the United States, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: World Bank, Inflation, consumer prices for the United States, retrieved from FRED, Federal
+// Reserve Bank of St. Louis. This data feed uses the FRED® API but is not endorsed or certified by
+// the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the
+// [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#inflation
 //
 // Parameters:
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetInflation(opt_datatype string) api.Response {
-	function := "INFLATION"
-	params := map[string]string{
+	return a.query("INFLATION", inflationParams(opt_datatype))
+}
+
+// InflationParams holds the parameters of [Alphavantage.QueryInflation].
+// See [Alphavantage.GetInflation] for their documentation.
+type InflationParams struct {
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryInflation is the typed form of [Alphavantage.GetInflation].
+func (a *Alphavantage) QueryInflation(p InflationParams) api.Response {
+	return a.query("INFLATION", inflationParams(
+		string(p.Datatype),
+	))
+}
+
+// inflationParams maps the arguments of [Alphavantage.GetInflation] onto their query parameters.
+func inflationParams(opt_datatype string) map[string]string {
+	return map[string]string{
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // RETAIL_SALES
+//
 // This API returns the monthly Advance Retail Sales: Retail Trade data of the United States.
-// Source: U.S. Census Bureau, Advance Retail Sales: Retail Trade, retrieved from FRED, Federal Reserve Bank of St. Louis (https://fred.stlouisfed.org/series/RSXFSN). This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// Source: U.S. Census Bureau, Advance Retail Sales: Retail Trade, retrieved from FRED, Federal Reserve
+// Bank of St. Louis (https://fred.stlouisfed.org/series/RSXFSN). This data feed uses the FRED® API
+// but is not endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed,
+// you agree to be bound by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#retail-sales
 //
 // Parameters:
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetRetailSales(opt_datatype string) api.Response {
-	function := "RETAIL_SALES"
-	params := map[string]string{
+	return a.query("RETAIL_SALES", retailSalesParams(opt_datatype))
+}
+
+// RetailSalesParams holds the parameters of [Alphavantage.QueryRetailSales].
+// See [Alphavantage.GetRetailSales] for their documentation.
+type RetailSalesParams struct {
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryRetailSales is the typed form of [Alphavantage.GetRetailSales].
+func (a *Alphavantage) QueryRetailSales(p RetailSalesParams) api.Response {
+	return a.query("RETAIL_SALES", retailSalesParams(
+		string(p.Datatype),
+	))
+}
+
+// retailSalesParams maps the arguments of [Alphavantage.GetRetailSales] onto their query parameters.
+func retailSalesParams(opt_datatype string) map[string]string {
+	return map[string]string{
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // DURABLES
-// This API returns the monthly manufacturers&#39; new orders of durable goods in the United States.
-// Source: U.S. Census Bureau, Manufacturers&#39; New Orders: Durable Goods, retrieved from FRED, Federal Reserve Bank of St. Louis (https://fred.stlouisfed.org/series/UMDMNO). This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// This API returns the monthly manufacturers' new orders of durable goods in the United States.
+//
+// Source: U.S. Census Bureau, Manufacturers' New Orders: Durable Goods, retrieved from FRED, Federal
+// Reserve Bank of St. Louis (https://fred.stlouisfed.org/series/UMDMNO). This data feed uses the
+// FRED® API but is not endorsed or certified by the Federal Reserve Bank of St. Louis. By using this
+// data feed, you agree to be bound by the [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#durable-goods
 //
 // Parameters:
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetDurables(opt_datatype string) api.Response {
-	function := "DURABLES"
-	params := map[string]string{
+	return a.query("DURABLES", durablesParams(opt_datatype))
+}
+
+// DurablesParams holds the parameters of [Alphavantage.QueryDurables].
+// See [Alphavantage.GetDurables] for their documentation.
+type DurablesParams struct {
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryDurables is the typed form of [Alphavantage.GetDurables].
+func (a *Alphavantage) QueryDurables(p DurablesParams) api.Response {
+	return a.query("DURABLES", durablesParams(
+		string(p.Datatype),
+	))
+}
+
+// durablesParams maps the arguments of [Alphavantage.GetDurables] onto their query parameters.
+func durablesParams(opt_datatype string) map[string]string {
+	return map[string]string{
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // UNEMPLOYMENT
-// This API returns the monthly unemployment data of the United States. The unemployment rate represents the number of unemployed as a percentage of the labor force. Labor force data are restricted to people 16 years of age and older, who currently reside in 1 of the 50 states or the District of Columbia, who do not reside in institutions (e.g., penal and mental facilities, homes for the aged), and who are not on active duty in the Armed Forces (<a href="https://fred.stlouisfed.org/series/UNRATE" target="_blank">source</a>).
-// Source: U.S. Bureau of Labor Statistics, Unemployment Rate, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// This API returns the monthly unemployment data of the United States. The unemployment rate
+// represents the number of unemployed as a percentage of the labor force. Labor force data are
+// restricted to people 16 years of age and older, who currently reside in 1 of the 50 states or the
+// District of Columbia, who do not reside in institutions (e.g., penal and mental facilities, homes
+// for the aged), and who are not on active duty in the Armed Forces ([source]).
+//
+// Source: U.S. Bureau of Labor Statistics, Unemployment Rate, retrieved from FRED, Federal Reserve
+// Bank of St. Louis. This data feed uses the FRED® API but is not endorsed or certified by the
+// Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the
+// [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#unemployment
 //
 // Parameters:
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [source]: https://fred.stlouisfed.org/series/UNRATE
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetUnemployment(opt_datatype string) api.Response {
-	function := "UNEMPLOYMENT"
-	params := map[string]string{
+	return a.query("UNEMPLOYMENT", unemploymentParams(opt_datatype))
+}
+
+// UnemploymentParams holds the parameters of [Alphavantage.QueryUnemployment].
+// See [Alphavantage.GetUnemployment] for their documentation.
+type UnemploymentParams struct {
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryUnemployment is the typed form of [Alphavantage.GetUnemployment].
+func (a *Alphavantage) QueryUnemployment(p UnemploymentParams) api.Response {
+	return a.query("UNEMPLOYMENT", unemploymentParams(
+		string(p.Datatype),
+	))
+}
+
+// unemploymentParams maps the arguments of [Alphavantage.GetUnemployment] onto their query parameters.
+func unemploymentParams(opt_datatype string) map[string]string {
+	return map[string]string{
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // NONFARM_PAYROLL
-// This API returns the monthly US All Employees: Total Nonfarm (commonly known as Total Nonfarm Payroll), a measure of the number of U.S. workers in the economy that excludes proprietors, private household employees, unpaid volunteers, farm employees, and the unincorporated self-employed.
-// Source: U.S. Bureau of Labor Statistics, All Employees, Total Nonfarm, retrieved from FRED, Federal Reserve Bank of St. Louis. This data feed uses the FRED® API but is <u>not</u> endorsed or certified by the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the <a href="https://fred.stlouisfed.org/docs/api/terms_of_use.html" target="_blank">FRED® API Terms of Use</a>.
+//
+// This API returns the monthly US All Employees: Total Nonfarm (commonly known as Total Nonfarm
+// Payroll), a measure of the number of U.S. workers in the economy that excludes proprietors, private
+// household employees, unpaid volunteers, farm employees, and the unincorporated self-employed.
+//
+// Source: U.S. Bureau of Labor Statistics, All Employees, Total Nonfarm, retrieved from FRED, Federal
+// Reserve Bank of St. Louis. This data feed uses the FRED® API but is not endorsed or certified by
+// the Federal Reserve Bank of St. Louis. By using this data feed, you agree to be bound by the
+// [FRED® API Terms of Use].
+//
 // https://www.alphavantage.co/documentation/#nonfarm-payroll
 //
 // Parameters:
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the time series in JSON format; csv returns the time series as a
+//     CSV (comma separated value) file.
+//
+// [FRED® API Terms of Use]: https://fred.stlouisfed.org/docs/api/terms_of_use.html
 func (a *Alphavantage) GetNonfarmPayroll(opt_datatype string) api.Response {
-	function := "NONFARM_PAYROLL"
-	params := map[string]string{
+	return a.query("NONFARM_PAYROLL", nonfarmPayrollParams(opt_datatype))
+}
+
+// NonfarmPayrollParams holds the parameters of [Alphavantage.QueryNonfarmPayroll].
+// See [Alphavantage.GetNonfarmPayroll] for their documentation.
+type NonfarmPayrollParams struct {
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryNonfarmPayroll is the typed form of [Alphavantage.GetNonfarmPayroll].
+func (a *Alphavantage) QueryNonfarmPayroll(p NonfarmPayrollParams) api.Response {
+	return a.query("NONFARM_PAYROLL", nonfarmPayrollParams(
+		string(p.Datatype),
+	))
+}
+
+// nonfarmPayrollParams maps the arguments of [Alphavantage.GetNonfarmPayroll] onto their query parameters.
+func nonfarmPayrollParams(opt_datatype string) map[string]string {
+	return map[string]string{
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Endpoint Category: Fundamental Data
 // https://www.alphavantage.co/documentation/#fundamentals
 //
-// We offer the following set of fundamental data APIs in various temporal dimensions covering key financial metrics, income statements, balance sheets, cash flow, and other fundamental data points.
+// We offer the following set of fundamental data APIs in various temporal dimensions covering key
+// financial metrics, income statements, balance sheets, cash flow, and other fundamental data points.
 
 // Company Overview
-// This API returns the company information, financial ratios, and other key metrics for the equity specified. Data is generally refreshed on the same day a company reports its latest earnings and financials.
+//
+// This API returns the company information, financial ratios, and other key metrics for the equity
+// specified. Data is generally refreshed on the same day a company reports its latest earnings and
+// financials.
+//
 // https://www.alphavantage.co/documentation/#company-overview
 //
 // Parameters:
-// -	symbol: The symbol of the token of your choice. For example: <code>symbol=IBM</code>.
+//   - symbol: The symbol of the token of your choice. For example: symbol=IBM.
 func (a *Alphavantage) GetOverview(symbol string) api.Response {
-	function := "OVERVIEW"
-	params := map[string]string{
+	return a.query("OVERVIEW", overviewParams(symbol))
+}
+
+// OverviewParams holds the parameters of [Alphavantage.QueryOverview].
+// See [Alphavantage.GetOverview] for their documentation.
+type OverviewParams struct {
+	Symbol string
+}
+
+// QueryOverview is the typed form of [Alphavantage.GetOverview].
+func (a *Alphavantage) QueryOverview(p OverviewParams) api.Response {
+	return a.query("OVERVIEW", overviewParams(
+		p.Symbol,
+	))
+}
+
+// overviewParams maps the arguments of [Alphavantage.GetOverview] onto their query parameters.
+func overviewParams(symbol string) map[string]string {
+	return map[string]string{
 		"symbol": symbol,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // INCOME_STATEMENT
-// This API returns the annual and quarterly income statements for the company of interest, with normalized fields <a href="https://documentation.alphavantage.co/FundamentalDataDocs/index.html" target="_blank">mapped to GAAP and IFRS taxonomies</a> of the SEC. Data is generally refreshed on the same day a company reports its latest earnings and financials.
+//
+// This API returns the annual and quarterly income statements for the company of interest, with
+// normalized fields [mapped to GAAP and IFRS taxonomies] of the SEC. Data is generally refreshed on
+// the same day a company reports its latest earnings and financials.
+//
 // https://www.alphavantage.co/documentation/#income-statement
 //
 // Parameters:
-// -	symbol: The symbol of the token of your choice. For example: <code>symbol=IBM</code>.
+//   - symbol: The symbol of the token of your choice. For example: symbol=IBM.
+//
+// [mapped to GAAP and IFRS taxonomies]: https://documentation.alphavantage.co/FundamentalDataDocs/index.html
 func (a *Alphavantage) GetIncomeStatement(symbol string) api.Response {
-	function := "INCOME_STATEMENT"
-	params := map[string]string{
+	return a.query("INCOME_STATEMENT", incomeStatementParams(symbol))
+}
+
+// IncomeStatementParams holds the parameters of [Alphavantage.QueryIncomeStatement].
+// See [Alphavantage.GetIncomeStatement] for their documentation.
+type IncomeStatementParams struct {
+	Symbol string
+}
+
+// QueryIncomeStatement is the typed form of [Alphavantage.GetIncomeStatement].
+func (a *Alphavantage) QueryIncomeStatement(p IncomeStatementParams) api.Response {
+	return a.query("INCOME_STATEMENT", incomeStatementParams(
+		p.Symbol,
+	))
+}
+
+// incomeStatementParams maps the arguments of [Alphavantage.GetIncomeStatement] onto their query parameters.
+func incomeStatementParams(symbol string) map[string]string {
+	return map[string]string{
 		"symbol": symbol,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // BALANCE_SHEET
-// This API returns the annual and quarterly balance sheets for the company of interest, with normalized fields <a href="https://documentation.alphavantage.co/FundamentalDataDocs/index.html" target="_blank">mapped to GAAP and IFRS taxonomies</a> of the SEC. Data is generally refreshed on the same day a company reports its latest earnings and financials.
+//
+// This API returns the annual and quarterly balance sheets for the company of interest, with
+// normalized fields [mapped to GAAP and IFRS taxonomies] of the SEC. Data is generally refreshed on
+// the same day a company reports its latest earnings and financials.
+//
 // https://www.alphavantage.co/documentation/#balance-sheet
 //
 // Parameters:
-// -	symbol: The symbol of the token of your choice. For example: <code>symbol=IBM</code>.
+//   - symbol: The symbol of the token of your choice. For example: symbol=IBM.
+//
+// [mapped to GAAP and IFRS taxonomies]: https://documentation.alphavantage.co/FundamentalDataDocs/index.html
 func (a *Alphavantage) GetBalanceSheet(symbol string) api.Response {
-	function := "BALANCE_SHEET"
-	params := map[string]string{
+	return a.query("BALANCE_SHEET", balanceSheetParams(symbol))
+}
+
+// BalanceSheetParams holds the parameters of [Alphavantage.QueryBalanceSheet].
+// See [Alphavantage.GetBalanceSheet] for their documentation.
+type BalanceSheetParams struct {
+	Symbol string
+}
+
+// QueryBalanceSheet is the typed form of [Alphavantage.GetBalanceSheet].
+func (a *Alphavantage) QueryBalanceSheet(p BalanceSheetParams) api.Response {
+	return a.query("BALANCE_SHEET", balanceSheetParams(
+		p.Symbol,
+	))
+}
+
+// balanceSheetParams maps the arguments of [Alphavantage.GetBalanceSheet] onto their query parameters.
+func balanceSheetParams(symbol string) map[string]string {
+	return map[string]string{
 		"symbol": symbol,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // CASH_FLOW
-// This API returns the annual and quarterly cash flow for the company of interest, with normalized fields <a href="https://documentation.alphavantage.co/FundamentalDataDocs/index.html" target="_blank">mapped to GAAP and IFRS taxonomies</a> of the SEC. Data is generally refreshed on the same day a company reports its latest earnings and financials.
+//
+// This API returns the annual and quarterly cash flow for the company of interest, with normalized
+// fields [mapped to GAAP and IFRS taxonomies] of the SEC. Data is generally refreshed on the same day
+// a company reports its latest earnings and financials.
+//
 // https://www.alphavantage.co/documentation/#cash-flow
 //
 // Parameters:
-// -	symbol: The symbol of the token of your choice. For example: <code>symbol=IBM</code>.
+//   - symbol: The symbol of the token of your choice. For example: symbol=IBM.
+//
+// [mapped to GAAP and IFRS taxonomies]: https://documentation.alphavantage.co/FundamentalDataDocs/index.html
 func (a *Alphavantage) GetCashFlow(symbol string) api.Response {
-	function := "CASH_FLOW"
-	params := map[string]string{
+	return a.query("CASH_FLOW", cashFlowParams(symbol))
+}
+
+// CashFlowParams holds the parameters of [Alphavantage.QueryCashFlow].
+// See [Alphavantage.GetCashFlow] for their documentation.
+type CashFlowParams struct {
+	Symbol string
+}
+
+// QueryCashFlow is the typed form of [Alphavantage.GetCashFlow].
+func (a *Alphavantage) QueryCashFlow(p CashFlowParams) api.Response {
+	return a.query("CASH_FLOW", cashFlowParams(
+		p.Symbol,
+	))
+}
+
+// cashFlowParams maps the arguments of [Alphavantage.GetCashFlow] onto their query parameters.
+func cashFlowParams(symbol string) map[string]string {
+	return map[string]string{
 		"symbol": symbol,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Earnings
-// This API returns the annual and quarterly earnings (EPS) for the company of interest. Quarterly data also includes analyst estimates and surprise metrics.
+//
+// This API returns the annual and quarterly earnings (EPS) for the company of interest. Quarterly data
+// also includes analyst estimates and surprise metrics.
+//
 // https://www.alphavantage.co/documentation/#earnings
 //
 // Parameters:
-// -	symbol: The symbol of the token of your choice. For example: <code>symbol=IBM</code>.
+//   - symbol: The symbol of the token of your choice. For example: symbol=IBM.
 func (a *Alphavantage) GetEarnings(symbol string) api.Response {
-	function := "EARNINGS"
-	params := map[string]string{
+	return a.query("EARNINGS", earningsParams(symbol))
+}
+
+// EarningsParams holds the parameters of [Alphavantage.QueryEarnings].
+// See [Alphavantage.GetEarnings] for their documentation.
+type EarningsParams struct {
+	Symbol string
+}
+
+// QueryEarnings is the typed form of [Alphavantage.GetEarnings].
+func (a *Alphavantage) QueryEarnings(p EarningsParams) api.Response {
+	return a.query("EARNINGS", earningsParams(
+		p.Symbol,
+	))
+}
+
+// earningsParams maps the arguments of [Alphavantage.GetEarnings] onto their query parameters.
+func earningsParams(symbol string) map[string]string {
+	return map[string]string{
 		"symbol": symbol,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Listing & Delisting Status
-// This API returns a list of active or delisted US stocks and ETFs, either as of the latest trading day or at a specific time in history. The endpoint is positioned to facilitate equity research on asset lifecycle and survivorship.
+//
+// This API returns a list of active or delisted US stocks and ETFs, either as of the latest trading
+// day or at a specific time in history. The endpoint is positioned to facilitate equity research on
+// asset lifecycle and survivorship.
+//
 // https://www.alphavantage.co/documentation/#listing-status
 //
 // Parameters:
-// -	opt_date: If no date is set, the API endpoint will return a list of active or delisted symbols as of the latest trading day. If a date is set, the API endpoint will &#34;travel back&#34; in time and return a list of active or delisted symbols on that particular date in history. Any <u>YYYY-MM-DD</u> date later than 2010-01-01 is supported. For example, <code>date=2013-08-03</code>
-// -	opt_state: By default, <code>state=active</code> and the API will return a list of actively traded stocks and ETFs. Set <code>state=delisted</code> to query a list of delisted assets.
+//   - opt_date: If no date is set, the API endpoint will return a list of active or delisted symbols
+//     as of the latest trading day. If a date is set, the API endpoint will "travel back" in time and
+//     return a list of active or delisted symbols on that particular date in history. Any YYYY-MM-DD
+//     date later than 2010-01-01 is supported. For example, date=2013-08-03
+//   - opt_state: By default, state=active and the API will return a list of actively traded stocks and
+//     ETFs. Set state=delisted to query a list of delisted assets.
 func (a *Alphavantage) GetListingStatus(opt_date, opt_state string) api.Response {
-	function := "LISTING_STATUS"
-	params := map[string]string{
+	return a.query("LISTING_STATUS", listingStatusParams(opt_date, opt_state))
+}
+
+// ListingStatusParams holds the parameters of [Alphavantage.QueryListingStatus].
+// See [Alphavantage.GetListingStatus] for their documentation.
+type ListingStatusParams struct {
+	Date  time.Time // Optional
+	State string    // Optional; one of active, delisted; default active
+}
+
+// QueryListingStatus is the typed form of [Alphavantage.GetListingStatus].
+func (a *Alphavantage) QueryListingStatus(p ListingStatusParams) api.Response {
+	return a.query("LISTING_STATUS", listingStatusParams(
+		formatDate(p.Date),
+		p.State,
+	))
+}
+
+// listingStatusParams maps the arguments of [Alphavantage.GetListingStatus] onto their query parameters.
+func listingStatusParams(opt_date, opt_state string) map[string]string {
+	return map[string]string{
 		"date":  opt_date,
 		"state": opt_state,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Earnings Calendar
+//
 // This API returns a list of company earnings expected in the next 3, 6, or 12 months.
+//
 // https://www.alphavantage.co/documentation/#earnings-calendar
 //
 // Parameters:
-// -	opt_symbol: By default, no symbol will be set for this API. When no symbol is set, the API endpoint will return the full list of company earnings scheduled. If a symbol is set, the API endpoint will return the expected earnings for that specific symbol. For example, <code>symbol=IBM</code>
-// -	opt_horizon: By default, <code>horizon=3month</code> and the API will return a list of expected company earnings in the next 3 months. You may set <code>horizon=6month</code> or <code>horizon=12month</code> to query the earnings scheduled for the next 6 months or 12 months, respectively.
+//   - opt_symbol: By default, no symbol will be set for this API. When no symbol is set, the API
+//     endpoint will return the full list of company earnings scheduled. If a symbol is set, the API
+//     endpoint will return the expected earnings for that specific symbol. For example, symbol=IBM
+//   - opt_horizon: By default, horizon=3month and the API will return a list of expected company
+//     earnings in the next 3 months. You may set horizon=6month or horizon=12month to query the
+//     earnings scheduled for the next 6 months or 12 months, respectively.
 func (a *Alphavantage) GetEarningsCalendar(opt_symbol, opt_horizon string) api.Response {
-	function := "EARNINGS_CALENDAR"
-	params := map[string]string{
+	return a.query("EARNINGS_CALENDAR", earningsCalendarParams(opt_symbol, opt_horizon))
+}
+
+// EarningsCalendarParams holds the parameters of [Alphavantage.QueryEarningsCalendar].
+// See [Alphavantage.GetEarningsCalendar] for their documentation.
+type EarningsCalendarParams struct {
+	Symbol  string // Optional; default IBM
+	Horizon string // Optional; one of 3month, 6month, 12month; default 3month
+}
+
+// QueryEarningsCalendar is the typed form of [Alphavantage.GetEarningsCalendar].
+func (a *Alphavantage) QueryEarningsCalendar(p EarningsCalendarParams) api.Response {
+	return a.query("EARNINGS_CALENDAR", earningsCalendarParams(
+		p.Symbol,
+		p.Horizon,
+	))
+}
+
+// earningsCalendarParams maps the arguments of [Alphavantage.GetEarningsCalendar] onto their query parameters.
+func earningsCalendarParams(opt_symbol, opt_horizon string) map[string]string {
+	return map[string]string{
 		"symbol":  opt_symbol,
 		"horizon": opt_horizon,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // IPO Calendar
+//
 // This API returns a list of IPOs expected in the next 3 months.
-// https://www.alphavantage.co/documentation/#ipo-calendar
 //
-// Parameters:
-func (a *Alphavantage) GetIpoCalendar(string) api.Response {
-	function := "IPO_CALENDAR"
-	params := map[string]string{}
+// https://www.alphavantage.co/documentation/#ipo-calendar
+func (a *Alphavantage) GetIpoCalendar() api.Response {
+	return a.query("IPO_CALENDAR", ipoCalendarParams())
+}
+
+// IpoCalendarParams holds the parameters of [Alphavantage.QueryIpoCalendar].
+// See [Alphavantage.GetIpoCalendar] for their documentation.
+type IpoCalendarParams struct {
+}
+
+// QueryIpoCalendar is the typed form of [Alphavantage.GetIpoCalendar].
+func (a *Alphavantage) QueryIpoCalendar(p IpoCalendarParams) api.Response {
+	return a.query("IPO_CALENDAR", ipoCalendarParams())
+}
 
-	return a.client.Query(function, params)
+// ipoCalendarParams maps the arguments of [Alphavantage.GetIpoCalendar] onto their query parameters.
+func ipoCalendarParams() map[string]string {
+	return map[string]string{}
 }
 
 // Endpoint Category: Foreign Exchange (FX)
 // https://www.alphavantage.co/documentation/#fx
 //
-// APIs under this section provide a wide range of data feed for realtime and historical forex (FX) rates.
+// APIs under this section provide a wide range of data feed for realtime and historical forex (FX)
+// rates.
 
 // CURRENCY_EXCHANGE_RATE
-// This API returns the realtime exchange rate for a pair of digital currency (e.g., Bitcoin) and physical currency (e.g., USD).
+//
+// This API returns the realtime exchange rate for a pair of digital currency (e.g., Bitcoin) and
+// physical currency (e.g., USD).
+//
 // https://www.alphavantage.co/documentation/#currency-exchange
 //
 // Parameters:
-// -	from_currency: The currency you would like to get the exchange rate for. It can either be a <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> physical currency</a> or <a href="https://www.alphavantage.co/digital_currency_list/" target="_blank"> digital/crypto currency</a>. For example: <code>from_currency=USD</code> or <code>from_currency=BTC</code>.
-// -	to_currency: The destination currency for the exchange rate. It can either be a <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> physical currency</a> or <a href="https://www.alphavantage.co/digital_currency_list/" target="_blank"> digital/crypto currency</a>. For example: <code>to_currency=USD</code> or <code>to_currency=BTC</code>.
+//   - from_currency: The currency you would like to get the exchange rate for. It can either be a
+//     [physical currency] or [digital/crypto currency]. For example: from_currency=USD or
+//     from_currency=BTC.
+//   - to_currency: The destination currency for the exchange rate. It can either be a
+//     [physical currency] or [digital/crypto currency]. For example: to_currency=USD or
+//     to_currency=BTC.
+//
+// [physical currency]: https://www.alphavantage.co/physical_currency_list/
+// [digital/crypto currency]: https://www.alphavantage.co/digital_currency_list/
 func (a *Alphavantage) GetCurrencyExchangeRate(from_currency, to_currency string) api.Response {
-	function := "CURRENCY_EXCHANGE_RATE"
-	params := map[string]string{
+	return a.query("CURRENCY_EXCHANGE_RATE", currencyExchangeRateParams(from_currency, to_currency))
+}
+
+// CurrencyExchangeRateParams holds the parameters of [Alphavantage.QueryCurrencyExchangeRate].
+// See [Alphavantage.GetCurrencyExchangeRate] for their documentation.
+type CurrencyExchangeRateParams struct {
+	FromCurrency string
+	ToCurrency   string
+}
+
+// QueryCurrencyExchangeRate is the typed form of [Alphavantage.GetCurrencyExchangeRate].
+func (a *Alphavantage) QueryCurrencyExchangeRate(p CurrencyExchangeRateParams) api.Response {
+	return a.query("CURRENCY_EXCHANGE_RATE", currencyExchangeRateParams(
+		p.FromCurrency,
+		p.ToCurrency,
+	))
+}
+
+// currencyExchangeRateParams maps the arguments of [Alphavantage.GetCurrencyExchangeRate] onto their query parameters.
+func currencyExchangeRateParams(from_currency, to_currency string) map[string]string {
+	return map[string]string{
 		"from_currency": from_currency,
 		"to_currency":   to_currency,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // [PREMIUM] FX_INTRADAY
-// This API returns intraday time series (timestamp, open, high, low, close) of the FX currency pair specified, updated realtime.
+//
+// This API returns intraday time series (timestamp, open, high, low, close) of the FX currency pair
+// specified, updated realtime.
+//
 // https://www.alphavantage.co/documentation/#fx-intraday
 //
 // Parameters:
-// -	from_symbol: A three-letter symbol from the <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> forex currency list</a>. For example: <code>from_symbol=EUR</code>
-// -	to_symbol: A three-letter symbol from the <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> forex currency list</a>. For example: <code>to_symbol=USD</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>
-// -	opt_outputsize: By default, <code>outputsize=compact</code>. Strings <code>compact</code> and <code>full</code> are accepted with the following specifications: <code>compact</code> returns only the latest 100 data points in the intraday time series; <code>full</code> returns the full-length intraday time series. The &#34;compact&#34; option is recommended if you would like to reduce the data size of each API call.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the intraday time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - from_symbol: A three-letter symbol from the [forex currency list]. For example: from_symbol=EUR
+//   - to_symbol: A three-letter symbol from the [forex currency list]. For example: to_symbol=USD
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min
+//   - opt_outputsize: By default, outputsize=compact. Strings compact and full are accepted with the
+//     following specifications: compact returns only the latest 100 data points in the intraday time
+//     series; full returns the full-length intraday time series. The "compact" option is recommended
+//     if you would like to reduce the data size of each API call.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the intraday time series in JSON format; csv returns the time
+//     series as a CSV (comma separated value) file.
+//
+// [forex currency list]: https://www.alphavantage.co/physical_currency_list/
 func (a *Alphavantage) GetFxIntraday(from_symbol, to_symbol, interval, opt_outputsize, opt_datatype string) api.Response {
-	function := "FX_INTRADAY"
-	params := map[string]string{
+	return a.query("FX_INTRADAY", fxIntradayParams(from_symbol, to_symbol, interval, opt_outputsize, opt_datatype))
+}
+
+// FxIntradayParams holds the parameters of [Alphavantage.QueryFxIntraday].
+// See [Alphavantage.GetFxIntraday] for their documentation.
+type FxIntradayParams struct {
+	FromSymbol string
+	ToSymbol   string
+	Interval   Interval   // One of 1min, 5min, 15min, 30min, 60min
+	Outputsize OutputSize // Optional; one of compact, full; default compact
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryFxIntraday is the typed form of [Alphavantage.GetFxIntraday].
+func (a *Alphavantage) QueryFxIntraday(p FxIntradayParams) api.Response {
+	return a.query("FX_INTRADAY", fxIntradayParams(
+		p.FromSymbol,
+		p.ToSymbol,
+		string(p.Interval),
+		string(p.Outputsize),
+		string(p.Datatype),
+	))
+}
+
+// fxIntradayParams maps the arguments of [Alphavantage.GetFxIntraday] onto their query parameters.
+func fxIntradayParams(from_symbol, to_symbol, interval, opt_outputsize, opt_datatype string) map[string]string {
+	return map[string]string{
 		"from_symbol": from_symbol,
 		"to_symbol":   to_symbol,
 		"interval":    interval,
 		"outputsize":  opt_outputsize,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // FX_DAILY
-// This API returns the daily time series (timestamp, open, high, low, close) of the FX currency pair specified, updated realtime.
+//
+// This API returns the daily time series (timestamp, open, high, low, close) of the FX currency pair
+// specified, updated realtime.
+//
 // https://www.alphavantage.co/documentation/#fx-daily
 //
 // Parameters:
-// -	from_symbol: A three-letter symbol from the <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> forex currency list</a>. For example: <code>from_symbol=EUR</code>
-// -	to_symbol: A three-letter symbol from the <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> forex currency list</a>. For example: <code>to_symbol=USD</code>
-// -	opt_outputsize: By default, <code>outputsize=compact</code>. Strings <code>compact</code> and <code>full</code> are accepted with the following specifications: <code>compact</code> returns only the latest 100 data points in the daily time series; <code>full</code> returns the full-length daily time series. The &#34;compact&#34; option is recommended if you would like to reduce the data size of each API call.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - from_symbol: A three-letter symbol from the [forex currency list]. For example: from_symbol=EUR
+//   - to_symbol: A three-letter symbol from the [forex currency list]. For example: to_symbol=USD
+//   - opt_outputsize: By default, outputsize=compact. Strings compact and full are accepted with the
+//     following specifications: compact returns only the latest 100 data points in the daily time
+//     series; full returns the full-length daily time series. The "compact" option is recommended if
+//     you would like to reduce the data size of each API call.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [forex currency list]: https://www.alphavantage.co/physical_currency_list/
 func (a *Alphavantage) GetFxDaily(from_symbol, to_symbol, opt_outputsize, opt_datatype string) api.Response {
-	function := "FX_DAILY"
-	params := map[string]string{
+	return a.query("FX_DAILY", fxDailyParams(from_symbol, to_symbol, opt_outputsize, opt_datatype))
+}
+
+// FxDailyParams holds the parameters of [Alphavantage.QueryFxDaily].
+// See [Alphavantage.GetFxDaily] for their documentation.
+type FxDailyParams struct {
+	FromSymbol string
+	ToSymbol   string
+	Outputsize OutputSize // Optional; one of compact, full; default compact
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryFxDaily is the typed form of [Alphavantage.GetFxDaily].
+func (a *Alphavantage) QueryFxDaily(p FxDailyParams) api.Response {
+	return a.query("FX_DAILY", fxDailyParams(
+		p.FromSymbol,
+		p.ToSymbol,
+		string(p.Outputsize),
+		string(p.Datatype),
+	))
+}
+
+// fxDailyParams maps the arguments of [Alphavantage.GetFxDaily] onto their query parameters.
+func fxDailyParams(from_symbol, to_symbol, opt_outputsize, opt_datatype string) map[string]string {
+	return map[string]string{
 		"from_symbol": from_symbol,
 		"to_symbol":   to_symbol,
 		"outputsize":  opt_outputsize,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // FX_WEEKLY
-// This API returns the weekly time series (timestamp, open, high, low, close) of the FX currency pair specified, updated realtime.
-// The latest data point is the price information for the week (or partial week) containing the current trading day, updated realtime.
+//
+// This API returns the weekly time series (timestamp, open, high, low, close) of the FX currency pair
+// specified, updated realtime.
+//
+// The latest data point is the price information for the week (or partial week) containing the current
+// trading day, updated realtime.
+//
 // https://www.alphavantage.co/documentation/#fx-weekly
 //
 // Parameters:
-// -	from_symbol: A three-letter symbol from the <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> forex currency list</a>. For example: <code>from_symbol=EUR</code>
-// -	to_symbol: A three-letter symbol from the <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> forex currency list</a>. For example: <code>to_symbol=USD</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the weekly time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - from_symbol: A three-letter symbol from the [forex currency list]. For example: from_symbol=EUR
+//   - to_symbol: A three-letter symbol from the [forex currency list]. For example: to_symbol=USD
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the weekly time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [forex currency list]: https://www.alphavantage.co/physical_currency_list/
 func (a *Alphavantage) GetFxWeekly(from_symbol, to_symbol, opt_datatype string) api.Response {
-	function := "FX_WEEKLY"
-	params := map[string]string{
+	return a.query("FX_WEEKLY", fxWeeklyParams(from_symbol, to_symbol, opt_datatype))
+}
+
+// FxWeeklyParams holds the parameters of [Alphavantage.QueryFxWeekly].
+// See [Alphavantage.GetFxWeekly] for their documentation.
+type FxWeeklyParams struct {
+	FromSymbol string
+	ToSymbol   string
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryFxWeekly is the typed form of [Alphavantage.GetFxWeekly].
+func (a *Alphavantage) QueryFxWeekly(p FxWeeklyParams) api.Response {
+	return a.query("FX_WEEKLY", fxWeeklyParams(
+		p.FromSymbol,
+		p.ToSymbol,
+		string(p.Datatype),
+	))
+}
+
+// fxWeeklyParams maps the arguments of [Alphavantage.GetFxWeekly] onto their query parameters.
+func fxWeeklyParams(from_symbol, to_symbol, opt_datatype string) map[string]string {
+	return map[string]string{
 		"from_symbol": from_symbol,
 		"to_symbol":   to_symbol,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // FX_MONTHLY
-// This API returns the monthly time series (timestamp, open, high, low, close) of the FX currency pair specified, updated realtime.
-// The latest data point is the prices information for the month (or partial month) containing the current trading day, updated realtime.
+//
+// This API returns the monthly time series (timestamp, open, high, low, close) of the FX currency pair
+// specified, updated realtime.
+//
+// The latest data point is the prices information for the month (or partial month) containing the
+// current trading day, updated realtime.
+//
 // https://www.alphavantage.co/documentation/#fx-monthly
 //
 // Parameters:
-// -	from_symbol: A three-letter symbol from the <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> forex currency list</a>. For example: <code>from_symbol=EUR</code>
-// -	to_symbol: A three-letter symbol from the <a href="https://www.alphavantage.co/physical_currency_list/" target="_blank"> forex currency list</a>. For example: <code>to_symbol=USD</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the monthly time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - from_symbol: A three-letter symbol from the [forex currency list]. For example: from_symbol=EUR
+//   - to_symbol: A three-letter symbol from the [forex currency list]. For example: to_symbol=USD
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the monthly time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [forex currency list]: https://www.alphavantage.co/physical_currency_list/
 func (a *Alphavantage) GetFxMonthly(from_symbol, to_symbol, opt_datatype string) api.Response {
-	function := "FX_MONTHLY"
-	params := map[string]string{
+	return a.query("FX_MONTHLY", fxMonthlyParams(from_symbol, to_symbol, opt_datatype))
+}
+
+// FxMonthlyParams holds the parameters of [Alphavantage.QueryFxMonthly].
+// See [Alphavantage.GetFxMonthly] for their documentation.
+type FxMonthlyParams struct {
+	FromSymbol string
+	ToSymbol   string
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryFxMonthly is the typed form of [Alphavantage.GetFxMonthly].
+func (a *Alphavantage) QueryFxMonthly(p FxMonthlyParams) api.Response {
+	return a.query("FX_MONTHLY", fxMonthlyParams(
+		p.FromSymbol,
+		p.ToSymbol,
+		string(p.Datatype),
+	))
+}
+
+// fxMonthlyParams maps the arguments of [Alphavantage.GetFxMonthly] onto their query parameters.
+func fxMonthlyParams(from_symbol, to_symbol, opt_datatype string) map[string]string {
+	return map[string]string{
 		"from_symbol": from_symbol,
 		"to_symbol":   to_symbol,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Endpoint Category: Alpha Intelligence™
 // https://www.alphavantage.co/documentation/#intelligence
 //
-// The APIs in this section contain advanced market intelligence built with our decades of expertise in AI, machine learning, and quantitative finance. We hope these highly differentiated alternative datasets can help turbocharge your trading strategy, market research, and financial software application to the next level.
+// The APIs in this section contain advanced market intelligence built with our decades of expertise in
+// AI, machine learning, and quantitative finance. We hope these highly differentiated alternative
+// datasets can help turbocharge your trading strategy, market research, and financial software
+// application to the next level.
 
 // Market News & Sentiment
-// Looking for market news signals to augment your trading strategy, or a global news feed API for your web/mobile app? You&#39;ve just found it. This API returns live and historical market news &amp; sentiment data derived from over 50 major financial news outlets around the world, covering stocks, cryptocurrencies, forex, and a wide range of topics such as fiscal policy, mergers &amp; acquisitions, IPOs, etc. This API, combined with our core stock API, fundamental data, and technical indicator APIs, can provide you with a 360-degree view of the financial market and the broader economy.
+//
+// Looking for market news signals to augment your trading strategy, or a global news feed API for your
+// web/mobile app? You've just found it. This API returns live and historical market news & sentiment
+// data derived from over 50 major financial news outlets around the world, covering stocks,
+// cryptocurrencies, forex, and a wide range of topics such as fiscal policy, mergers & acquisitions,
+// IPOs, etc. This API, combined with our core stock API, fundamental data, and technical indicator
+// APIs, can provide you with a 360-degree view of the financial market and the broader economy.
+//
 // https://www.alphavantage.co/documentation/#news-sentiment
 //
 // Parameters:
-// -	opt_tickers: The stock/crypto/forex symbols of your choice. For example: <code>tickers=IBM</code> will filter for articles that mention the IBM ticker; <code>tickers=COIN,CRYPTO:BTC,FOREX:USD</code> will filter for articles that <u>simultaneously</u> mention Coinbase (COIN), Bitcoin (CRYPTO:BTC), and US Dollar (FOREX:USD) in their content.
-// -	opt_topics: The news topics of your choice. For example: <code>topics=technology</code> will filter for articles that write about the technology sector; <code>topics=technology,ipo</code> will filter for articles that <u>simultaneously</u> cover technology <u>and</u> IPO in their content. Below is the full list of supported topics:
-//
-//	<li>Blockchain: <code>blockchain</code></li>
-//	<li>Earnings: <code>earnings</code></li>
-//	<li>IPO: <code>ipo</code></li>
-//	<li>Mergers &amp; Acquisitions: <code>mergers_and_acquisitions</code></li>
-//	<li>Financial Markets: <code>financial_markets</code></li>
-//	<li>Economy - Fiscal Policy (e.g., tax reform, government spending): <code>economy_fiscal</code></li>
-//	<li>Economy - Monetary Policy (e.g., interest rates, inflation): <code>economy_monetary</code></li>
-//	<li>Economy - Macro/Overall: <code>economy_macro</code></li>
-//	<li>Energy &amp; Transportation: <code>energy_transportation</code></li>
-//	<li>Finance: <code>finance</code></li>
-//	<li>Life Sciences: <code>life_sciences</code></li>
-//	<li>Manufacturing: <code>manufacturing</code></li>
-//	<li>Real Estate &amp; Construction: <code>real_estate</code></li>
-//	<li>Retail &amp; Wholesale: <code>retail_wholesale</code></li>
-//	<li>Technology: <code>technology</code></li>
-//
-// -	opt_time_from: The time range of the news articles you are targeting, in YYYYMMDDTHHMM format. For example: <code>time_from=20220410T0130</code>. If time_from is specified but time_to is missing, the API will return articles published between the time_from value and the current time.
-// -	opt_sort: By default, <code>sort=LATEST</code> and the API will return the latest articles first. You can also set <code>sort=EARLIEST</code> or <code>sort=RELEVANCE</code> based on your use case.
-// -	opt_limit: By default, <code>limit=50</code> and the API will return up to 50 matching results. You can also set <code>limit=200</code> to output up to 200 results. If you are looking for an even higher output limit, please contact <a href="/cdn-cgi/l/email-protection" class="__cf_email__">[email protected]</a> to have your limit boosted.
-func (a *Alphavantage) GetNewsSentiment(opt_tickers, opt_topics, opt_time_from, opt_sort, opt_limit string) api.Response {
-	function := "NEWS_SENTIMENT"
-	params := map[string]string{
+//   - opt_tickers: The stock/crypto/forex symbols of your choice. For example: tickers=IBM will filter
+//     for articles that mention the IBM ticker; tickers=COIN,CRYPTO:BTC,FOREX:USD will filter for
+//     articles that simultaneously mention Coinbase (COIN), Bitcoin (CRYPTO:BTC), and US Dollar
+//     (FOREX:USD) in their content.
+//   - opt_topics: The news topics of your choice. For example: topics=technology will filter for
+//     articles that write about the technology sector; topics=technology,ipo will filter for articles
+//     that simultaneously cover technology and IPO in their content. Below is the full list of
+//     supported topics: Blockchain: blockchain; Earnings: earnings; IPO: ipo; Mergers & Acquisitions:
+//     mergers_and_acquisitions; Financial Markets: financial_markets; Economy - Fiscal Policy (e.g.,
+//     tax reform, government spending): economy_fiscal; Economy - Monetary Policy (e.g., interest
+//     rates, inflation): economy_monetary; Economy - Macro/Overall: economy_macro; Energy &
+//     Transportation: energy_transportation; Finance: finance; Life Sciences: life_sciences;
+//     Manufacturing: manufacturing; Real Estate & Construction: real_estate; Retail & Wholesale:
+//     retail_wholesale; Technology: technology
+//   - opt_time_from: The time range of the news articles you are targeting, in YYYYMMDDTHHMM format.
+//     For example: time_from=20220410T0130. If time_from is specified but time_to is missing, the API
+//     will return articles published between the time_from value and the current time.
+//   - opt_time_to: The time range of the news articles you are targeting, in YYYYMMDDTHHMM format. For
+//     example: time_from=20220410T0130. If time_from is specified but time_to is missing, the API will
+//     return articles published between the time_from value and the current time.
+//   - opt_sort: By default, sort=LATEST and the API will return the latest articles first. You can
+//     also set sort=EARLIEST or sort=RELEVANCE based on your use case.
+//   - opt_limit: By default, limit=50 and the API will return up to 50 matching results. You can also
+//     set limit=200 to output up to 200 results. If you are looking for an even higher output limit,
+//     please contact [Alpha Vantage support] to have your limit boosted.
+//
+// [Alpha Vantage support]: https://www.alphavantage.co/support/
+func (a *Alphavantage) GetNewsSentiment(opt_tickers, opt_topics, opt_time_from, opt_time_to, opt_sort, opt_limit string) api.Response {
+	return a.query("NEWS_SENTIMENT", newsSentimentParams(opt_tickers, opt_topics, opt_time_from, opt_time_to, opt_sort, opt_limit))
+}
+
+// NewsSentimentParams holds the parameters of [Alphavantage.QueryNewsSentiment].
+// See [Alphavantage.GetNewsSentiment] for their documentation.
+type NewsSentimentParams struct {
+	Tickers  string    // Optional; comma-separated list
+	Topics   string    // Optional; comma-separated list of blockchain, earnings, ipo, mergers_and_acquisitions, financial_markets, economy_fiscal, economy_monetary, economy_macro, energy_transportation, finance, life_sciences, manufacturing, real_estate, retail_wholesale, technology
+	TimeFrom time.Time // Optional
+	TimeTo   time.Time // Optional
+	Sort     string    // Optional; one of LATEST, EARLIEST, RELEVANCE; default LATEST
+	Limit    int       // Optional; default 50
+}
+
+// QueryNewsSentiment is the typed form of [Alphavantage.GetNewsSentiment].
+func (a *Alphavantage) QueryNewsSentiment(p NewsSentimentParams) api.Response {
+	return a.query("NEWS_SENTIMENT", newsSentimentParams(
+		p.Tickers,
+		p.Topics,
+		formatDateTime(p.TimeFrom),
+		formatDateTime(p.TimeTo),
+		p.Sort,
+		formatInt(p.Limit),
+	))
+}
+
+// newsSentimentParams maps the arguments of [Alphavantage.GetNewsSentiment] onto their query parameters.
+func newsSentimentParams(opt_tickers, opt_topics, opt_time_from, opt_time_to, opt_sort, opt_limit string) map[string]string {
+	return map[string]string{
 		"tickers":   opt_tickers,
 		"topics":    opt_topics,
 		"time_from": opt_time_from,
+		"time_to":   opt_time_to,
 		"sort":      opt_sort,
 		"limit":     opt_limit,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Endpoint Category: Technical Indicators
 // https://www.alphavantage.co/documentation/#technical-indicators
 //
-// Technical indicator APIs for a given equity or currency exchange pair, derived from the underlying time series based stock API and forex data. All indicators are calculated from adjusted time series data to eliminate artificial price/volume perturbations from historical split and dividend events.
+// Technical indicator APIs for a given equity or currency exchange pair, derived from the underlying
+// time series based stock API and forex data. All indicators are calculated from adjusted time series
+// data to eliminate artificial price/volume perturbations from historical split and dividend events.
 
 // SMA
-// This API returns the simple moving average (SMA) values. See also: <a href="http://www.investopedia.com/articles/technical/052201.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=SimpleMA.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the simple moving average (SMA) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#sma
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each moving average value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each moving average value. Positive
+//     integers are accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/052201.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=SimpleMA.htm
 func (a *Alphavantage) GetSma(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "SMA"
-	params := map[string]string{
+	return a.query("SMA", smaParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// SmaParams holds the parameters of [Alphavantage.QuerySma].
+// See [Alphavantage.GetSma] for their documentation.
+type SmaParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QuerySma is the typed form of [Alphavantage.GetSma].
+func (a *Alphavantage) QuerySma(p SmaParams) api.Response {
+	return a.query("SMA", smaParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// smaParams maps the arguments of [Alphavantage.GetSma] onto their query parameters.
+func smaParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // EMA
-// This API returns the exponential moving average (EMA) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=ExpMA.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the exponential moving average (EMA) values. See also: [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#ema
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each moving average value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each moving average value. Positive
+//     integers are accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=ExpMA.htm
 func (a *Alphavantage) GetEma(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "EMA"
-	params := map[string]string{
+	return a.query("EMA", emaParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// EmaParams holds the parameters of [Alphavantage.QueryEma].
+// See [Alphavantage.GetEma] for their documentation.
+type EmaParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryEma is the typed form of [Alphavantage.GetEma].
+func (a *Alphavantage) QueryEma(p EmaParams) api.Response {
+	return a.query("EMA", emaParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// emaParams maps the arguments of [Alphavantage.GetEma] onto their query parameters.
+func emaParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // WMA
-// This API returns the weighted moving average (WMA) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=WeightedMA.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the weighted moving average (WMA) values. See also: [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#wma
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each moving average value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each moving average value. Positive
+//     integers are accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=WeightedMA.htm
 func (a *Alphavantage) GetWma(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "WMA"
-	params := map[string]string{
+	return a.query("WMA", wmaParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// WmaParams holds the parameters of [Alphavantage.QueryWma].
+// See [Alphavantage.GetWma] for their documentation.
+type WmaParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryWma is the typed form of [Alphavantage.GetWma].
+func (a *Alphavantage) QueryWma(p WmaParams) api.Response {
+	return a.query("WMA", wmaParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// wmaParams maps the arguments of [Alphavantage.GetWma] onto their query parameters.
+func wmaParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // DEMA
-// This API returns the double exponential moving average (DEMA) values. See also: <a href="http://www.investopedia.com/articles/trading/10/double-exponential-moving-average.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=DEMA.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the double exponential moving average (DEMA) values. See also:
+// [Investopedia article] and [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#dema
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each moving average value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each moving average value. Positive
+//     integers are accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/trading/10/double-exponential-moving-average.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=DEMA.htm
 func (a *Alphavantage) GetDema(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "DEMA"
-	params := map[string]string{
+	return a.query("DEMA", demaParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// DemaParams holds the parameters of [Alphavantage.QueryDema].
+// See [Alphavantage.GetDema] for their documentation.
+type DemaParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryDema is the typed form of [Alphavantage.GetDema].
+func (a *Alphavantage) QueryDema(p DemaParams) api.Response {
+	return a.query("DEMA", demaParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// demaParams maps the arguments of [Alphavantage.GetDema] onto their query parameters.
+func demaParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // TEMA
-// This API returns the triple exponential moving average (TEMA) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=TEMA.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the triple exponential moving average (TEMA) values. See also:
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#tema
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each moving average value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each moving average value. Positive
+//     integers are accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=TEMA.htm
 func (a *Alphavantage) GetTema(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "TEMA"
-	params := map[string]string{
+	return a.query("TEMA", temaParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// TemaParams holds the parameters of [Alphavantage.QueryTema].
+// See [Alphavantage.GetTema] for their documentation.
+type TemaParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryTema is the typed form of [Alphavantage.GetTema].
+func (a *Alphavantage) QueryTema(p TemaParams) api.Response {
+	return a.query("TEMA", temaParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// temaParams maps the arguments of [Alphavantage.GetTema] onto their query parameters.
+func temaParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // TRIMA
-// This API returns the triangular moving average (TRIMA) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=TriangularMA.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the triangular moving average (TRIMA) values. See also: [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#trima
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each moving average value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each moving average value. Positive
+//     integers are accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=TriangularMA.htm
 func (a *Alphavantage) GetTrima(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "TRIMA"
-	params := map[string]string{
+	return a.query("TRIMA", trimaParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// TrimaParams holds the parameters of [Alphavantage.QueryTrima].
+// See [Alphavantage.GetTrima] for their documentation.
+type TrimaParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryTrima is the typed form of [Alphavantage.GetTrima].
+func (a *Alphavantage) QueryTrima(p TrimaParams) api.Response {
+	return a.query("TRIMA", trimaParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// trimaParams maps the arguments of [Alphavantage.GetTrima] onto their query parameters.
+func trimaParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // KAMA
+//
 // This API returns the Kaufman adaptive moving average (KAMA) values.
+//
 // https://www.alphavantage.co/documentation/#kama
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each moving average value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each moving average value. Positive
+//     integers are accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetKama(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "KAMA"
-	params := map[string]string{
+	return a.query("KAMA", kamaParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// KamaParams holds the parameters of [Alphavantage.QueryKama].
+// See [Alphavantage.GetKama] for their documentation.
+type KamaParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryKama is the typed form of [Alphavantage.GetKama].
+func (a *Alphavantage) QueryKama(p KamaParams) api.Response {
+	return a.query("KAMA", kamaParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// kamaParams maps the arguments of [Alphavantage.GetKama] onto their query parameters.
+func kamaParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // MAMA
+//
 // This API returns the MESA adaptive moving average (MAMA) values.
+//
 // https://www.alphavantage.co/documentation/#mama
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_fastlimit: Positive floats are accepted. By default, <code>fastlimit=0.01</code>.
-// -	opt_slowlimit: Positive floats are accepted. By default, <code>slowlimit=0.01</code>.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_fastlimit: Positive floats are accepted. By default, fastlimit=0.01.
+//   - opt_slowlimit: Positive floats are accepted. By default, slowlimit=0.01.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetMama(symbol, interval, series_type, opt_fastlimit, opt_slowlimit, opt_datatype string) api.Response {
-	function := "MAMA"
-	params := map[string]string{
+	return a.query("MAMA", mamaParams(symbol, interval, series_type, opt_fastlimit, opt_slowlimit, opt_datatype))
+}
+
+// MamaParams holds the parameters of [Alphavantage.QueryMama].
+// See [Alphavantage.GetMama] for their documentation.
+type MamaParams struct {
+	Symbol     string
+	Interval   Interval   // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	SeriesType SeriesType // One of close, open, high, low
+	Fastlimit  float64    // Optional; default 0.01
+	Slowlimit  float64    // Optional; default 0.01
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryMama is the typed form of [Alphavantage.GetMama].
+func (a *Alphavantage) QueryMama(p MamaParams) api.Response {
+	return a.query("MAMA", mamaParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.SeriesType),
+		formatFloat(p.Fastlimit),
+		formatFloat(p.Slowlimit),
+		string(p.Datatype),
+	))
+}
+
+// mamaParams maps the arguments of [Alphavantage.GetMama] onto their query parameters.
+func mamaParams(symbol, interval, series_type, opt_fastlimit, opt_slowlimit, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"series_type": series_type,
@@ -927,67 +2242,165 @@ func (a *Alphavantage) GetMama(symbol, interval, series_type, opt_fastlimit, opt
 		"slowlimit":   opt_slowlimit,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // VWAP
-// This API returns the volume weighted average price (VWAP) for <u>intraday</u> time series. See also: <a href="https://www.investopedia.com/terms/v/vwap.asp" target="_blank">Investopedia article</a>.
+//
+// This API returns the volume weighted average price (VWAP) for intraday time series. See also:
+// [Investopedia article].
+//
 // https://www.alphavantage.co/documentation/#vwap
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. In keeping with mainstream investment literatures on VWAP, the following intraday intervals are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. In keeping with
+//     mainstream investment literatures on VWAP, the following intraday intervals are supported: 1min,
+//     5min, 15min, 30min, 60min
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: https://www.investopedia.com/terms/v/vwap.asp
 func (a *Alphavantage) GetVwap(symbol, interval, opt_datatype string) api.Response {
-	function := "VWAP"
-	params := map[string]string{
+	return a.query("VWAP", vwapParams(symbol, interval, opt_datatype))
+}
+
+// VwapParams holds the parameters of [Alphavantage.QueryVwap].
+// See [Alphavantage.GetVwap] for their documentation.
+type VwapParams struct {
+	Symbol   string
+	Interval Interval // One of 1min, 5min, 15min, 30min, 60min
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryVwap is the typed form of [Alphavantage.GetVwap].
+func (a *Alphavantage) QueryVwap(p VwapParams) api.Response {
+	return a.query("VWAP", vwapParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// vwapParams maps the arguments of [Alphavantage.GetVwap] onto their query parameters.
+func vwapParams(symbol, interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":   symbol,
 		"interval": interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // T3
-// This API returns the triple exponential moving average (T3) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=T3.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the triple exponential moving average (T3) values. See also:
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#t3
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each moving average value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each moving average value. Positive
+//     integers are accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=T3.htm
 func (a *Alphavantage) GetT3(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "T3"
-	params := map[string]string{
+	return a.query("T3", t3Params(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// T3Params holds the parameters of [Alphavantage.QueryT3].
+// See [Alphavantage.GetT3] for their documentation.
+type T3Params struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryT3 is the typed form of [Alphavantage.GetT3].
+func (a *Alphavantage) QueryT3(p T3Params) api.Response {
+	return a.query("T3", t3Params(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// t3Params maps the arguments of [Alphavantage.GetT3] onto their query parameters.
+func t3Params(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // MACD
-// This API returns the moving average convergence / divergence (MACD) values. See also: <a href="http://www.investopedia.com/articles/forex/05/macddiverge.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=MACD.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the moving average convergence / divergence (MACD) values. See also:
+// [Investopedia article] and [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#macd
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_fastperiod: Positive integers are accepted. By default, <code>fastperiod=12</code>.
-// -	opt_slowperiod: Positive integers are accepted. By default, <code>slowperiod=26</code>.
-// -	opt_signalperiod: Positive integers are accepted. By default, <code>signalperiod=9</code>.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_fastperiod: Positive integers are accepted. By default, fastperiod=12.
+//   - opt_slowperiod: Positive integers are accepted. By default, slowperiod=26.
+//   - opt_signalperiod: Positive integers are accepted. By default, signalperiod=9.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/forex/05/macddiverge.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=MACD.htm
 func (a *Alphavantage) GetMacd(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_datatype string) api.Response {
-	function := "MACD"
-	params := map[string]string{
+	return a.query("MACD", macdParams(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_datatype))
+}
+
+// MacdParams holds the parameters of [Alphavantage.QueryMacd].
+// See [Alphavantage.GetMacd] for their documentation.
+type MacdParams struct {
+	Symbol       string
+	Interval     Interval   // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	SeriesType   SeriesType // One of close, open, high, low
+	Fastperiod   int        // Optional; default 12
+	Slowperiod   int        // Optional; default 26
+	Signalperiod int        // Optional; default 9
+	Datatype     DataType   // Optional; one of json, csv; default json
+}
+
+// QueryMacd is the typed form of [Alphavantage.GetMacd].
+func (a *Alphavantage) QueryMacd(p MacdParams) api.Response {
+	return a.query("MACD", macdParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.SeriesType),
+		formatInt(p.Fastperiod),
+		formatInt(p.Slowperiod),
+		formatInt(p.Signalperiod),
+		string(p.Datatype),
+	))
+}
+
+// macdParams maps the arguments of [Alphavantage.GetMacd] onto their query parameters.
+func macdParams(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":       symbol,
 		"interval":     interval,
 		"series_type":  series_type,
@@ -996,28 +2409,86 @@ func (a *Alphavantage) GetMacd(symbol, interval, series_type, opt_fastperiod, op
 		"signalperiod": opt_signalperiod,
 		"datatype":     opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // MACDEXT
-// This API returns the moving average convergence / divergence values with controllable moving average type. See also: <a href="http://www.investopedia.com/articles/forex/05/macddiverge.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=MACD.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the moving average convergence / divergence values with controllable moving average
+// type. See also: [Investopedia article] and [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#macdext
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_fastperiod: Positive integers are accepted. By default, <code>fastperiod=12</code>.
-// -	opt_slowperiod: Positive integers are accepted. By default, <code>slowperiod=26</code>.
-// -	opt_signalperiod: Positive integers are accepted. By default, <code>signalperiod=9</code>.
-// -	opt_fastmatype: Moving average type for the faster moving average. By default, <code>fastmatype=0</code>. Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 = Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average (MAMA).
-// -	opt_slowmatype: Moving average type for the slower moving average. By default, <code>slowmatype=0</code>. Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 = Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average (MAMA).
-// -	opt_signalmatype: Moving average type for the signal moving average. By default, <code>signalmatype=0</code>. Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 = Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average (MAMA).
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_fastperiod: Positive integers are accepted. By default, fastperiod=12.
+//   - opt_slowperiod: Positive integers are accepted. By default, slowperiod=26.
+//   - opt_signalperiod: Positive integers are accepted. By default, signalperiod=9.
+//   - opt_fastmatype: Moving average type for the faster moving average. By default, fastmatype=0.
+//     Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 =
+//     Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential
+//     Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving
+//     Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA
+//     Adaptive Moving Average (MAMA).
+//   - opt_slowmatype: Moving average type for the slower moving average. By default, slowmatype=0.
+//     Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 =
+//     Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential
+//     Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving
+//     Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA
+//     Adaptive Moving Average (MAMA).
+//   - opt_signalmatype: Moving average type for the signal moving average. By default, signalmatype=0.
+//     Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 =
+//     Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential
+//     Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving
+//     Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA
+//     Adaptive Moving Average (MAMA).
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/forex/05/macddiverge.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=MACD.htm
 func (a *Alphavantage) GetMacdext(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_fastmatype, opt_slowmatype, opt_signalmatype, opt_datatype string) api.Response {
-	function := "MACDEXT"
-	params := map[string]string{
+	return a.query("MACDEXT", macdextParams(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_fastmatype, opt_slowmatype, opt_signalmatype, opt_datatype))
+}
+
+// MacdextParams holds the parameters of [Alphavantage.QueryMacdext].
+// See [Alphavantage.GetMacdext] for their documentation.
+type MacdextParams struct {
+	Symbol       string
+	Interval     Interval   // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	SeriesType   SeriesType // One of close, open, high, low
+	Fastperiod   int        // Optional; default 12
+	Slowperiod   int        // Optional; default 26
+	Signalperiod int        // Optional; default 9
+	Fastmatype   MAType     // Optional; one of 0, 1, 2, 3, 4, 5, 6, 7, 8; default 0
+	Slowmatype   MAType     // Optional; one of 0, 1, 2, 3, 4, 5, 6, 7, 8; default 0
+	Signalmatype MAType     // Optional; one of 0, 1, 2, 3, 4, 5, 6, 7, 8; default 0
+	Datatype     DataType   // Optional; one of json, csv; default json
+}
+
+// QueryMacdext is the typed form of [Alphavantage.GetMacdext].
+func (a *Alphavantage) QueryMacdext(p MacdextParams) api.Response {
+	return a.query("MACDEXT", macdextParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.SeriesType),
+		formatInt(p.Fastperiod),
+		formatInt(p.Slowperiod),
+		formatInt(p.Signalperiod),
+		formatInt(int(p.Fastmatype)),
+		formatInt(int(p.Slowmatype)),
+		formatInt(int(p.Signalmatype)),
+		string(p.Datatype),
+	))
+}
+
+// macdextParams maps the arguments of [Alphavantage.GetMacdext] onto their query parameters.
+func macdextParams(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_fastmatype, opt_slowmatype, opt_signalmatype, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":       symbol,
 		"interval":     interval,
 		"series_type":  series_type,
@@ -1029,26 +2500,77 @@ func (a *Alphavantage) GetMacdext(symbol, interval, series_type, opt_fastperiod,
 		"signalmatype": opt_signalmatype,
 		"datatype":     opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // [PREMIUM] STOCH
-// This API returns the stochastic oscillator (STOCH) values. See also: <a href="https://www.investopedia.com/terms/s/stochasticoscillator.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=StochasticOscillator.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the stochastic oscillator (STOCH) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#stoch
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	opt_fastkperiod: The time period of the fastk moving average. Positive integers are accepted. By default, <code>fastkperiod=5</code>.
-// -	opt_slowkperiod: The time period of the slowk moving average. Positive integers are accepted. By default, <code>slowkperiod=3</code>.
-// -	opt_slowdperiod: The time period of the slowd moving average. Positive integers are accepted. By default, <code>slowdperiod=3</code>.
-// -	opt_slowkmatype: Moving average type for the slowk moving average. By default, <code>slowkmatype=0</code>. Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 = Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average (MAMA).
-// -	opt_slowdmatype: Moving average type for the slowd moving average. By default, <code>slowdmatype=0</code>. Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 = Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average (MAMA).
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - opt_fastkperiod: The time period of the fastk moving average. Positive integers are accepted. By
+//     default, fastkperiod=5.
+//   - opt_slowkperiod: The time period of the slowk moving average. Positive integers are accepted. By
+//     default, slowkperiod=3.
+//   - opt_slowdperiod: The time period of the slowd moving average. Positive integers are accepted. By
+//     default, slowdperiod=3.
+//   - opt_slowkmatype: Moving average type for the slowk moving average. By default, slowkmatype=0.
+//     Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 =
+//     Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential
+//     Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving
+//     Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA
+//     Adaptive Moving Average (MAMA).
+//   - opt_slowdmatype: Moving average type for the slowd moving average. By default, slowdmatype=0.
+//     Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 =
+//     Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential
+//     Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving
+//     Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA
+//     Adaptive Moving Average (MAMA).
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: https://www.investopedia.com/terms/s/stochasticoscillator.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=StochasticOscillator.htm
 func (a *Alphavantage) GetStoch(symbol, interval, opt_fastkperiod, opt_slowkperiod, opt_slowdperiod, opt_slowkmatype, opt_slowdmatype, opt_datatype string) api.Response {
-	function := "STOCH"
-	params := map[string]string{
+	return a.query("STOCH", stochParams(symbol, interval, opt_fastkperiod, opt_slowkperiod, opt_slowdperiod, opt_slowkmatype, opt_slowdmatype, opt_datatype))
+}
+
+// StochParams holds the parameters of [Alphavantage.QueryStoch].
+// See [Alphavantage.GetStoch] for their documentation.
+type StochParams struct {
+	Symbol      string
+	Interval    Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	Fastkperiod int      // Optional; default 5
+	Slowkperiod int      // Optional; default 3
+	Slowdperiod int      // Optional; default 3
+	Slowkmatype MAType   // Optional; one of 0, 1, 2, 3, 4, 5, 6, 7, 8; default 0
+	Slowdmatype MAType   // Optional; one of 0, 1, 2, 3, 4, 5, 6, 7, 8; default 0
+	Datatype    DataType // Optional; one of json, csv; default json
+}
+
+// QueryStoch is the typed form of [Alphavantage.GetStoch].
+func (a *Alphavantage) QueryStoch(p StochParams) api.Response {
+	return a.query("STOCH", stochParams(
+		p.Symbol,
+		string(p.Interval),
+		formatInt(p.Fastkperiod),
+		formatInt(p.Slowkperiod),
+		formatInt(p.Slowdperiod),
+		formatInt(int(p.Slowkmatype)),
+		formatInt(int(p.Slowdmatype)),
+		string(p.Datatype),
+	))
+}
+
+// stochParams maps the arguments of [Alphavantage.GetStoch] onto their query parameters.
+func stochParams(symbol, interval, opt_fastkperiod, opt_slowkperiod, opt_slowdperiod, opt_slowkmatype, opt_slowdmatype, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"fastkperiod": opt_fastkperiod,
@@ -1058,24 +2580,65 @@ func (a *Alphavantage) GetStoch(symbol, interval, opt_fastkperiod, opt_slowkperi
 		"slowdmatype": opt_slowdmatype,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // STOCHF
-// This API returns the stochastic fast (STOCHF) values. See also: <a href="http://www.investopedia.com/university/indicator_oscillator/ind_osc8.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=StochasticOscillator.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the stochastic fast (STOCHF) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#stochf
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	opt_fastkperiod: The time period of the fastk moving average. Positive integers are accepted. By default, <code>fastkperiod=5</code>.
-// -	opt_fastdperiod: The time period of the fastd moving average. Positive integers are accepted. By default, <code>fastdperiod=3</code>.
-// -	opt_fastdmatype: Moving average type for the fastd moving average. By default, <code>fastdmatype=0</code>. Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 = Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average (MAMA).
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - opt_fastkperiod: The time period of the fastk moving average. Positive integers are accepted. By
+//     default, fastkperiod=5.
+//   - opt_fastdperiod: The time period of the fastd moving average. Positive integers are accepted. By
+//     default, fastdperiod=3.
+//   - opt_fastdmatype: Moving average type for the fastd moving average. By default, fastdmatype=0.
+//     Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 =
+//     Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential
+//     Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving
+//     Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA
+//     Adaptive Moving Average (MAMA).
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/university/indicator_oscillator/ind_osc8.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=StochasticOscillator.htm
 func (a *Alphavantage) GetStochf(symbol, interval, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype string) api.Response {
-	function := "STOCHF"
-	params := map[string]string{
+	return a.query("STOCHF", stochfParams(symbol, interval, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype))
+}
+
+// StochfParams holds the parameters of [Alphavantage.QueryStochf].
+// See [Alphavantage.GetStochf] for their documentation.
+type StochfParams struct {
+	Symbol      string
+	Interval    Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	Fastkperiod int      // Optional; default 5
+	Fastdperiod int      // Optional; default 3
+	Fastdmatype MAType   // Optional; one of 0, 1, 2, 3, 4, 5, 6, 7, 8; default 0
+	Datatype    DataType // Optional; one of json, csv; default json
+}
+
+// QueryStochf is the typed form of [Alphavantage.GetStochf].
+func (a *Alphavantage) QueryStochf(p StochfParams) api.Response {
+	return a.query("STOCHF", stochfParams(
+		p.Symbol,
+		string(p.Interval),
+		formatInt(p.Fastkperiod),
+		formatInt(p.Fastdperiod),
+		formatInt(int(p.Fastdmatype)),
+		string(p.Datatype),
+	))
+}
+
+// stochfParams maps the arguments of [Alphavantage.GetStochf] onto their query parameters.
+func stochfParams(symbol, interval, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"fastkperiod": opt_fastkperiod,
@@ -1083,49 +2646,129 @@ func (a *Alphavantage) GetStochf(symbol, interval, opt_fastkperiod, opt_fastdper
 		"fastdmatype": opt_fastdmatype,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // [PREMIUM] RSI
-// This API returns the relative strength index (RSI) values. See also: <a href="http://www.investopedia.com/articles/technical/071601.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=RSI.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the relative strength index (RSI) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#rsi
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each RSI value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each RSI value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/071601.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=RSI.htm
 func (a *Alphavantage) GetRsi(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "RSI"
-	params := map[string]string{
+	return a.query("RSI", rsiParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// RsiParams holds the parameters of [Alphavantage.QueryRsi].
+// See [Alphavantage.GetRsi] for their documentation.
+type RsiParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryRsi is the typed form of [Alphavantage.GetRsi].
+func (a *Alphavantage) QueryRsi(p RsiParams) api.Response {
+	return a.query("RSI", rsiParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// rsiParams maps the arguments of [Alphavantage.GetRsi] onto their query parameters.
+func rsiParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // STOCHRSI
-// This API returns the stochastic relative strength index (STOCHRSI) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=StochRSI.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the stochastic relative strength index (STOCHRSI) values. See also:
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#stochrsi
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each STOCHRSI value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_fastkperiod: The time period of the fastk moving average. Positive integers are accepted. By default, <code>fastkperiod=5</code>.
-// -	opt_fastdperiod: The time period of the fastd moving average. Positive integers are accepted. By default, <code>fastdperiod=3</code>.
-// -	opt_fastdmatype: Moving average type for the fastd moving average. By default, <code>fastdmatype=0</code>. Integers 0 - 8 are accepted with the following mappings.  0 = Simple Moving Average (SMA), 1 = Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average (MAMA).
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each STOCHRSI value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_fastkperiod: The time period of the fastk moving average. Positive integers are accepted. By
+//     default, fastkperiod=5.
+//   - opt_fastdperiod: The time period of the fastd moving average. Positive integers are accepted. By
+//     default, fastdperiod=3.
+//   - opt_fastdmatype: Moving average type for the fastd moving average. By default, fastdmatype=0.
+//     Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 =
+//     Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential
+//     Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving
+//     Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA
+//     Adaptive Moving Average (MAMA).
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=StochRSI.htm
 func (a *Alphavantage) GetStochrsi(symbol, interval, time_period, series_type, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype string) api.Response {
-	function := "STOCHRSI"
-	params := map[string]string{
+	return a.query("STOCHRSI", stochrsiParams(symbol, interval, time_period, series_type, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype))
+}
+
+// StochrsiParams holds the parameters of [Alphavantage.QueryStochrsi].
+// See [Alphavantage.GetStochrsi] for their documentation.
+type StochrsiParams struct {
+	Symbol      string
+	Interval    Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod  int
+	SeriesType  SeriesType // One of close, open, high, low
+	Fastkperiod int        // Optional; default 5
+	Fastdperiod int        // Optional; default 3
+	Fastdmatype MAType     // Optional; one of 0, 1, 2, 3, 4, 5, 6, 7, 8; default 0
+	Datatype    DataType   // Optional; one of json, csv; default json
+}
+
+// QueryStochrsi is the typed form of [Alphavantage.GetStochrsi].
+func (a *Alphavantage) QueryStochrsi(p StochrsiParams) api.Response {
+	return a.query("STOCHRSI", stochrsiParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		formatInt(p.Fastkperiod),
+		formatInt(p.Fastdperiod),
+		formatInt(int(p.Fastdmatype)),
+		string(p.Datatype),
+	))
+}
+
+// stochrsiParams maps the arguments of [Alphavantage.GetStochrsi] onto their query parameters.
+func stochrsiParams(symbol, interval, time_period, series_type, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
@@ -1135,88 +2778,217 @@ func (a *Alphavantage) GetStochrsi(symbol, interval, time_period, series_type, o
 		"fastdmatype": opt_fastdmatype,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // WILLR
-// This API returns the Williams&#39; %R (WILLR) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=WilliamsR.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the Williams' %R (WILLR) values. See also: [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#willr
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each WILLR value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each WILLR value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=WilliamsR.htm
 func (a *Alphavantage) GetWillr(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "WILLR"
-	params := map[string]string{
+	return a.query("WILLR", willrParams(symbol, interval, time_period, opt_datatype))
+}
+
+// WillrParams holds the parameters of [Alphavantage.QueryWillr].
+// See [Alphavantage.GetWillr] for their documentation.
+type WillrParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryWillr is the typed form of [Alphavantage.GetWillr].
+func (a *Alphavantage) QueryWillr(p WillrParams) api.Response {
+	return a.query("WILLR", willrParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// willrParams maps the arguments of [Alphavantage.GetWillr] onto their query parameters.
+func willrParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // [PREMIUM] ADX
-// This API returns the average directional movement index (ADX) values. See also: <a href="http://www.investopedia.com/articles/trading/07/adx-trend-indicator.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=ADX.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the average directional movement index (ADX) values. See also:
+// [Investopedia article] and [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#adx
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each ADX value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each ADX value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/trading/07/adx-trend-indicator.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=ADX.htm
 func (a *Alphavantage) GetAdx(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "ADX"
-	params := map[string]string{
+	return a.query("ADX", adxParams(symbol, interval, time_period, opt_datatype))
+}
+
+// AdxParams holds the parameters of [Alphavantage.QueryAdx].
+// See [Alphavantage.GetAdx] for their documentation.
+type AdxParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryAdx is the typed form of [Alphavantage.GetAdx].
+func (a *Alphavantage) QueryAdx(p AdxParams) api.Response {
+	return a.query("ADX", adxParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// adxParams maps the arguments of [Alphavantage.GetAdx] onto their query parameters.
+func adxParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // ADXR
-// This API returns the average directional movement index rating (ADXR) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=ADXR.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the average directional movement index rating (ADXR) values. See also:
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#adxr
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each ADXR value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each ADXR value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=ADXR.htm
 func (a *Alphavantage) GetAdxr(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "ADXR"
-	params := map[string]string{
+	return a.query("ADXR", adxrParams(symbol, interval, time_period, opt_datatype))
+}
+
+// AdxrParams holds the parameters of [Alphavantage.QueryAdxr].
+// See [Alphavantage.GetAdxr] for their documentation.
+type AdxrParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryAdxr is the typed form of [Alphavantage.GetAdxr].
+func (a *Alphavantage) QueryAdxr(p AdxrParams) api.Response {
+	return a.query("ADXR", adxrParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// adxrParams maps the arguments of [Alphavantage.GetAdxr] onto their query parameters.
+func adxrParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // APO
-// This API returns the absolute price oscillator (APO) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=PriceOscillator.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the absolute price oscillator (APO) values. See also: [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#apo
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_fastperiod: Positive integers are accepted. By default, <code>fastperiod=12</code>.
-// -	opt_slowperiod: Positive integers are accepted. By default, <code>slowperiod=26</code>.
-// -	opt_matype: Moving average type. By default, <code>matype=0</code>. Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 = Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average (MAMA).
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_fastperiod: Positive integers are accepted. By default, fastperiod=12.
+//   - opt_slowperiod: Positive integers are accepted. By default, slowperiod=26.
+//   - opt_matype: Moving average type. By default, matype=0. Integers 0 - 8 are accepted with the
+//     following mappings. 0 = Simple Moving Average (SMA), 1 = Exponential Moving Average (EMA), 2 =
+//     Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA), 4 = Triple
+//     Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3 Moving Average,
+//     7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average (MAMA).
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=PriceOscillator.htm
 func (a *Alphavantage) GetApo(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype string) api.Response {
-	function := "APO"
-	params := map[string]string{
+	return a.query("APO", apoParams(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype))
+}
+
+// ApoParams holds the parameters of [Alphavantage.QueryApo].
+// See [Alphavantage.GetApo] for their documentation.
+type ApoParams struct {
+	Symbol     string
+	Interval   Interval   // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	SeriesType SeriesType // One of close, open, high, low
+	Fastperiod int        // Optional; default 12
+	Slowperiod int        // Optional; default 26
+	Matype     MAType     // Optional; one of 0, 1, 2, 3, 4, 5, 6, 7, 8; default 0
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryApo is the typed form of [Alphavantage.GetApo].
+func (a *Alphavantage) QueryApo(p ApoParams) api.Response {
+	return a.query("APO", apoParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.SeriesType),
+		formatInt(p.Fastperiod),
+		formatInt(p.Slowperiod),
+		formatInt(int(p.Matype)),
+		string(p.Datatype),
+	))
+}
+
+// apoParams maps the arguments of [Alphavantage.GetApo] onto their query parameters.
+func apoParams(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"series_type": series_type,
@@ -1225,25 +2997,66 @@ func (a *Alphavantage) GetApo(symbol, interval, series_type, opt_fastperiod, opt
 		"matype":      opt_matype,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // PPO
-// This API returns the percentage price oscillator (PPO) values. See also: <a href="http://www.investopedia.com/articles/investing/051214/use-percentage-price-oscillator-elegant-indicator-picking-stocks.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=PriceOscillatorPct.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the percentage price oscillator (PPO) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#ppo
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_fastperiod: Positive integers are accepted. By default, <code>fastperiod=12</code>.
-// -	opt_slowperiod: Positive integers are accepted. By default, <code>slowperiod=26</code>.
-// -	opt_matype: Moving average type. By default, <code>matype=0</code>. Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 = Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average (MAMA).
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_fastperiod: Positive integers are accepted. By default, fastperiod=12.
+//   - opt_slowperiod: Positive integers are accepted. By default, slowperiod=26.
+//   - opt_matype: Moving average type. By default, matype=0. Integers 0 - 8 are accepted with the
+//     following mappings. 0 = Simple Moving Average (SMA), 1 = Exponential Moving Average (EMA), 2 =
+//     Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA), 4 = Triple
+//     Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3 Moving Average,
+//     7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average (MAMA).
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/investing/051214/use-percentage-price-oscillator-elegant-indicator-picking-stocks.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=PriceOscillatorPct.htm
 func (a *Alphavantage) GetPpo(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype string) api.Response {
-	function := "PPO"
-	params := map[string]string{
+	return a.query("PPO", ppoParams(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype))
+}
+
+// PpoParams holds the parameters of [Alphavantage.QueryPpo].
+// See [Alphavantage.GetPpo] for their documentation.
+type PpoParams struct {
+	Symbol     string
+	Interval   Interval   // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	SeriesType SeriesType // One of close, open, high, low
+	Fastperiod int        // Optional; default 12
+	Slowperiod int        // Optional; default 26
+	Matype     MAType     // Optional; one of 0, 1, 2, 3, 4, 5, 6, 7, 8; default 0
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryPpo is the typed form of [Alphavantage.GetPpo].
+func (a *Alphavantage) QueryPpo(p PpoParams) api.Response {
+	return a.query("PPO", ppoParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.SeriesType),
+		formatInt(p.Fastperiod),
+		formatInt(p.Slowperiod),
+		formatInt(int(p.Matype)),
+		string(p.Datatype),
+	))
+}
+
+// ppoParams maps the arguments of [Alphavantage.GetPpo] onto their query parameters.
+func ppoParams(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"series_type": series_type,
@@ -1252,242 +3065,587 @@ func (a *Alphavantage) GetPpo(symbol, interval, series_type, opt_fastperiod, opt
 		"matype":      opt_matype,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // MOM
-// This API returns the momentum (MOM) values. See also: <a href="http://www.investopedia.com/articles/technical/03/070203.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=Momentum.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the momentum (MOM) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#mom
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each MOM value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each MOM value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/03/070203.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=Momentum.htm
 func (a *Alphavantage) GetMom(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "MOM"
-	params := map[string]string{
+	return a.query("MOM", momParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// MomParams holds the parameters of [Alphavantage.QueryMom].
+// See [Alphavantage.GetMom] for their documentation.
+type MomParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryMom is the typed form of [Alphavantage.GetMom].
+func (a *Alphavantage) QueryMom(p MomParams) api.Response {
+	return a.query("MOM", momParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// momParams maps the arguments of [Alphavantage.GetMom] onto their query parameters.
+func momParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // BOP
+//
 // This API returns the balance of power (BOP) values.
+//
 // https://www.alphavantage.co/documentation/#bop
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetBop(symbol, interval, opt_datatype string) api.Response {
-	function := "BOP"
-	params := map[string]string{
+	return a.query("BOP", bopParams(symbol, interval, opt_datatype))
+}
+
+// BopParams holds the parameters of [Alphavantage.QueryBop].
+// See [Alphavantage.GetBop] for their documentation.
+type BopParams struct {
+	Symbol   string
+	Interval Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryBop is the typed form of [Alphavantage.GetBop].
+func (a *Alphavantage) QueryBop(p BopParams) api.Response {
+	return a.query("BOP", bopParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// bopParams maps the arguments of [Alphavantage.GetBop] onto their query parameters.
+func bopParams(symbol, interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":   symbol,
 		"interval": interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // [PREMIUM] CCI
-// This API returns the commodity channel index (CCI) values. See also: <a href="http://www.investopedia.com/articles/trading/05/041805.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=CCI.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the commodity channel index (CCI) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#cci
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each CCI value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each CCI value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/trading/05/041805.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=CCI.htm
 func (a *Alphavantage) GetCci(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "CCI"
-	params := map[string]string{
+	return a.query("CCI", cciParams(symbol, interval, time_period, opt_datatype))
+}
+
+// CciParams holds the parameters of [Alphavantage.QueryCci].
+// See [Alphavantage.GetCci] for their documentation.
+type CciParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryCci is the typed form of [Alphavantage.GetCci].
+func (a *Alphavantage) QueryCci(p CciParams) api.Response {
+	return a.query("CCI", cciParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// cciParams maps the arguments of [Alphavantage.GetCci] onto their query parameters.
+func cciParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // CMO
-// This API returns the Chande momentum oscillator (CMO) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=CMO.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the Chande momentum oscillator (CMO) values. See also: [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#cmo
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each CMO value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each CMO value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=CMO.htm
 func (a *Alphavantage) GetCmo(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "CMO"
-	params := map[string]string{
+	return a.query("CMO", cmoParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// CmoParams holds the parameters of [Alphavantage.QueryCmo].
+// See [Alphavantage.GetCmo] for their documentation.
+type CmoParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryCmo is the typed form of [Alphavantage.GetCmo].
+func (a *Alphavantage) QueryCmo(p CmoParams) api.Response {
+	return a.query("CMO", cmoParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// cmoParams maps the arguments of [Alphavantage.GetCmo] onto their query parameters.
+func cmoParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // ROC
-// This API returns the rate of change (ROC) values. See also: <a href="http://www.investopedia.com/articles/technical/092401.asp" target="_blank">Investopedia article</a>.
+//
+// This API returns the rate of change (ROC) values. See also: [Investopedia article].
+//
 // https://www.alphavantage.co/documentation/#roc
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each ROC value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each ROC value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/092401.asp
 func (a *Alphavantage) GetRoc(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "ROC"
-	params := map[string]string{
+	return a.query("ROC", rocParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// RocParams holds the parameters of [Alphavantage.QueryRoc].
+// See [Alphavantage.GetRoc] for their documentation.
+type RocParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryRoc is the typed form of [Alphavantage.GetRoc].
+func (a *Alphavantage) QueryRoc(p RocParams) api.Response {
+	return a.query("ROC", rocParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// rocParams maps the arguments of [Alphavantage.GetRoc] onto their query parameters.
+func rocParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // ROCR
-// This API returns the rate of change ratio (ROCR) values. See also: <a href="http://www.investopedia.com/articles/technical/092401.asp" target="_blank">Investopedia article</a>.
+//
+// This API returns the rate of change ratio (ROCR) values. See also: [Investopedia article].
+//
 // https://www.alphavantage.co/documentation/#rocr
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each ROCR value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each ROCR value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/092401.asp
 func (a *Alphavantage) GetRocr(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "ROCR"
-	params := map[string]string{
+	return a.query("ROCR", rocrParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// RocrParams holds the parameters of [Alphavantage.QueryRocr].
+// See [Alphavantage.GetRocr] for their documentation.
+type RocrParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryRocr is the typed form of [Alphavantage.GetRocr].
+func (a *Alphavantage) QueryRocr(p RocrParams) api.Response {
+	return a.query("ROCR", rocrParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// rocrParams maps the arguments of [Alphavantage.GetRocr] onto their query parameters.
+func rocrParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // AROON
-// This API returns the Aroon (AROON) values. See also: <a href="http://www.investopedia.com/articles/trading/06/aroon.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=Aroon.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the Aroon (AROON) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#aroon
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each AROON value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each AROON value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/trading/06/aroon.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=Aroon.htm
 func (a *Alphavantage) GetAroon(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "AROON"
-	params := map[string]string{
+	return a.query("AROON", aroonParams(symbol, interval, time_period, opt_datatype))
+}
+
+// AroonParams holds the parameters of [Alphavantage.QueryAroon].
+// See [Alphavantage.GetAroon] for their documentation.
+type AroonParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryAroon is the typed form of [Alphavantage.GetAroon].
+func (a *Alphavantage) QueryAroon(p AroonParams) api.Response {
+	return a.query("AROON", aroonParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// aroonParams maps the arguments of [Alphavantage.GetAroon] onto their query parameters.
+func aroonParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // AROONOSC
-// This API returns the Aroon oscillator (AROONOSC) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=AroonOscillator.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the Aroon oscillator (AROONOSC) values. See also: [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#aroonosc
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each AROONOSC value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each AROONOSC value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=AroonOscillator.htm
 func (a *Alphavantage) GetAroonosc(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "AROONOSC"
-	params := map[string]string{
+	return a.query("AROONOSC", aroonoscParams(symbol, interval, time_period, opt_datatype))
+}
+
+// AroonoscParams holds the parameters of [Alphavantage.QueryAroonosc].
+// See [Alphavantage.GetAroonosc] for their documentation.
+type AroonoscParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryAroonosc is the typed form of [Alphavantage.GetAroonosc].
+func (a *Alphavantage) QueryAroonosc(p AroonoscParams) api.Response {
+	return a.query("AROONOSC", aroonoscParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// aroonoscParams maps the arguments of [Alphavantage.GetAroonosc] onto their query parameters.
+func aroonoscParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // MFI
-// This API returns the money flow index (MFI) values. See also: <a href="http://www.investopedia.com/articles/technical/03/072303.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=MoneyFlowIndex.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the money flow index (MFI) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#mfi
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each MFI value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each MFI value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/03/072303.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=MoneyFlowIndex.htm
 func (a *Alphavantage) GetMfi(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "MFI"
-	params := map[string]string{
+	return a.query("MFI", mfiParams(symbol, interval, time_period, opt_datatype))
+}
+
+// MfiParams holds the parameters of [Alphavantage.QueryMfi].
+// See [Alphavantage.GetMfi] for their documentation.
+type MfiParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryMfi is the typed form of [Alphavantage.GetMfi].
+func (a *Alphavantage) QueryMfi(p MfiParams) api.Response {
+	return a.query("MFI", mfiParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// mfiParams maps the arguments of [Alphavantage.GetMfi] onto their query parameters.
+func mfiParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // TRIX
-// This API returns the 1-day rate of change of a triple smooth exponential moving average (TRIX) values. See also: <a href="http://www.investopedia.com/articles/technical/02/092402.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=TRIX.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the 1-day rate of change of a triple smooth exponential moving average (TRIX)
+// values. See also: [Investopedia article] and [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#trix
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each TRIX value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each TRIX value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/02/092402.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=TRIX.htm
 func (a *Alphavantage) GetTrix(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "TRIX"
-	params := map[string]string{
+	return a.query("TRIX", trixParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// TrixParams holds the parameters of [Alphavantage.QueryTrix].
+// See [Alphavantage.GetTrix] for their documentation.
+type TrixParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryTrix is the typed form of [Alphavantage.GetTrix].
+func (a *Alphavantage) QueryTrix(p TrixParams) api.Response {
+	return a.query("TRIX", trixParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// trixParams maps the arguments of [Alphavantage.GetTrix] onto their query parameters.
+func trixParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // ULTOSC
-// This API returns the ultimate oscillator (ULTOSC) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=UltimateOsc.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the ultimate oscillator (ULTOSC) values. See also: [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#ultosc
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	opt_timeperiod1: The first time period for the indicator. Positive integers are accepted. By default, <code>timeperiod1=7</code>.
-// -	opt_timeperiod2: The second time period for the indicator. Positive integers are accepted. By default, <code>timeperiod2=14</code>.
-// -	opt_timeperiod3: The third time period for the indicator. Positive integers are accepted. By default, <code>timeperiod3=28</code>.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - opt_timeperiod1: The first time period for the indicator. Positive integers are accepted. By
+//     default, timeperiod1=7.
+//   - opt_timeperiod2: The second time period for the indicator. Positive integers are accepted. By
+//     default, timeperiod2=14.
+//   - opt_timeperiod3: The third time period for the indicator. Positive integers are accepted. By
+//     default, timeperiod3=28.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=UltimateOsc.htm
 func (a *Alphavantage) GetUltosc(symbol, interval, opt_timeperiod1, opt_timeperiod2, opt_timeperiod3, opt_datatype string) api.Response {
-	function := "ULTOSC"
-	params := map[string]string{
+	return a.query("ULTOSC", ultoscParams(symbol, interval, opt_timeperiod1, opt_timeperiod2, opt_timeperiod3, opt_datatype))
+}
+
+// UltoscParams holds the parameters of [Alphavantage.QueryUltosc].
+// See [Alphavantage.GetUltosc] for their documentation.
+type UltoscParams struct {
+	Symbol      string
+	Interval    Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	Timeperiod1 int      // Optional; default 7
+	Timeperiod2 int      // Optional; default 14
+	Timeperiod3 int      // Optional; default 28
+	Datatype    DataType // Optional; one of json, csv; default json
+}
+
+// QueryUltosc is the typed form of [Alphavantage.GetUltosc].
+func (a *Alphavantage) QueryUltosc(p UltoscParams) api.Response {
+	return a.query("ULTOSC", ultoscParams(
+		p.Symbol,
+		string(p.Interval),
+		formatInt(p.Timeperiod1),
+		formatInt(p.Timeperiod2),
+		formatInt(p.Timeperiod3),
+		string(p.Datatype),
+	))
+}
+
+// ultoscParams maps the arguments of [Alphavantage.GetUltosc] onto their query parameters.
+func ultoscParams(symbol, interval, opt_timeperiod1, opt_timeperiod2, opt_timeperiod3, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"timeperiod1": opt_timeperiod1,
@@ -1495,131 +3653,329 @@ func (a *Alphavantage) GetUltosc(symbol, interval, opt_timeperiod1, opt_timeperi
 		"timeperiod3": opt_timeperiod3,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // DX
-// This API returns the directional movement index (DX) values. See also: <a href="http://www.investopedia.com/articles/technical/02/050602.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=DX.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the directional movement index (DX) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#dx
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each DX value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each DX value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/02/050602.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=DX.htm
 func (a *Alphavantage) GetDx(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "DX"
-	params := map[string]string{
+	return a.query("DX", dxParams(symbol, interval, time_period, opt_datatype))
+}
+
+// DxParams holds the parameters of [Alphavantage.QueryDx].
+// See [Alphavantage.GetDx] for their documentation.
+type DxParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryDx is the typed form of [Alphavantage.GetDx].
+func (a *Alphavantage) QueryDx(p DxParams) api.Response {
+	return a.query("DX", dxParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// dxParams maps the arguments of [Alphavantage.GetDx] onto their query parameters.
+func dxParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // MINUS_DI
-// This API returns the minus directional indicator (MINUS_DI) values. See also: <a href="http://www.investopedia.com/articles/technical/02/050602.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=DI.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the minus directional indicator (MINUS_DI) values. See also: [Investopedia article]
+// and [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#minusdi
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each MINUS_DI value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each MINUS_DI value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/02/050602.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=DI.htm
 func (a *Alphavantage) GetMinusDi(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "MINUS_DI"
-	params := map[string]string{
+	return a.query("MINUS_DI", minusDiParams(symbol, interval, time_period, opt_datatype))
+}
+
+// MinusDiParams holds the parameters of [Alphavantage.QueryMinusDi].
+// See [Alphavantage.GetMinusDi] for their documentation.
+type MinusDiParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryMinusDi is the typed form of [Alphavantage.GetMinusDi].
+func (a *Alphavantage) QueryMinusDi(p MinusDiParams) api.Response {
+	return a.query("MINUS_DI", minusDiParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// minusDiParams maps the arguments of [Alphavantage.GetMinusDi] onto their query parameters.
+func minusDiParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // PLUS_DI
-// This API returns the plus directional indicator (PLUS_DI) values. See also: <a href="http://www.investopedia.com/articles/technical/02/050602.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=DI.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the plus directional indicator (PLUS_DI) values. See also: [Investopedia article]
+// and [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#plusdi
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each PLUS_DI value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each PLUS_DI value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/02/050602.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=DI.htm
 func (a *Alphavantage) GetPlusDi(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "PLUS_DI"
-	params := map[string]string{
+	return a.query("PLUS_DI", plusDiParams(symbol, interval, time_period, opt_datatype))
+}
+
+// PlusDiParams holds the parameters of [Alphavantage.QueryPlusDi].
+// See [Alphavantage.GetPlusDi] for their documentation.
+type PlusDiParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryPlusDi is the typed form of [Alphavantage.GetPlusDi].
+func (a *Alphavantage) QueryPlusDi(p PlusDiParams) api.Response {
+	return a.query("PLUS_DI", plusDiParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// plusDiParams maps the arguments of [Alphavantage.GetPlusDi] onto their query parameters.
+func plusDiParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // MINUS_DM
-// This API returns the minus directional movement (MINUS_DM) values. See also: <a href="http://www.investopedia.com/articles/technical/02/050602.asp" target="_blank">Investopedia article</a>
+//
+// This API returns the minus directional movement (MINUS_DM) values. See also: [Investopedia article]
+//
 // https://www.alphavantage.co/documentation/#minusdm
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each MINUS_DM value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each MINUS_DM value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/02/050602.asp
 func (a *Alphavantage) GetMinusDm(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "MINUS_DM"
-	params := map[string]string{
+	return a.query("MINUS_DM", minusDmParams(symbol, interval, time_period, opt_datatype))
+}
+
+// MinusDmParams holds the parameters of [Alphavantage.QueryMinusDm].
+// See [Alphavantage.GetMinusDm] for their documentation.
+type MinusDmParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryMinusDm is the typed form of [Alphavantage.GetMinusDm].
+func (a *Alphavantage) QueryMinusDm(p MinusDmParams) api.Response {
+	return a.query("MINUS_DM", minusDmParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// minusDmParams maps the arguments of [Alphavantage.GetMinusDm] onto their query parameters.
+func minusDmParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // PLUS_DM
-// This API returns the plus directional movement (PLUS_DM) values. See also: <a href="http://www.investopedia.com/articles/technical/02/050602.asp" target="_blank">Investopedia article</a>
+//
+// This API returns the plus directional movement (PLUS_DM) values. See also: [Investopedia article]
+//
 // https://www.alphavantage.co/documentation/#plusdm
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each PLUS_DM value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each PLUS_DM value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/02/050602.asp
 func (a *Alphavantage) GetPlusDm(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "PLUS_DM"
-	params := map[string]string{
+	return a.query("PLUS_DM", plusDmParams(symbol, interval, time_period, opt_datatype))
+}
+
+// PlusDmParams holds the parameters of [Alphavantage.QueryPlusDm].
+// See [Alphavantage.GetPlusDm] for their documentation.
+type PlusDmParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryPlusDm is the typed form of [Alphavantage.GetPlusDm].
+func (a *Alphavantage) QueryPlusDm(p PlusDmParams) api.Response {
+	return a.query("PLUS_DM", plusDmParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// plusDmParams maps the arguments of [Alphavantage.GetPlusDm] onto their query parameters.
+func plusDmParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // BBANDS
-// This API returns the Bollinger bands (BBANDS) values. See also: <a href="http://www.investopedia.com/articles/technical/04/030304.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=Bollinger.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the Bollinger bands (BBANDS) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#bbands
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each BBANDS value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_nbdevup: The standard deviation multiplier of the upper band. Positive integers are accepted. By default, <code>nbdevup=2</code>.
-// -	opt_nbdevdn: The standard deviation multiplier of the lower band. Positive integers are accepted. By default, <code>nbdevdn=2</code>.
-// -	opt_matype: Moving average type of the time series. By default, <code>matype=0</code>. Integers 0 - 8 are accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 = Exponential Moving Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA), 4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3 Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average (MAMA).
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each BBANDS value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_nbdevup: The standard deviation multiplier of the upper band. Positive integers are
+//     accepted. By default, nbdevup=2.
+//   - opt_nbdevdn: The standard deviation multiplier of the lower band. Positive integers are
+//     accepted. By default, nbdevdn=2.
+//   - opt_matype: Moving average type of the time series. By default, matype=0. Integers 0 - 8 are
+//     accepted with the following mappings. 0 = Simple Moving Average (SMA), 1 = Exponential Moving
+//     Average (EMA), 2 = Weighted Moving Average (WMA), 3 = Double Exponential Moving Average (DEMA),
+//     4 = Triple Exponential Moving Average (TEMA), 5 = Triangular Moving Average (TRIMA), 6 = T3
+//     Moving Average, 7 = Kaufman Adaptive Moving Average (KAMA), 8 = MESA Adaptive Moving Average
+//     (MAMA).
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/04/030304.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=Bollinger.htm
 func (a *Alphavantage) GetBbands(symbol, interval, time_period, series_type, opt_nbdevup, opt_nbdevdn, opt_matype, opt_datatype string) api.Response {
-	function := "BBANDS"
-	params := map[string]string{
+	return a.query("BBANDS", bbandsParams(symbol, interval, time_period, series_type, opt_nbdevup, opt_nbdevdn, opt_matype, opt_datatype))
+}
+
+// BbandsParams holds the parameters of [Alphavantage.QueryBbands].
+// See [Alphavantage.GetBbands] for their documentation.
+type BbandsParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Nbdevup    int        // Optional; default 2
+	Nbdevdn    int        // Optional; default 2
+	Matype     MAType     // Optional; one of 0, 1, 2, 3, 4, 5, 6, 7, 8; default 0
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryBbands is the typed form of [Alphavantage.GetBbands].
+func (a *Alphavantage) QueryBbands(p BbandsParams) api.Response {
+	return a.query("BBANDS", bbandsParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		formatInt(p.Nbdevup),
+		formatInt(p.Nbdevdn),
+		formatInt(int(p.Matype)),
+		string(p.Datatype),
+	))
+}
+
+// bbandsParams maps the arguments of [Alphavantage.GetBbands] onto their query parameters.
+func bbandsParams(symbol, interval, time_period, series_type, opt_nbdevup, opt_nbdevdn, opt_matype, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
@@ -1629,527 +3985,2059 @@ func (a *Alphavantage) GetBbands(symbol, interval, time_period, series_type, opt
 		"matype":      opt_matype,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // MIDPOINT
+//
 // This API returns the midpoint (MIDPOINT) values. MIDPOINT = (highest value + lowest value)/2.
+//
 // https://www.alphavantage.co/documentation/#midpoint
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each MIDPOINT value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each MIDPOINT value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetMidpoint(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
-	function := "MIDPOINT"
-	params := map[string]string{
+	return a.query("MIDPOINT", midpointParams(symbol, interval, time_period, series_type, opt_datatype))
+}
+
+// MidpointParams holds the parameters of [Alphavantage.QueryMidpoint].
+// See [Alphavantage.GetMidpoint] for their documentation.
+type MidpointParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryMidpoint is the typed form of [Alphavantage.GetMidpoint].
+func (a *Alphavantage) QueryMidpoint(p MidpointParams) api.Response {
+	return a.query("MIDPOINT", midpointParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// midpointParams maps the arguments of [Alphavantage.GetMidpoint] onto their query parameters.
+func midpointParams(symbol, interval, time_period, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // MIDPRICE
+//
 // This API returns the midpoint price (MIDPRICE) values. MIDPRICE = (highest high + lowest low)/2.
+//
 // https://www.alphavantage.co/documentation/#midprice
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each MIDPRICE value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each MIDPRICE value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetMidprice(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "MIDPRICE"
-	params := map[string]string{
+	return a.query("MIDPRICE", midpriceParams(symbol, interval, time_period, opt_datatype))
+}
+
+// MidpriceParams holds the parameters of [Alphavantage.QueryMidprice].
+// See [Alphavantage.GetMidprice] for their documentation.
+type MidpriceParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryMidprice is the typed form of [Alphavantage.GetMidprice].
+func (a *Alphavantage) QueryMidprice(p MidpriceParams) api.Response {
+	return a.query("MIDPRICE", midpriceParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// midpriceParams maps the arguments of [Alphavantage.GetMidprice] onto their query parameters.
+func midpriceParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // SAR
-// This API returns the parabolic SAR (SAR) values. See also: <a href="http://www.investopedia.com/articles/technical/02/042202.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=SAR.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the parabolic SAR (SAR) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#sar
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	opt_acceleration: The acceleration factor. Positive floats are accepted. By default, <code>acceleration=0.01</code>.
-// -	opt_maximum: The acceleration factor maximum value. Positive floats are accepted. By default, <code>maximum=0.20</code>.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - opt_acceleration: The acceleration factor. Positive floats are accepted. By default,
+//     acceleration=0.01.
+//   - opt_maximum: The acceleration factor maximum value. Positive floats are accepted. By default,
+//     maximum=0.20.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/02/042202.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=SAR.htm
 func (a *Alphavantage) GetSar(symbol, interval, opt_acceleration, opt_maximum, opt_datatype string) api.Response {
-	function := "SAR"
-	params := map[string]string{
+	return a.query("SAR", sarParams(symbol, interval, opt_acceleration, opt_maximum, opt_datatype))
+}
+
+// SarParams holds the parameters of [Alphavantage.QuerySar].
+// See [Alphavantage.GetSar] for their documentation.
+type SarParams struct {
+	Symbol       string
+	Interval     Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	Acceleration float64  // Optional; default 0.01
+	Maximum      float64  // Optional; default 0.20
+	Datatype     DataType // Optional; one of json, csv; default json
+}
+
+// QuerySar is the typed form of [Alphavantage.GetSar].
+func (a *Alphavantage) QuerySar(p SarParams) api.Response {
+	return a.query("SAR", sarParams(
+		p.Symbol,
+		string(p.Interval),
+		formatFloat(p.Acceleration),
+		formatFloat(p.Maximum),
+		string(p.Datatype),
+	))
+}
+
+// sarParams maps the arguments of [Alphavantage.GetSar] onto their query parameters.
+func sarParams(symbol, interval, opt_acceleration, opt_maximum, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":       symbol,
 		"interval":     interval,
 		"acceleration": opt_acceleration,
 		"maximum":      opt_maximum,
 		"datatype":     opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // TRANGE
-// This API returns the true range (TRANGE) values. See also: <a href="http://www.fmlabs.com/reference/default.htm?url=TR.htm" target="_blank">mathematical reference</a>
+//
+// This API returns the true range (TRANGE) values. See also: [mathematical reference]
+//
 // https://www.alphavantage.co/documentation/#trange
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=TR.htm
 func (a *Alphavantage) GetTrange(symbol, interval, opt_datatype string) api.Response {
-	function := "TRANGE"
-	params := map[string]string{
+	return a.query("TRANGE", trangeParams(symbol, interval, opt_datatype))
+}
+
+// TrangeParams holds the parameters of [Alphavantage.QueryTrange].
+// See [Alphavantage.GetTrange] for their documentation.
+type TrangeParams struct {
+	Symbol   string
+	Interval Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryTrange is the typed form of [Alphavantage.GetTrange].
+func (a *Alphavantage) QueryTrange(p TrangeParams) api.Response {
+	return a.query("TRANGE", trangeParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// trangeParams maps the arguments of [Alphavantage.GetTrange] onto their query parameters.
+func trangeParams(symbol, interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":   symbol,
 		"interval": interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // ATR
-// This API returns the average true range (ATR) values. See also: <a href="http://www.investopedia.com/articles/trading/08/average-true-range.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=ATR.htm" target="_blank">mathematical reference</a>
+//
+// This API returns the average true range (ATR) values. See also: [Investopedia article] and
+// [mathematical reference]
+//
 // https://www.alphavantage.co/documentation/#atr
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each ATR value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each ATR value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/trading/08/average-true-range.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=ATR.htm
 func (a *Alphavantage) GetAtr(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "ATR"
-	params := map[string]string{
+	return a.query("ATR", atrParams(symbol, interval, time_period, opt_datatype))
+}
+
+// AtrParams holds the parameters of [Alphavantage.QueryAtr].
+// See [Alphavantage.GetAtr] for their documentation.
+type AtrParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryAtr is the typed form of [Alphavantage.GetAtr].
+func (a *Alphavantage) QueryAtr(p AtrParams) api.Response {
+	return a.query("ATR", atrParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// atrParams maps the arguments of [Alphavantage.GetAtr] onto their query parameters.
+func atrParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // NATR
+//
 // This API returns the normalized average true range (NATR) values.
+//
 // https://www.alphavantage.co/documentation/#natr
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	time_period: Number of data points used to calculate each NATR value. Positive integers are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - time_period: Number of data points used to calculate each NATR value. Positive integers are
+//     accepted (e.g., time_period=60, time_period=200)
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetNatr(symbol, interval, time_period, opt_datatype string) api.Response {
-	function := "NATR"
-	params := map[string]string{
+	return a.query("NATR", natrParams(symbol, interval, time_period, opt_datatype))
+}
+
+// NatrParams holds the parameters of [Alphavantage.QueryNatr].
+// See [Alphavantage.GetNatr] for their documentation.
+type NatrParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	TimePeriod int
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryNatr is the typed form of [Alphavantage.GetNatr].
+func (a *Alphavantage) QueryNatr(p NatrParams) api.Response {
+	return a.query("NATR", natrParams(
+		p.Symbol,
+		string(p.Interval),
+		formatRequiredInt(p.TimePeriod),
+		string(p.Datatype),
+	))
+}
+
+// natrParams maps the arguments of [Alphavantage.GetNatr] onto their query parameters.
+func natrParams(symbol, interval, time_period, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"time_period": time_period,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // AD
-// This API returns the Chaikin A/D line (AD) values. See also: <a href="http://www.investopedia.com/articles/active-trading/031914/understanding-chaikin-oscillator.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=AccumDist.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the Chaikin A/D line (AD) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#ad
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/active-trading/031914/understanding-chaikin-oscillator.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=AccumDist.htm
 func (a *Alphavantage) GetAd(symbol, interval, opt_datatype string) api.Response {
-	function := "AD"
-	params := map[string]string{
+	return a.query("AD", adParams(symbol, interval, opt_datatype))
+}
+
+// AdParams holds the parameters of [Alphavantage.QueryAd].
+// See [Alphavantage.GetAd] for their documentation.
+type AdParams struct {
+	Symbol   string
+	Interval Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryAd is the typed form of [Alphavantage.GetAd].
+func (a *Alphavantage) QueryAd(p AdParams) api.Response {
+	return a.query("AD", adParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// adParams maps the arguments of [Alphavantage.GetAd] onto their query parameters.
+func adParams(symbol, interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":   symbol,
 		"interval": interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // ADOSC
-// This API returns the Chaikin A/D oscillator (ADOSC) values. See also: <a href="http://www.investopedia.com/articles/active-trading/031914/understanding-chaikin-oscillator.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=AccumDist.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the Chaikin A/D oscillator (ADOSC) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#adosc
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	opt_fastperiod: The time period of the fast EMA. Positive integers are accepted. By default, <code>fastperiod=3</code>.
-// -	opt_slowperiod: The time period of the slow EMA. Positive integers are accepted. By default, <code>slowperiod=10</code>.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - opt_fastperiod: The time period of the fast EMA. Positive integers are accepted. By default,
+//     fastperiod=3.
+//   - opt_slowperiod: The time period of the slow EMA. Positive integers are accepted. By default,
+//     slowperiod=10.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/active-trading/031914/understanding-chaikin-oscillator.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=AccumDist.htm
 func (a *Alphavantage) GetAdosc(symbol, interval, opt_fastperiod, opt_slowperiod, opt_datatype string) api.Response {
-	function := "ADOSC"
-	params := map[string]string{
+	return a.query("ADOSC", adoscParams(symbol, interval, opt_fastperiod, opt_slowperiod, opt_datatype))
+}
+
+// AdoscParams holds the parameters of [Alphavantage.QueryAdosc].
+// See [Alphavantage.GetAdosc] for their documentation.
+type AdoscParams struct {
+	Symbol     string
+	Interval   Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	Fastperiod int      // Optional; default 3
+	Slowperiod int      // Optional; default 10
+	Datatype   DataType // Optional; one of json, csv; default json
+}
+
+// QueryAdosc is the typed form of [Alphavantage.GetAdosc].
+func (a *Alphavantage) QueryAdosc(p AdoscParams) api.Response {
+	return a.query("ADOSC", adoscParams(
+		p.Symbol,
+		string(p.Interval),
+		formatInt(p.Fastperiod),
+		formatInt(p.Slowperiod),
+		string(p.Datatype),
+	))
+}
+
+// adoscParams maps the arguments of [Alphavantage.GetAdosc] onto their query parameters.
+func adoscParams(symbol, interval, opt_fastperiod, opt_slowperiod, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":     symbol,
 		"interval":   interval,
 		"fastperiod": opt_fastperiod,
 		"slowperiod": opt_slowperiod,
 		"datatype":   opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // OBV
-// This API returns the on balance volume (OBV) values. See also: <a href="http://www.investopedia.com/articles/technical/100801.asp" target="_blank">Investopedia article</a> and <a href="http://www.fmlabs.com/reference/default.htm?url=OBV.htm" target="_blank">mathematical reference</a>.
+//
+// This API returns the on balance volume (OBV) values. See also: [Investopedia article] and
+// [mathematical reference].
+//
 // https://www.alphavantage.co/documentation/#obv
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Investopedia article]: http://www.investopedia.com/articles/technical/100801.asp
+// [mathematical reference]: http://www.fmlabs.com/reference/default.htm?url=OBV.htm
 func (a *Alphavantage) GetObv(symbol, interval, opt_datatype string) api.Response {
-	function := "OBV"
-	params := map[string]string{
+	return a.query("OBV", obvParams(symbol, interval, opt_datatype))
+}
+
+// ObvParams holds the parameters of [Alphavantage.QueryObv].
+// See [Alphavantage.GetObv] for their documentation.
+type ObvParams struct {
+	Symbol   string
+	Interval Interval // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryObv is the typed form of [Alphavantage.GetObv].
+func (a *Alphavantage) QueryObv(p ObvParams) api.Response {
+	return a.query("OBV", obvParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.Datatype),
+	))
+}
+
+// obvParams maps the arguments of [Alphavantage.GetObv] onto their query parameters.
+func obvParams(symbol, interval, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":   symbol,
 		"interval": interval,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // HT_TRENDLINE
+//
 // This API returns the Hilbert transform, instantaneous trendline (HT_TRENDLINE) values.
+//
 // https://www.alphavantage.co/documentation/#httrendline
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetHtTrendline(symbol, interval, series_type, opt_datatype string) api.Response {
-	function := "HT_TRENDLINE"
-	params := map[string]string{
+	return a.query("HT_TRENDLINE", htTrendlineParams(symbol, interval, series_type, opt_datatype))
+}
+
+// HtTrendlineParams holds the parameters of [Alphavantage.QueryHtTrendline].
+// See [Alphavantage.GetHtTrendline] for their documentation.
+type HtTrendlineParams struct {
+	Symbol     string
+	Interval   Interval   // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryHtTrendline is the typed form of [Alphavantage.GetHtTrendline].
+func (a *Alphavantage) QueryHtTrendline(p HtTrendlineParams) api.Response {
+	return a.query("HT_TRENDLINE", htTrendlineParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// htTrendlineParams maps the arguments of [Alphavantage.GetHtTrendline] onto their query parameters.
+func htTrendlineParams(symbol, interval, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // HT_SINE
+//
 // This API returns the Hilbert transform, sine wave (HT_SINE) values.
+//
 // https://www.alphavantage.co/documentation/#htsine
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetHtSine(symbol, interval, series_type, opt_datatype string) api.Response {
-	function := "HT_SINE"
-	params := map[string]string{
+	return a.query("HT_SINE", htSineParams(symbol, interval, series_type, opt_datatype))
+}
+
+// HtSineParams holds the parameters of [Alphavantage.QueryHtSine].
+// See [Alphavantage.GetHtSine] for their documentation.
+type HtSineParams struct {
+	Symbol     string
+	Interval   Interval   // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryHtSine is the typed form of [Alphavantage.GetHtSine].
+func (a *Alphavantage) QueryHtSine(p HtSineParams) api.Response {
+	return a.query("HT_SINE", htSineParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// htSineParams maps the arguments of [Alphavantage.GetHtSine] onto their query parameters.
+func htSineParams(symbol, interval, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // HT_TRENDMODE
+//
 // This API returns the Hilbert transform, trend vs cycle mode (HT_TRENDMODE) values.
+//
 // https://www.alphavantage.co/documentation/#httrendmode
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetHtTrendmode(symbol, interval, series_type, opt_datatype string) api.Response {
-	function := "HT_TRENDMODE"
-	params := map[string]string{
+	return a.query("HT_TRENDMODE", htTrendmodeParams(symbol, interval, series_type, opt_datatype))
+}
+
+// HtTrendmodeParams holds the parameters of [Alphavantage.QueryHtTrendmode].
+// See [Alphavantage.GetHtTrendmode] for their documentation.
+type HtTrendmodeParams struct {
+	Symbol     string
+	Interval   Interval   // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryHtTrendmode is the typed form of [Alphavantage.GetHtTrendmode].
+func (a *Alphavantage) QueryHtTrendmode(p HtTrendmodeParams) api.Response {
+	return a.query("HT_TRENDMODE", htTrendmodeParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// htTrendmodeParams maps the arguments of [Alphavantage.GetHtTrendmode] onto their query parameters.
+func htTrendmodeParams(symbol, interval, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // HT_DCPERIOD
+//
 // This API returns the Hilbert transform, dominant cycle period (HT_DCPERIOD) values.
+//
 // https://www.alphavantage.co/documentation/#htdcperiod
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetHtDcperiod(symbol, interval, series_type, opt_datatype string) api.Response {
-	function := "HT_DCPERIOD"
-	params := map[string]string{
+	return a.query("HT_DCPERIOD", htDcperiodParams(symbol, interval, series_type, opt_datatype))
+}
+
+// HtDcperiodParams holds the parameters of [Alphavantage.QueryHtDcperiod].
+// See [Alphavantage.GetHtDcperiod] for their documentation.
+type HtDcperiodParams struct {
+	Symbol     string
+	Interval   Interval   // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryHtDcperiod is the typed form of [Alphavantage.GetHtDcperiod].
+func (a *Alphavantage) QueryHtDcperiod(p HtDcperiodParams) api.Response {
+	return a.query("HT_DCPERIOD", htDcperiodParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// htDcperiodParams maps the arguments of [Alphavantage.GetHtDcperiod] onto their query parameters.
+func htDcperiodParams(symbol, interval, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // HT_DCPHASE
+//
 // This API returns the Hilbert transform, dominant cycle phase (HT_DCPHASE) values.
+//
 // https://www.alphavantage.co/documentation/#htdcphase
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetHtDcphase(symbol, interval, series_type, opt_datatype string) api.Response {
-	function := "HT_DCPHASE"
-	params := map[string]string{
+	return a.query("HT_DCPHASE", htDcphaseParams(symbol, interval, series_type, opt_datatype))
+}
+
+// HtDcphaseParams holds the parameters of [Alphavantage.QueryHtDcphase].
+// See [Alphavantage.GetHtDcphase] for their documentation.
+type HtDcphaseParams struct {
+	Symbol     string
+	Interval   Interval   // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryHtDcphase is the typed form of [Alphavantage.GetHtDcphase].
+func (a *Alphavantage) QueryHtDcphase(p HtDcphaseParams) api.Response {
+	return a.query("HT_DCPHASE", htDcphaseParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// htDcphaseParams maps the arguments of [Alphavantage.GetHtDcphase] onto their query parameters.
+func htDcphaseParams(symbol, interval, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // HT_PHASOR
+//
 // This API returns the Hilbert transform, phasor components (HT_PHASOR) values.
+//
 // https://www.alphavantage.co/documentation/#htphasor
 //
 // Parameters:
-// -	symbol: The name of the token of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>, <code>daily</code>, <code>weekly</code>, <code>monthly</code>
-// -	series_type: The desired price type in the time series. Four types are supported: <code>close</code>, <code>open</code>, <code>high</code>, <code>low</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the token of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+//   - series_type: The desired price type in the time series. Four types are supported: close, open,
+//     high, low
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetHtPhasor(symbol, interval, series_type, opt_datatype string) api.Response {
-	function := "HT_PHASOR"
-	params := map[string]string{
+	return a.query("HT_PHASOR", htPhasorParams(symbol, interval, series_type, opt_datatype))
+}
+
+// HtPhasorParams holds the parameters of [Alphavantage.QueryHtPhasor].
+// See [Alphavantage.GetHtPhasor] for their documentation.
+type HtPhasorParams struct {
+	Symbol     string
+	Interval   Interval   // One of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly
+	SeriesType SeriesType // One of close, open, high, low
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryHtPhasor is the typed form of [Alphavantage.GetHtPhasor].
+func (a *Alphavantage) QueryHtPhasor(p HtPhasorParams) api.Response {
+	return a.query("HT_PHASOR", htPhasorParams(
+		p.Symbol,
+		string(p.Interval),
+		string(p.SeriesType),
+		string(p.Datatype),
+	))
+}
+
+// htPhasorParams maps the arguments of [Alphavantage.GetHtPhasor] onto their query parameters.
+func htPhasorParams(symbol, interval, series_type, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":      symbol,
 		"interval":    interval,
 		"series_type": series_type,
 		"datatype":    opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Endpoint Category: Time Series Stock Data APIs
 // https://www.alphavantage.co/documentation/#time-series-data
 //
-// This suite of APIs provide global equity data in 4 different temporal resolutions: (1) daily, (2) weekly, (3) monthly, and (4) intraday. Daily, weekly, and monthly time series contain 20+ years of historical data.
+// This suite of APIs provide global equity data in 4 different temporal resolutions: (1) daily, (2)
+// weekly, (3) monthly, and (4) intraday. Daily, weekly, and monthly time series contain 20+ years of
+// historical data.
 
 // TIME_SERIES_INTRADAY
-// This API returns intraday time series of the equity specified, covering <u>extended trading hours</u> where applicable (e.g., 4:00am to 8:00pm Eastern Time for the US market). The intraday data is derived from the Securities Information Processor (SIP) market-aggregated data. You can query both raw (as-traded) and split/dividend-adjusted intraday data from this endpoint.
-// This API returns the most recent 1-2 months of intraday data and is best suited for short-term/medium-term charting and trading strategy development. If you are targeting a deeper intraday history, please use the <a href="#intraday-extended">Extended Intraday API</a>.
+//
+// This API returns intraday time series of the equity specified, covering extended trading hours where
+// applicable (e.g., 4:00am to 8:00pm Eastern Time for the US market). The intraday data is derived
+// from the Securities Information Processor (SIP) market-aggregated data. You can query both raw
+// (as-traded) and split/dividend-adjusted intraday data from this endpoint.
+//
+// This API returns the most recent 1-2 months of intraday data and is best suited for
+// short-term/medium-term charting and trading strategy development. If you are targeting a deeper
+// intraday history, please use the [Extended Intraday API].
+//
 // https://www.alphavantage.co/documentation/#intraday
 //
 // Parameters:
-// -	symbol: The name of the equity of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>
-// -	opt_adjusted: By default, <code>adjusted=true</code> and the output time series is adjusted by historical split and dividend events. Set <code>adjusted=false</code> to query raw (as-traded) intraday values.
-// -	opt_outputsize: By default, <code>outputsize=compact</code>. Strings <code>compact</code> and <code>full</code> are accepted with the following specifications: <code>compact</code> returns only the latest 100 data points in the intraday time series; <code>full</code> returns the full-length intraday time series. The &#34;compact&#34; option is recommended if you would like to reduce the data size of each API call.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the intraday time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the equity of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min
+//   - opt_adjusted: By default, adjusted=true and the output time series is adjusted by historical
+//     split and dividend events. Set adjusted=false to query raw (as-traded) intraday values.
+//   - opt_outputsize: By default, outputsize=compact. Strings compact and full are accepted with the
+//     following specifications: compact returns only the latest 100 data points in the intraday time
+//     series; full returns the full-length intraday time series. The "compact" option is recommended
+//     if you would like to reduce the data size of each API call.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the intraday time series in JSON format; csv returns the time
+//     series as a CSV (comma separated value) file.
+//
+// [Extended Intraday API]: https://www.alphavantage.co/documentation/#intraday-extended
 func (a *Alphavantage) GetTimeSeriesIntraday(symbol, interval, opt_adjusted, opt_outputsize, opt_datatype string) api.Response {
-	function := "TIME_SERIES_INTRADAY"
-	params := map[string]string{
+	return a.query("TIME_SERIES_INTRADAY", timeSeriesIntradayParams(symbol, interval, opt_adjusted, opt_outputsize, opt_datatype))
+}
+
+// TimeSeriesIntradayParams holds the parameters of [Alphavantage.QueryTimeSeriesIntraday].
+// See [Alphavantage.GetTimeSeriesIntraday] for their documentation.
+type TimeSeriesIntradayParams struct {
+	Symbol     string
+	Interval   Interval   // One of 1min, 5min, 15min, 30min, 60min
+	Adjusted   string     // Optional; one of true, false; default true
+	Outputsize OutputSize // Optional; one of compact, full; default compact
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryTimeSeriesIntraday is the typed form of [Alphavantage.GetTimeSeriesIntraday].
+func (a *Alphavantage) QueryTimeSeriesIntraday(p TimeSeriesIntradayParams) api.Response {
+	return a.query("TIME_SERIES_INTRADAY", timeSeriesIntradayParams(
+		p.Symbol,
+		string(p.Interval),
+		p.Adjusted,
+		string(p.Outputsize),
+		string(p.Datatype),
+	))
+}
+
+// timeSeriesIntradayParams maps the arguments of [Alphavantage.GetTimeSeriesIntraday] onto their query parameters.
+func timeSeriesIntradayParams(symbol, interval, opt_adjusted, opt_outputsize, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":     symbol,
 		"interval":   interval,
 		"adjusted":   opt_adjusted,
 		"outputsize": opt_outputsize,
 		"datatype":   opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Intraday (Extended History)
-// This API returns historical intraday time series for the trailing 2 years, covering over 2 million data points per ticker. The intraday data is derived from the Securities Information Processor (SIP) market-aggregated data. You can query both raw (as-traded) and split/dividend-adjusted intraday data from this endpoint. Common use cases for this API include data visualization, trading simulation/backtesting, and machine learning and deep learning applications with a longer horizon.
+//
+// This API returns historical intraday time series for the trailing 2 years, covering over 2 million
+// data points per ticker. The intraday data is derived from the Securities Information Processor (SIP)
+// market-aggregated data. You can query both raw (as-traded) and split/dividend-adjusted intraday data
+// from this endpoint. Common use cases for this API include data visualization, trading
+// simulation/backtesting, and machine learning and deep learning applications with a longer horizon.
+//
 // https://www.alphavantage.co/documentation/#intraday-extended
 //
 // Parameters:
-// -	symbol: The name of the equity of your choice. For example: <code>symbol=IBM</code>
-// -	interval: Time interval between two consecutive data points in the time series. The following values are supported: <code>1min</code>, <code>5min</code>, <code>15min</code>, <code>30min</code>, <code>60min</code>
-// -	slice: Two years of minute-level intraday data contains over 2 million data points, which can take up to Gigabytes of memory. To ensure optimal API response speed, the trailing 2 years of intraday data is evenly divided into 24 &#34;slices&#34; - <code>year1month1</code>, <code>year1month2</code>, <code>year1month3</code>, ..., <code>year1month11</code>, <code>year1month12</code>, <code>year2month1</code>, <code>year2month2</code>, <code>year2month3</code>, ..., <code>year2month11</code>, <code>year2month12</code>. Each slice is a 30-day window, with <code>year1month1</code> being the most recent and <code>year2month12</code> being the farthest from today. By default, <code>slice=year1month1</code>.
-// -	opt_adjusted: By default, <code>adjusted=true</code> and the output time series is adjusted by historical split and dividend events. Set <code>adjusted=false</code> to query raw (as-traded) intraday values.
+//   - symbol: The name of the equity of your choice. For example: symbol=IBM
+//   - interval: Time interval between two consecutive data points in the time series. The following
+//     values are supported: 1min, 5min, 15min, 30min, 60min
+//   - slice: Two years of minute-level intraday data contains over 2 million data points, which can
+//     take up to Gigabytes of memory. To ensure optimal API response speed, the trailing 2 years of
+//     intraday data is evenly divided into 24 "slices" - year1month1, year1month2, year1month3, ...,
+//     year1month11, year1month12, year2month1, year2month2, year2month3, ..., year2month11,
+//     year2month12. Each slice is a 30-day window, with year1month1 being the most recent and
+//     year2month12 being the farthest from today. By default, slice=year1month1.
+//   - opt_adjusted: By default, adjusted=true and the output time series is adjusted by historical
+//     split and dividend events. Set adjusted=false to query raw (as-traded) intraday values.
 func (a *Alphavantage) GetTimeSeriesIntradayExtended(symbol, interval, slice, opt_adjusted string) api.Response {
-	function := "TIME_SERIES_INTRADAY_EXTENDED"
-	params := map[string]string{
+	return a.query("TIME_SERIES_INTRADAY_EXTENDED", timeSeriesIntradayExtendedParams(symbol, interval, slice, opt_adjusted))
+}
+
+// TimeSeriesIntradayExtendedParams holds the parameters of [Alphavantage.QueryTimeSeriesIntradayExtended].
+// See [Alphavantage.GetTimeSeriesIntradayExtended] for their documentation.
+type TimeSeriesIntradayExtendedParams struct {
+	Symbol   string
+	Interval Interval // One of 1min, 5min, 15min, 30min, 60min
+	Slice    string   // Default year1month1
+	Adjusted string   // Optional; one of true, false; default true
+}
+
+// QueryTimeSeriesIntradayExtended is the typed form of [Alphavantage.GetTimeSeriesIntradayExtended].
+func (a *Alphavantage) QueryTimeSeriesIntradayExtended(p TimeSeriesIntradayExtendedParams) api.Response {
+	return a.query("TIME_SERIES_INTRADAY_EXTENDED", timeSeriesIntradayExtendedParams(
+		p.Symbol,
+		string(p.Interval),
+		p.Slice,
+		p.Adjusted,
+	))
+}
+
+// timeSeriesIntradayExtendedParams maps the arguments of [Alphavantage.GetTimeSeriesIntradayExtended] onto their query parameters.
+func timeSeriesIntradayExtendedParams(symbol, interval, slice, opt_adjusted string) map[string]string {
+	return map[string]string{
 		"symbol":   symbol,
 		"interval": interval,
 		"slice":    slice,
 		"adjusted": opt_adjusted,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // [PREMIUM] TIME_SERIES_DAILY
-// This API returns <u>raw</u> (as-traded) daily time series (date, daily open, daily high, daily low, daily close, daily volume) of the global equity specified, covering 20+ years of historical data. If you are also interested in split/dividend-adjusted historical data, please use the <a href="#dailyadj">Daily Adjusted API</a>, which covers adjusted close values and historical split and dividend events.
+//
+// This API returns raw (as-traded) daily time series (date, daily open, daily high, daily low, daily
+// close, daily volume) of the global equity specified, covering 20+ years of historical data. If you
+// are also interested in split/dividend-adjusted historical data, please use the [Daily Adjusted API],
+// which covers adjusted close values and historical split and dividend events.
+//
 // https://www.alphavantage.co/documentation/#daily
 //
 // Parameters:
-// -	symbol: The name of the equity of your choice. For example: <code>symbol=IBM</code>
-// -	opt_outputsize: By default, <code>outputsize=compact</code>. Strings <code>compact</code> and <code>full</code> are accepted with the following specifications: <code>compact</code> returns only the latest 100 data points; <code>full</code> returns the full-length time series of 20+ years of historical data. The &#34;compact&#34; option is recommended if you would like to reduce the data size of each API call.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the equity of your choice. For example: symbol=IBM
+//   - opt_outputsize: By default, outputsize=compact. Strings compact and full are accepted with the
+//     following specifications: compact returns only the latest 100 data points; full returns the
+//     full-length time series of 20+ years of historical data. The "compact" option is recommended if
+//     you would like to reduce the data size of each API call.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
+//
+// [Daily Adjusted API]: https://www.alphavantage.co/documentation/#dailyadj
 func (a *Alphavantage) GetTimeSeriesDaily(symbol, opt_outputsize, opt_datatype string) api.Response {
-	function := "TIME_SERIES_DAILY"
-	params := map[string]string{
+	return a.query("TIME_SERIES_DAILY", timeSeriesDailyParams(symbol, opt_outputsize, opt_datatype))
+}
+
+// TimeSeriesDailyParams holds the parameters of [Alphavantage.QueryTimeSeriesDaily].
+// See [Alphavantage.GetTimeSeriesDaily] for their documentation.
+type TimeSeriesDailyParams struct {
+	Symbol     string
+	Outputsize OutputSize // Optional; one of compact, full; default compact
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryTimeSeriesDaily is the typed form of [Alphavantage.GetTimeSeriesDaily].
+func (a *Alphavantage) QueryTimeSeriesDaily(p TimeSeriesDailyParams) api.Response {
+	return a.query("TIME_SERIES_DAILY", timeSeriesDailyParams(
+		p.Symbol,
+		string(p.Outputsize),
+		string(p.Datatype),
+	))
+}
+
+// timeSeriesDailyParams maps the arguments of [Alphavantage.GetTimeSeriesDaily] onto their query parameters.
+func timeSeriesDailyParams(symbol, opt_outputsize, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":     symbol,
 		"outputsize": opt_outputsize,
 		"datatype":   opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // TIME_SERIES_DAILY_ADJUSTED
-// This API returns raw (as-traded) daily open/high/low/close/volume values, daily adjusted close values, and historical split/dividend events of the global equity specified, covering 20+ years of historical data.
+//
+// This API returns raw (as-traded) daily open/high/low/close/volume values, daily adjusted close
+// values, and historical split/dividend events of the global equity specified, covering 20+ years of
+// historical data.
+//
 // https://www.alphavantage.co/documentation/#dailyadj
 //
 // Parameters:
-// -	symbol: The name of the equity of your choice. For example: <code>symbol=IBM</code>
-// -	opt_outputsize: By default, <code>outputsize=compact</code>. Strings <code>compact</code> and <code>full</code> are accepted with the following specifications: <code>compact</code> returns only the latest 100 data points; <code>full</code> returns the full-length time series of 20+ years of historical data. The &#34;compact&#34; option is recommended if you would like to reduce the data size of each API call.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the daily time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the equity of your choice. For example: symbol=IBM
+//   - opt_outputsize: By default, outputsize=compact. Strings compact and full are accepted with the
+//     following specifications: compact returns only the latest 100 data points; full returns the
+//     full-length time series of 20+ years of historical data. The "compact" option is recommended if
+//     you would like to reduce the data size of each API call.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the daily time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetTimeSeriesDailyAdjusted(symbol, opt_outputsize, opt_datatype string) api.Response {
-	function := "TIME_SERIES_DAILY_ADJUSTED"
-	params := map[string]string{
+	return a.query("TIME_SERIES_DAILY_ADJUSTED", timeSeriesDailyAdjustedParams(symbol, opt_outputsize, opt_datatype))
+}
+
+// TimeSeriesDailyAdjustedParams holds the parameters of [Alphavantage.QueryTimeSeriesDailyAdjusted].
+// See [Alphavantage.GetTimeSeriesDailyAdjusted] for their documentation.
+type TimeSeriesDailyAdjustedParams struct {
+	Symbol     string
+	Outputsize OutputSize // Optional; one of compact, full; default compact
+	Datatype   DataType   // Optional; one of json, csv; default json
+}
+
+// QueryTimeSeriesDailyAdjusted is the typed form of [Alphavantage.GetTimeSeriesDailyAdjusted].
+func (a *Alphavantage) QueryTimeSeriesDailyAdjusted(p TimeSeriesDailyAdjustedParams) api.Response {
+	return a.query("TIME_SERIES_DAILY_ADJUSTED", timeSeriesDailyAdjustedParams(
+		p.Symbol,
+		string(p.Outputsize),
+		string(p.Datatype),
+	))
+}
+
+// timeSeriesDailyAdjustedParams maps the arguments of [Alphavantage.GetTimeSeriesDailyAdjusted] onto their query parameters.
+func timeSeriesDailyAdjustedParams(symbol, opt_outputsize, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":     symbol,
 		"outputsize": opt_outputsize,
 		"datatype":   opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // TIME_SERIES_WEEKLY
-// This API returns weekly time series (last trading day of each week, weekly open, weekly high, weekly low, weekly close, weekly volume) of the global equity specified, covering 20+ years of historical data.
+//
+// This API returns weekly time series (last trading day of each week, weekly open, weekly high, weekly
+// low, weekly close, weekly volume) of the global equity specified, covering 20+ years of historical
+// data.
+//
 // https://www.alphavantage.co/documentation/#weekly
 //
 // Parameters:
-// -	symbol: The name of the equity of your choice. For example: <code>symbol=IBM</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the weekly time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the equity of your choice. For example: symbol=IBM
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the weekly time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetTimeSeriesWeekly(symbol, opt_datatype string) api.Response {
-	function := "TIME_SERIES_WEEKLY"
-	params := map[string]string{
+	return a.query("TIME_SERIES_WEEKLY", timeSeriesWeeklyParams(symbol, opt_datatype))
+}
+
+// TimeSeriesWeeklyParams holds the parameters of [Alphavantage.QueryTimeSeriesWeekly].
+// See [Alphavantage.GetTimeSeriesWeekly] for their documentation.
+type TimeSeriesWeeklyParams struct {
+	Symbol   string
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryTimeSeriesWeekly is the typed form of [Alphavantage.GetTimeSeriesWeekly].
+func (a *Alphavantage) QueryTimeSeriesWeekly(p TimeSeriesWeeklyParams) api.Response {
+	return a.query("TIME_SERIES_WEEKLY", timeSeriesWeeklyParams(
+		p.Symbol,
+		string(p.Datatype),
+	))
+}
+
+// timeSeriesWeeklyParams maps the arguments of [Alphavantage.GetTimeSeriesWeekly] onto their query parameters.
+func timeSeriesWeeklyParams(symbol, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":   symbol,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // TIME_SERIES_WEEKLY_ADJUSTED
-// This API returns weekly adjusted time series (last trading day of each week, weekly open, weekly high, weekly low, weekly close, weekly adjusted close, weekly volume, weekly dividend) of the global equity specified, covering 20+ years of historical data.
+//
+// This API returns weekly adjusted time series (last trading day of each week, weekly open, weekly
+// high, weekly low, weekly close, weekly adjusted close, weekly volume, weekly dividend) of the global
+// equity specified, covering 20+ years of historical data.
+//
 // https://www.alphavantage.co/documentation/#weeklyadj
 //
 // Parameters:
-// -	symbol: The name of the equity of your choice. For example: <code>symbol=IBM</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the weekly time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the equity of your choice. For example: symbol=IBM
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the weekly time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetTimeSeriesWeeklyAdjusted(symbol, opt_datatype string) api.Response {
-	function := "TIME_SERIES_WEEKLY_ADJUSTED"
-	params := map[string]string{
+	return a.query("TIME_SERIES_WEEKLY_ADJUSTED", timeSeriesWeeklyAdjustedParams(symbol, opt_datatype))
+}
+
+// TimeSeriesWeeklyAdjustedParams holds the parameters of [Alphavantage.QueryTimeSeriesWeeklyAdjusted].
+// See [Alphavantage.GetTimeSeriesWeeklyAdjusted] for their documentation.
+type TimeSeriesWeeklyAdjustedParams struct {
+	Symbol   string
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryTimeSeriesWeeklyAdjusted is the typed form of [Alphavantage.GetTimeSeriesWeeklyAdjusted].
+func (a *Alphavantage) QueryTimeSeriesWeeklyAdjusted(p TimeSeriesWeeklyAdjustedParams) api.Response {
+	return a.query("TIME_SERIES_WEEKLY_ADJUSTED", timeSeriesWeeklyAdjustedParams(
+		p.Symbol,
+		string(p.Datatype),
+	))
+}
+
+// timeSeriesWeeklyAdjustedParams maps the arguments of [Alphavantage.GetTimeSeriesWeeklyAdjusted] onto their query parameters.
+func timeSeriesWeeklyAdjustedParams(symbol, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":   symbol,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // TIME_SERIES_MONTHLY
-// This API returns monthly time series (last trading day of each month, monthly open, monthly high, monthly low, monthly close, monthly volume) of the global equity specified, covering 20+ years of historical data.
+//
+// This API returns monthly time series (last trading day of each month, monthly open, monthly high,
+// monthly low, monthly close, monthly volume) of the global equity specified, covering 20+ years of
+// historical data.
+//
 // https://www.alphavantage.co/documentation/#monthly
 //
 // Parameters:
-// -	symbol: The name of the equity of your choice. For example: <code>symbol=IBM</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the monthly time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the equity of your choice. For example: symbol=IBM
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the monthly time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetTimeSeriesMonthly(symbol, opt_datatype string) api.Response {
-	function := "TIME_SERIES_MONTHLY"
-	params := map[string]string{
+	return a.query("TIME_SERIES_MONTHLY", timeSeriesMonthlyParams(symbol, opt_datatype))
+}
+
+// TimeSeriesMonthlyParams holds the parameters of [Alphavantage.QueryTimeSeriesMonthly].
+// See [Alphavantage.GetTimeSeriesMonthly] for their documentation.
+type TimeSeriesMonthlyParams struct {
+	Symbol   string
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryTimeSeriesMonthly is the typed form of [Alphavantage.GetTimeSeriesMonthly].
+func (a *Alphavantage) QueryTimeSeriesMonthly(p TimeSeriesMonthlyParams) api.Response {
+	return a.query("TIME_SERIES_MONTHLY", timeSeriesMonthlyParams(
+		p.Symbol,
+		string(p.Datatype),
+	))
+}
+
+// timeSeriesMonthlyParams maps the arguments of [Alphavantage.GetTimeSeriesMonthly] onto their query parameters.
+func timeSeriesMonthlyParams(symbol, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":   symbol,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // TIME_SERIES_MONTHLY_ADJUSTED
-// This API returns monthly adjusted time series (last trading day of each month, monthly open, monthly high, monthly low, monthly close, monthly adjusted close, monthly volume, monthly dividend) of the equity specified, covering 20+ years of historical data.
+//
+// This API returns monthly adjusted time series (last trading day of each month, monthly open, monthly
+// high, monthly low, monthly close, monthly adjusted close, monthly volume, monthly dividend) of the
+// equity specified, covering 20+ years of historical data.
+//
 // https://www.alphavantage.co/documentation/#monthlyadj
 //
 // Parameters:
-// -	symbol: The name of the equity of your choice. For example: <code>symbol=IBM</code>
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the monthly time series in JSON format; <code>csv</code> returns the time series as a CSV (comma separated value) file.
+//   - symbol: The name of the equity of your choice. For example: symbol=IBM
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the monthly time series in JSON format; csv returns the time series
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetTimeSeriesMonthlyAdjusted(symbol, opt_datatype string) api.Response {
-	function := "TIME_SERIES_MONTHLY_ADJUSTED"
-	params := map[string]string{
+	return a.query("TIME_SERIES_MONTHLY_ADJUSTED", timeSeriesMonthlyAdjustedParams(symbol, opt_datatype))
+}
+
+// TimeSeriesMonthlyAdjustedParams holds the parameters of [Alphavantage.QueryTimeSeriesMonthlyAdjusted].
+// See [Alphavantage.GetTimeSeriesMonthlyAdjusted] for their documentation.
+type TimeSeriesMonthlyAdjustedParams struct {
+	Symbol   string
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryTimeSeriesMonthlyAdjusted is the typed form of [Alphavantage.GetTimeSeriesMonthlyAdjusted].
+func (a *Alphavantage) QueryTimeSeriesMonthlyAdjusted(p TimeSeriesMonthlyAdjustedParams) api.Response {
+	return a.query("TIME_SERIES_MONTHLY_ADJUSTED", timeSeriesMonthlyAdjustedParams(
+		p.Symbol,
+		string(p.Datatype),
+	))
+}
+
+// timeSeriesMonthlyAdjustedParams maps the arguments of [Alphavantage.GetTimeSeriesMonthlyAdjusted] onto their query parameters.
+func timeSeriesMonthlyAdjustedParams(symbol, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":   symbol,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Quote Endpoint
-// A lightweight alternative to the time series APIs, this service returns the price and volume information for a token of your choice.
+//
+// A lightweight alternative to the time series APIs, this service returns the price and volume
+// information for a token of your choice.
+//
 // https://www.alphavantage.co/documentation/#latestprice
 //
 // Parameters:
-// -	symbol: The symbol of the global token of your choice. For example: <code>symbol=IBM</code>.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the quote data in JSON format; <code>csv</code> returns the quote data as a CSV (comma separated value) file.
+//   - symbol: The symbol of the global token of your choice. For example: symbol=IBM.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the quote data in JSON format; csv returns the quote data as a CSV
+//     (comma separated value) file.
 func (a *Alphavantage) GetGlobalQuote(symbol, opt_datatype string) api.Response {
-	function := "GLOBAL_QUOTE"
-	params := map[string]string{
+	return a.query("GLOBAL_QUOTE", globalQuoteParams(symbol, opt_datatype))
+}
+
+// GlobalQuoteParams holds the parameters of [Alphavantage.QueryGlobalQuote].
+// See [Alphavantage.GetGlobalQuote] for their documentation.
+type GlobalQuoteParams struct {
+	Symbol   string
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QueryGlobalQuote is the typed form of [Alphavantage.GetGlobalQuote].
+func (a *Alphavantage) QueryGlobalQuote(p GlobalQuoteParams) api.Response {
+	return a.query("GLOBAL_QUOTE", globalQuoteParams(
+		p.Symbol,
+		string(p.Datatype),
+	))
+}
+
+// globalQuoteParams maps the arguments of [Alphavantage.GetGlobalQuote] onto their query parameters.
+func globalQuoteParams(symbol, opt_datatype string) map[string]string {
+	return map[string]string{
 		"symbol":   symbol,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Search Endpoint
-// Looking for some specific symbols or companies? Trying to build an auto-complete search box similar to the one below?
-// <img style="width: 60%; height: auto;" src="/static/img/autocomplete.jpg"/>
-// We&#39;ve got you covered! The Search Endpoint returns the best-matching symbols and market information based on keywords of your choice. The search results also contain match scores that provide you with the full flexibility to develop your own search and filtering logic.
+//
+// Looking for some specific symbols or companies? Trying to build an auto-complete search box similar
+// to the one below?
+//
+// We've got you covered! The Search Endpoint returns the best-matching symbols and market information
+// based on keywords of your choice. The search results also contain match scores that provide you with
+// the full flexibility to develop your own search and filtering logic.
+//
 // https://www.alphavantage.co/documentation/#symbolsearch
 //
 // Parameters:
-// -	keywords: A text string of your choice. For example: <code>keywords=microsoft</code>.
-// -	opt_datatype: By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are accepted with the following specifications: <code>json</code> returns the search results in JSON format; <code>csv</code> returns the search results as a CSV (comma separated value) file.
+//   - keywords: A text string of your choice. For example: keywords=microsoft.
+//   - opt_datatype: By default, datatype=json. Strings json and csv are accepted with the following
+//     specifications: json returns the search results in JSON format; csv returns the search results
+//     as a CSV (comma separated value) file.
 func (a *Alphavantage) GetSymbolSearch(keywords, opt_datatype string) api.Response {
-	function := "SYMBOL_SEARCH"
-	params := map[string]string{
+	return a.query("SYMBOL_SEARCH", symbolSearchParams(keywords, opt_datatype))
+}
+
+// SymbolSearchParams holds the parameters of [Alphavantage.QuerySymbolSearch].
+// See [Alphavantage.GetSymbolSearch] for their documentation.
+type SymbolSearchParams struct {
+	Keywords string
+	Datatype DataType // Optional; one of json, csv; default json
+}
+
+// QuerySymbolSearch is the typed form of [Alphavantage.GetSymbolSearch].
+func (a *Alphavantage) QuerySymbolSearch(p SymbolSearchParams) api.Response {
+	return a.query("SYMBOL_SEARCH", symbolSearchParams(
+		p.Keywords,
+		string(p.Datatype),
+	))
+}
+
+// symbolSearchParams maps the arguments of [Alphavantage.GetSymbolSearch] onto their query parameters.
+func symbolSearchParams(keywords, opt_datatype string) map[string]string {
+	return map[string]string{
 		"keywords": keywords,
 		"datatype": opt_datatype,
 	}
-
-	return a.client.Query(function, params)
 }
 
 // Global Market Open & Close Status
-// This endpoint returns the current market status (open vs. closed) of major trading venues for equities, forex, and cryptocurrencies around the world.
-// https://www.alphavantage.co/documentation/#market-status
 //
-// Parameters:
-func (a *Alphavantage) GetMarketStatus(string) api.Response {
-	function := "MARKET_STATUS"
-	params := map[string]string{}
+// This endpoint returns the current market status (open vs. closed) of major trading venues for
+// equities, forex, and cryptocurrencies around the world.
+//
+// https://www.alphavantage.co/documentation/#market-status
+func (a *Alphavantage) GetMarketStatus() api.Response {
+	return a.query("MARKET_STATUS", marketStatusParams())
+}
+
+// MarketStatusParams holds the parameters of [Alphavantage.QueryMarketStatus].
+// See [Alphavantage.GetMarketStatus] for their documentation.
+type MarketStatusParams struct {
+}
+
+// QueryMarketStatus is the typed form of [Alphavantage.GetMarketStatus].
+func (a *Alphavantage) QueryMarketStatus(p MarketStatusParams) api.Response {
+	return a.query("MARKET_STATUS", marketStatusParams())
+}
+
+// marketStatusParams maps the arguments of [Alphavantage.GetMarketStatus] onto their query parameters.
+func marketStatusParams() map[string]string {
+	return map[string]string{}
+}
+
+// requiredParams lists, for each endpoint function, the parameters it cannot be called without.
+var requiredParams = map[string][]string{
+	"WTI":                           {},
+	"BRENT":                         {},
+	"NATURAL_GAS":                   {},
+	"COPPER":                        {},
+	"ALUMINUM":                      {},
+	"WHEAT":                         {},
+	"CORN":                          {},
+	"COTTON":                        {},
+	"SUGAR":                         {},
+	"COFFEE":                        {},
+	"ALL_COMMODITIES":               {},
+	"CRYPTO_INTRADAY":               {"symbol", "market", "interval"},
+	"DIGITAL_CURRENCY_DAILY":        {"symbol", "market"},
+	"DIGITAL_CURRENCY_WEEKLY":       {"symbol", "market"},
+	"DIGITAL_CURRENCY_MONTHLY":      {"symbol", "market"},
+	"REAL_GDP":                      {},
+	"REAL_GDP_PER_CAPITA":           {},
+	"TREASURY_YIELD":                {},
+	"FEDERAL_FUNDS_RATE":            {},
+	"CPI":                           {},
+	"INFLATION":                     {},
+	"RETAIL_SALES":                  {},
+	"DURABLES":                      {},
+	"UNEMPLOYMENT":                  {},
+	"NONFARM_PAYROLL":               {},
+	"OVERVIEW":                      {"symbol"},
+	"INCOME_STATEMENT":              {"symbol"},
+	"BALANCE_SHEET":                 {"symbol"},
+	"CASH_FLOW":                     {"symbol"},
+	"EARNINGS":                      {"symbol"},
+	"LISTING_STATUS":                {},
+	"EARNINGS_CALENDAR":             {},
+	"IPO_CALENDAR":                  {},
+	"CURRENCY_EXCHANGE_RATE":        {"from_currency", "to_currency"},
+	"FX_INTRADAY":                   {"from_symbol", "to_symbol", "interval"},
+	"FX_DAILY":                      {"from_symbol", "to_symbol"},
+	"FX_WEEKLY":                     {"from_symbol", "to_symbol"},
+	"FX_MONTHLY":                    {"from_symbol", "to_symbol"},
+	"NEWS_SENTIMENT":                {},
+	"SMA":                           {"symbol", "interval", "time_period", "series_type"},
+	"EMA":                           {"symbol", "interval", "time_period", "series_type"},
+	"WMA":                           {"symbol", "interval", "time_period", "series_type"},
+	"DEMA":                          {"symbol", "interval", "time_period", "series_type"},
+	"TEMA":                          {"symbol", "interval", "time_period", "series_type"},
+	"TRIMA":                         {"symbol", "interval", "time_period", "series_type"},
+	"KAMA":                          {"symbol", "interval", "time_period", "series_type"},
+	"MAMA":                          {"symbol", "interval", "series_type"},
+	"VWAP":                          {"symbol", "interval"},
+	"T3":                            {"symbol", "interval", "time_period", "series_type"},
+	"MACD":                          {"symbol", "interval", "series_type"},
+	"MACDEXT":                       {"symbol", "interval", "series_type"},
+	"STOCH":                         {"symbol", "interval"},
+	"STOCHF":                        {"symbol", "interval"},
+	"RSI":                           {"symbol", "interval", "time_period", "series_type"},
+	"STOCHRSI":                      {"symbol", "interval", "time_period", "series_type"},
+	"WILLR":                         {"symbol", "interval", "time_period"},
+	"ADX":                           {"symbol", "interval", "time_period"},
+	"ADXR":                          {"symbol", "interval", "time_period"},
+	"APO":                           {"symbol", "interval", "series_type"},
+	"PPO":                           {"symbol", "interval", "series_type"},
+	"MOM":                           {"symbol", "interval", "time_period", "series_type"},
+	"BOP":                           {"symbol", "interval"},
+	"CCI":                           {"symbol", "interval", "time_period"},
+	"CMO":                           {"symbol", "interval", "time_period", "series_type"},
+	"ROC":                           {"symbol", "interval", "time_period", "series_type"},
+	"ROCR":                          {"symbol", "interval", "time_period", "series_type"},
+	"AROON":                         {"symbol", "interval", "time_period"},
+	"AROONOSC":                      {"symbol", "interval", "time_period"},
+	"MFI":                           {"symbol", "interval", "time_period"},
+	"TRIX":                          {"symbol", "interval", "time_period", "series_type"},
+	"ULTOSC":                        {"symbol", "interval"},
+	"DX":                            {"symbol", "interval", "time_period"},
+	"MINUS_DI":                      {"symbol", "interval", "time_period"},
+	"PLUS_DI":                       {"symbol", "interval", "time_period"},
+	"MINUS_DM":                      {"symbol", "interval", "time_period"},
+	"PLUS_DM":                       {"symbol", "interval", "time_period"},
+	"BBANDS":                        {"symbol", "interval", "time_period", "series_type"},
+	"MIDPOINT":                      {"symbol", "interval", "time_period", "series_type"},
+	"MIDPRICE":                      {"symbol", "interval", "time_period"},
+	"SAR":                           {"symbol", "interval"},
+	"TRANGE":                        {"symbol", "interval"},
+	"ATR":                           {"symbol", "interval", "time_period"},
+	"NATR":                          {"symbol", "interval", "time_period"},
+	"AD":                            {"symbol", "interval"},
+	"ADOSC":                         {"symbol", "interval"},
+	"OBV":                           {"symbol", "interval"},
+	"HT_TRENDLINE":                  {"symbol", "interval", "series_type"},
+	"HT_SINE":                       {"symbol", "interval", "series_type"},
+	"HT_TRENDMODE":                  {"symbol", "interval", "series_type"},
+	"HT_DCPERIOD":                   {"symbol", "interval", "series_type"},
+	"HT_DCPHASE":                    {"symbol", "interval", "series_type"},
+	"HT_PHASOR":                     {"symbol", "interval", "series_type"},
+	"TIME_SERIES_INTRADAY":          {"symbol", "interval"},
+	"TIME_SERIES_INTRADAY_EXTENDED": {"symbol", "interval", "slice"},
+	"TIME_SERIES_DAILY":             {"symbol"},
+	"TIME_SERIES_DAILY_ADJUSTED":    {"symbol"},
+	"TIME_SERIES_WEEKLY":            {"symbol"},
+	"TIME_SERIES_WEEKLY_ADJUSTED":   {"symbol"},
+	"TIME_SERIES_MONTHLY":           {"symbol"},
+	"TIME_SERIES_MONTHLY_ADJUSTED":  {"symbol"},
+	"GLOBAL_QUOTE":                  {"symbol"},
+	"SYMBOL_SEARCH":                 {"keywords"},
+	"MARKET_STATUS":                 {},
+}
+
+// documentedRules holds, for each endpoint function, the checks implied by its parameter descriptions: accepted
+// values, positive numbers and date formats.
+var documentedRules = map[string]map[string]paramRule{
+	"WTI": {
+		"interval": oneOf("monthly", "daily", "weekly"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"BRENT": {
+		"interval": oneOf("monthly", "daily", "weekly"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"NATURAL_GAS": {
+		"interval": oneOf("monthly", "daily", "weekly"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"COPPER": {
+		"interval": oneOf("monthly", "quarterly", "annual"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"ALUMINUM": {
+		"interval": oneOf("monthly", "quarterly", "annual"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"WHEAT": {
+		"interval": oneOf("monthly", "quarterly", "annual"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"CORN": {
+		"interval": oneOf("monthly", "quarterly", "annual"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"COTTON": {
+		"interval": oneOf("monthly", "quarterly", "annual"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"SUGAR": {
+		"interval": oneOf("monthly", "quarterly", "annual"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"COFFEE": {
+		"interval": oneOf("monthly", "quarterly", "annual"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"ALL_COMMODITIES": {
+		"interval": oneOf("monthly", "quarterly", "annual"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"CRYPTO_INTRADAY": {
+		"interval":   oneOf("1min", "5min", "15min", "30min", "60min"),
+		"outputsize": oneOf("compact", "full"),
+		"datatype":   oneOf("json", "csv"),
+	},
+	"REAL_GDP": {
+		"interval": oneOf("annual", "quarterly"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"REAL_GDP_PER_CAPITA": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"TREASURY_YIELD": {
+		"interval": oneOf("monthly", "daily", "weekly"),
+		"maturity": oneOf("10year", "3month", "2year", "5year", "7year", "30year"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"FEDERAL_FUNDS_RATE": {
+		"interval": oneOf("monthly", "daily", "weekly"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"CPI": {
+		"interval": oneOf("monthly", "semiannual"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"INFLATION": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"RETAIL_SALES": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"DURABLES": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"UNEMPLOYMENT": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"NONFARM_PAYROLL": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"LISTING_STATUS": {
+		"date":  timeLayout("2006-01-02", "YYYY-MM-DD", time.Time{}),
+		"state": oneOf("active", "delisted"),
+	},
+	"EARNINGS_CALENDAR": {
+		"horizon": oneOf("3month", "6month", "12month"),
+	},
+	"FX_INTRADAY": {
+		"interval":   oneOf("1min", "5min", "15min", "30min", "60min"),
+		"outputsize": oneOf("compact", "full"),
+		"datatype":   oneOf("json", "csv"),
+	},
+	"FX_DAILY": {
+		"outputsize": oneOf("compact", "full"),
+		"datatype":   oneOf("json", "csv"),
+	},
+	"FX_WEEKLY": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"FX_MONTHLY": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"NEWS_SENTIMENT": {
+		"topics":    listOf(oneOf("blockchain", "earnings", "ipo", "mergers_and_acquisitions", "financial_markets", "economy_fiscal", "economy_monetary", "economy_macro", "energy_transportation", "finance", "life_sciences", "manufacturing", "real_estate", "retail_wholesale", "technology")),
+		"time_from": timeLayout("20060102T1504", "YYYYMMDDTHHMM", time.Time{}),
+		"time_to":   timeLayout("20060102T1504", "YYYYMMDDTHHMM", time.Time{}),
+		"sort":      oneOf("LATEST", "EARLIEST", "RELEVANCE"),
+	},
+	"SMA": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"EMA": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"WMA": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"DEMA": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"TEMA": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"TRIMA": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"KAMA": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"MAMA": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"fastlimit":   positiveFloat,
+		"slowlimit":   positiveFloat,
+		"datatype":    oneOf("json", "csv"),
+	},
+	"VWAP": {
+		"interval": oneOf("1min", "5min", "15min", "30min", "60min"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"T3": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"MACD": {
+		"interval":     oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"series_type":  oneOf("close", "open", "high", "low"),
+		"fastperiod":   intBetween(1, maxInt),
+		"slowperiod":   intBetween(1, maxInt),
+		"signalperiod": intBetween(1, maxInt),
+		"datatype":     oneOf("json", "csv"),
+	},
+	"MACDEXT": {
+		"interval":     oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"series_type":  oneOf("close", "open", "high", "low"),
+		"fastperiod":   intBetween(1, maxInt),
+		"slowperiod":   intBetween(1, maxInt),
+		"signalperiod": intBetween(1, maxInt),
+		"fastmatype":   oneOf("0", "1", "2", "3", "4", "5", "6", "7", "8"),
+		"slowmatype":   oneOf("0", "1", "2", "3", "4", "5", "6", "7", "8"),
+		"signalmatype": oneOf("0", "1", "2", "3", "4", "5", "6", "7", "8"),
+		"datatype":     oneOf("json", "csv"),
+	},
+	"STOCH": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"fastkperiod": intBetween(1, maxInt),
+		"slowkperiod": intBetween(1, maxInt),
+		"slowdperiod": intBetween(1, maxInt),
+		"slowkmatype": oneOf("0", "1", "2", "3", "4", "5", "6", "7", "8"),
+		"slowdmatype": oneOf("0", "1", "2", "3", "4", "5", "6", "7", "8"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"STOCHF": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"fastkperiod": intBetween(1, maxInt),
+		"fastdperiod": intBetween(1, maxInt),
+		"fastdmatype": oneOf("0", "1", "2", "3", "4", "5", "6", "7", "8"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"RSI": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"STOCHRSI": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"fastkperiod": intBetween(1, maxInt),
+		"fastdperiod": intBetween(1, maxInt),
+		"fastdmatype": oneOf("0", "1", "2", "3", "4", "5", "6", "7", "8"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"WILLR": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"ADX": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"ADXR": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"APO": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"fastperiod":  intBetween(1, maxInt),
+		"slowperiod":  intBetween(1, maxInt),
+		"matype":      oneOf("0", "1", "2", "3", "4", "5", "6", "7", "8"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"PPO": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"fastperiod":  intBetween(1, maxInt),
+		"slowperiod":  intBetween(1, maxInt),
+		"matype":      oneOf("0", "1", "2", "3", "4", "5", "6", "7", "8"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"MOM": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"BOP": {
+		"interval": oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"CCI": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"CMO": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"ROC": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"ROCR": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"AROON": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"AROONOSC": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"MFI": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"TRIX": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"ULTOSC": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"timeperiod1": intBetween(1, maxInt),
+		"timeperiod2": intBetween(1, maxInt),
+		"timeperiod3": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"DX": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"MINUS_DI": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"PLUS_DI": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"MINUS_DM": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"PLUS_DM": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"BBANDS": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"nbdevup":     intBetween(1, maxInt),
+		"nbdevdn":     intBetween(1, maxInt),
+		"matype":      oneOf("0", "1", "2", "3", "4", "5", "6", "7", "8"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"MIDPOINT": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"MIDPRICE": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"SAR": {
+		"interval":     oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"acceleration": positiveFloat,
+		"maximum":      positiveFloat,
+		"datatype":     oneOf("json", "csv"),
+	},
+	"TRANGE": {
+		"interval": oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"ATR": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"NATR": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"time_period": intBetween(1, maxInt),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"AD": {
+		"interval": oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"ADOSC": {
+		"interval":   oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"fastperiod": intBetween(1, maxInt),
+		"slowperiod": intBetween(1, maxInt),
+		"datatype":   oneOf("json", "csv"),
+	},
+	"OBV": {
+		"interval": oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"datatype": oneOf("json", "csv"),
+	},
+	"HT_TRENDLINE": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"HT_SINE": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"HT_TRENDMODE": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"HT_DCPERIOD": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"HT_DCPHASE": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"HT_PHASOR": {
+		"interval":    oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly"),
+		"series_type": oneOf("close", "open", "high", "low"),
+		"datatype":    oneOf("json", "csv"),
+	},
+	"TIME_SERIES_INTRADAY": {
+		"interval":   oneOf("1min", "5min", "15min", "30min", "60min"),
+		"adjusted":   oneOf("true", "false"),
+		"outputsize": oneOf("compact", "full"),
+		"datatype":   oneOf("json", "csv"),
+	},
+	"TIME_SERIES_INTRADAY_EXTENDED": {
+		"interval": oneOf("1min", "5min", "15min", "30min", "60min"),
+		"adjusted": oneOf("true", "false"),
+	},
+	"TIME_SERIES_DAILY": {
+		"outputsize": oneOf("compact", "full"),
+		"datatype":   oneOf("json", "csv"),
+	},
+	"TIME_SERIES_DAILY_ADJUSTED": {
+		"outputsize": oneOf("compact", "full"),
+		"datatype":   oneOf("json", "csv"),
+	},
+	"TIME_SERIES_WEEKLY": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"TIME_SERIES_WEEKLY_ADJUSTED": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"TIME_SERIES_MONTHLY": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"TIME_SERIES_MONTHLY_ADJUSTED": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"GLOBAL_QUOTE": {
+		"datatype": oneOf("json", "csv"),
+	},
+	"SYMBOL_SEARCH": {
+		"datatype": oneOf("json", "csv"),
+	},
+}
+
+// API is the set of methods generated from the documentation page.  It is implemented by *Alphavantage and by
+// fake.Client, so code that only needs these methods can be tested without the network.
+type API interface {
+	CommoditiesAPI
+	DigitalCurrencyAPI
+	EconomicIndicatorsAPI
+	FundamentalsAPI
+	FxAPI
+	IntelligenceAPI
+	TechnicalIndicatorsAPI
+	TimeSeriesDataAPI
+}
+
+var _ API = (*Alphavantage)(nil)
+
+// CommoditiesAPI holds the methods of the Commodities endpoints.
+type CommoditiesAPI interface {
+	GetWti(opt_interval, opt_datatype string) api.Response
+	QueryWti(p WtiParams) api.Response
+	GetBrent(opt_interval, opt_datatype string) api.Response
+	QueryBrent(p BrentParams) api.Response
+	GetNaturalGas(opt_interval, opt_datatype string) api.Response
+	QueryNaturalGas(p NaturalGasParams) api.Response
+	GetCopper(opt_interval, opt_datatype string) api.Response
+	QueryCopper(p CopperParams) api.Response
+	GetAluminum(opt_interval, opt_datatype string) api.Response
+	QueryAluminum(p AluminumParams) api.Response
+	GetWheat(opt_interval, opt_datatype string) api.Response
+	QueryWheat(p WheatParams) api.Response
+	GetCorn(opt_interval, opt_datatype string) api.Response
+	QueryCorn(p CornParams) api.Response
+	GetCotton(opt_interval, opt_datatype string) api.Response
+	QueryCotton(p CottonParams) api.Response
+	GetSugar(opt_interval, opt_datatype string) api.Response
+	QuerySugar(p SugarParams) api.Response
+	GetCoffee(opt_interval, opt_datatype string) api.Response
+	QueryCoffee(p CoffeeParams) api.Response
+	GetAllCommodities(opt_interval, opt_datatype string) api.Response
+	QueryAllCommodities(p AllCommoditiesParams) api.Response
+}
+
+// DigitalCurrencyAPI holds the methods of the Digital & Crypto Currencies endpoints.
+type DigitalCurrencyAPI interface {
+	GetCryptoIntraday(symbol, market, interval, opt_outputsize, opt_datatype string) api.Response
+	QueryCryptoIntraday(p CryptoIntradayParams) api.Response
+	GetDigitalCurrencyDaily(symbol, market string) api.Response
+	QueryDigitalCurrencyDaily(p DigitalCurrencyDailyParams) api.Response
+	GetDigitalCurrencyWeekly(symbol, market string) api.Response
+	QueryDigitalCurrencyWeekly(p DigitalCurrencyWeeklyParams) api.Response
+	GetDigitalCurrencyMonthly(symbol, market string) api.Response
+	QueryDigitalCurrencyMonthly(p DigitalCurrencyMonthlyParams) api.Response
+}
+
+// EconomicIndicatorsAPI holds the methods of the Economic Indicators endpoints.
+type EconomicIndicatorsAPI interface {
+	GetRealGdp(opt_interval, opt_datatype string) api.Response
+	QueryRealGdp(p RealGdpParams) api.Response
+	GetRealGdpPerCapita(opt_datatype string) api.Response
+	QueryRealGdpPerCapita(p RealGdpPerCapitaParams) api.Response
+	GetTreasuryYield(opt_interval, opt_maturity, opt_datatype string) api.Response
+	QueryTreasuryYield(p TreasuryYieldParams) api.Response
+	GetFederalFundsRate(opt_interval, opt_datatype string) api.Response
+	QueryFederalFundsRate(p FederalFundsRateParams) api.Response
+	GetCpi(opt_interval, opt_datatype string) api.Response
+	QueryCpi(p CpiParams) api.Response
+	GetInflation(opt_datatype string) api.Response
+	QueryInflation(p InflationParams) api.Response
+	GetRetailSales(opt_datatype string) api.Response
+	QueryRetailSales(p RetailSalesParams) api.Response
+	GetDurables(opt_datatype string) api.Response
+	QueryDurables(p DurablesParams) api.Response
+	GetUnemployment(opt_datatype string) api.Response
+	QueryUnemployment(p UnemploymentParams) api.Response
+	GetNonfarmPayroll(opt_datatype string) api.Response
+	QueryNonfarmPayroll(p NonfarmPayrollParams) api.Response
+}
+
+// FundamentalsAPI holds the methods of the Fundamental Data endpoints.
+type FundamentalsAPI interface {
+	GetOverview(symbol string) api.Response
+	QueryOverview(p OverviewParams) api.Response
+	GetIncomeStatement(symbol string) api.Response
+	QueryIncomeStatement(p IncomeStatementParams) api.Response
+	GetBalanceSheet(symbol string) api.Response
+	QueryBalanceSheet(p BalanceSheetParams) api.Response
+	GetCashFlow(symbol string) api.Response
+	QueryCashFlow(p CashFlowParams) api.Response
+	GetEarnings(symbol string) api.Response
+	QueryEarnings(p EarningsParams) api.Response
+	GetListingStatus(opt_date, opt_state string) api.Response
+	QueryListingStatus(p ListingStatusParams) api.Response
+	GetEarningsCalendar(opt_symbol, opt_horizon string) api.Response
+	QueryEarningsCalendar(p EarningsCalendarParams) api.Response
+	GetIpoCalendar() api.Response
+	QueryIpoCalendar(p IpoCalendarParams) api.Response
+}
+
+// FxAPI holds the methods of the Foreign Exchange (FX) endpoints.
+type FxAPI interface {
+	GetCurrencyExchangeRate(from_currency, to_currency string) api.Response
+	QueryCurrencyExchangeRate(p CurrencyExchangeRateParams) api.Response
+	GetFxIntraday(from_symbol, to_symbol, interval, opt_outputsize, opt_datatype string) api.Response
+	QueryFxIntraday(p FxIntradayParams) api.Response
+	GetFxDaily(from_symbol, to_symbol, opt_outputsize, opt_datatype string) api.Response
+	QueryFxDaily(p FxDailyParams) api.Response
+	GetFxWeekly(from_symbol, to_symbol, opt_datatype string) api.Response
+	QueryFxWeekly(p FxWeeklyParams) api.Response
+	GetFxMonthly(from_symbol, to_symbol, opt_datatype string) api.Response
+	QueryFxMonthly(p FxMonthlyParams) api.Response
+}
+
+// IntelligenceAPI holds the methods of the Alpha Intelligence™ endpoints.
+type IntelligenceAPI interface {
+	GetNewsSentiment(opt_tickers, opt_topics, opt_time_from, opt_time_to, opt_sort, opt_limit string) api.Response
+	QueryNewsSentiment(p NewsSentimentParams) api.Response
+}
 
-	return a.client.Query(function, params)
+// TechnicalIndicatorsAPI holds the methods of the Technical Indicators endpoints.
+type TechnicalIndicatorsAPI interface {
+	GetSma(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QuerySma(p SmaParams) api.Response
+	GetEma(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryEma(p EmaParams) api.Response
+	GetWma(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryWma(p WmaParams) api.Response
+	GetDema(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryDema(p DemaParams) api.Response
+	GetTema(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryTema(p TemaParams) api.Response
+	GetTrima(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryTrima(p TrimaParams) api.Response
+	GetKama(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryKama(p KamaParams) api.Response
+	GetMama(symbol, interval, series_type, opt_fastlimit, opt_slowlimit, opt_datatype string) api.Response
+	QueryMama(p MamaParams) api.Response
+	GetVwap(symbol, interval, opt_datatype string) api.Response
+	QueryVwap(p VwapParams) api.Response
+	GetT3(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryT3(p T3Params) api.Response
+	GetMacd(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_datatype string) api.Response
+	QueryMacd(p MacdParams) api.Response
+	GetMacdext(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_fastmatype, opt_slowmatype, opt_signalmatype, opt_datatype string) api.Response
+	QueryMacdext(p MacdextParams) api.Response
+	GetStoch(symbol, interval, opt_fastkperiod, opt_slowkperiod, opt_slowdperiod, opt_slowkmatype, opt_slowdmatype, opt_datatype string) api.Response
+	QueryStoch(p StochParams) api.Response
+	GetStochf(symbol, interval, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype string) api.Response
+	QueryStochf(p StochfParams) api.Response
+	GetRsi(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryRsi(p RsiParams) api.Response
+	GetStochrsi(symbol, interval, time_period, series_type, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype string) api.Response
+	QueryStochrsi(p StochrsiParams) api.Response
+	GetWillr(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryWillr(p WillrParams) api.Response
+	GetAdx(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryAdx(p AdxParams) api.Response
+	GetAdxr(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryAdxr(p AdxrParams) api.Response
+	GetApo(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype string) api.Response
+	QueryApo(p ApoParams) api.Response
+	GetPpo(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype string) api.Response
+	QueryPpo(p PpoParams) api.Response
+	GetMom(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryMom(p MomParams) api.Response
+	GetBop(symbol, interval, opt_datatype string) api.Response
+	QueryBop(p BopParams) api.Response
+	GetCci(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryCci(p CciParams) api.Response
+	GetCmo(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryCmo(p CmoParams) api.Response
+	GetRoc(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryRoc(p RocParams) api.Response
+	GetRocr(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryRocr(p RocrParams) api.Response
+	GetAroon(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryAroon(p AroonParams) api.Response
+	GetAroonosc(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryAroonosc(p AroonoscParams) api.Response
+	GetMfi(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryMfi(p MfiParams) api.Response
+	GetTrix(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryTrix(p TrixParams) api.Response
+	GetUltosc(symbol, interval, opt_timeperiod1, opt_timeperiod2, opt_timeperiod3, opt_datatype string) api.Response
+	QueryUltosc(p UltoscParams) api.Response
+	GetDx(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryDx(p DxParams) api.Response
+	GetMinusDi(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryMinusDi(p MinusDiParams) api.Response
+	GetPlusDi(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryPlusDi(p PlusDiParams) api.Response
+	GetMinusDm(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryMinusDm(p MinusDmParams) api.Response
+	GetPlusDm(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryPlusDm(p PlusDmParams) api.Response
+	GetBbands(symbol, interval, time_period, series_type, opt_nbdevup, opt_nbdevdn, opt_matype, opt_datatype string) api.Response
+	QueryBbands(p BbandsParams) api.Response
+	GetMidpoint(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryMidpoint(p MidpointParams) api.Response
+	GetMidprice(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryMidprice(p MidpriceParams) api.Response
+	GetSar(symbol, interval, opt_acceleration, opt_maximum, opt_datatype string) api.Response
+	QuerySar(p SarParams) api.Response
+	GetTrange(symbol, interval, opt_datatype string) api.Response
+	QueryTrange(p TrangeParams) api.Response
+	GetAtr(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryAtr(p AtrParams) api.Response
+	GetNatr(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryNatr(p NatrParams) api.Response
+	GetAd(symbol, interval, opt_datatype string) api.Response
+	QueryAd(p AdParams) api.Response
+	GetAdosc(symbol, interval, opt_fastperiod, opt_slowperiod, opt_datatype string) api.Response
+	QueryAdosc(p AdoscParams) api.Response
+	GetObv(symbol, interval, opt_datatype string) api.Response
+	QueryObv(p ObvParams) api.Response
+	GetHtTrendline(symbol, interval, series_type, opt_datatype string) api.Response
+	QueryHtTrendline(p HtTrendlineParams) api.Response
+	GetHtSine(symbol, interval, series_type, opt_datatype string) api.Response
+	QueryHtSine(p HtSineParams) api.Response
+	GetHtTrendmode(symbol, interval, series_type, opt_datatype string) api.Response
+	QueryHtTrendmode(p HtTrendmodeParams) api.Response
+	GetHtDcperiod(symbol, interval, series_type, opt_datatype string) api.Response
+	QueryHtDcperiod(p HtDcperiodParams) api.Response
+	GetHtDcphase(symbol, interval, series_type, opt_datatype string) api.Response
+	QueryHtDcphase(p HtDcphaseParams) api.Response
+	GetHtPhasor(symbol, interval, series_type, opt_datatype string) api.Response
+	QueryHtPhasor(p HtPhasorParams) api.Response
 }
 
-// Checksum: C9nQYlBo4vIrBxhXXTFbZct/xYtP+sCm209jYlnOhao=
+// TimeSeriesDataAPI holds the methods of the Time Series Stock Data APIs endpoints.
+type TimeSeriesDataAPI interface {
+	GetTimeSeriesIntraday(symbol, interval, opt_adjusted, opt_outputsize, opt_datatype string) api.Response
+	QueryTimeSeriesIntraday(p TimeSeriesIntradayParams) api.Response
+	GetTimeSeriesIntradayExtended(symbol, interval, slice, opt_adjusted string) api.Response
+	QueryTimeSeriesIntradayExtended(p TimeSeriesIntradayExtendedParams) api.Response
+	GetTimeSeriesDaily(symbol, opt_outputsize, opt_datatype string) api.Response
+	QueryTimeSeriesDaily(p TimeSeriesDailyParams) api.Response
+	GetTimeSeriesDailyAdjusted(symbol, opt_outputsize, opt_datatype string) api.Response
+	QueryTimeSeriesDailyAdjusted(p TimeSeriesDailyAdjustedParams) api.Response
+	GetTimeSeriesWeekly(symbol, opt_datatype string) api.Response
+	QueryTimeSeriesWeekly(p TimeSeriesWeeklyParams) api.Response
+	GetTimeSeriesWeeklyAdjusted(symbol, opt_datatype string) api.Response
+	QueryTimeSeriesWeeklyAdjusted(p TimeSeriesWeeklyAdjustedParams) api.Response
+	GetTimeSeriesMonthly(symbol, opt_datatype string) api.Response
+	QueryTimeSeriesMonthly(p TimeSeriesMonthlyParams) api.Response
+	GetTimeSeriesMonthlyAdjusted(symbol, opt_datatype string) api.Response
+	QueryTimeSeriesMonthlyAdjusted(p TimeSeriesMonthlyAdjustedParams) api.Response
+	GetGlobalQuote(symbol, opt_datatype string) api.Response
+	QueryGlobalQuote(p GlobalQuoteParams) api.Response
+	GetSymbolSearch(keywords, opt_datatype string) api.Response
+	QuerySymbolSearch(p SymbolSearchParams) api.Response
+	GetMarketStatus() api.Response
+	QueryMarketStatus(p MarketStatusParams) api.Response
+}
@@ -0,0 +1,968 @@
+package avtest
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// request is a request being answered, with the random source its data is generated from.
+type request struct {
+	function string
+	params   url.Values
+	now      time.Time
+	rand     *rand.Rand
+	server   *Server
+}
+
+// result is a generated response.  Endpoints with both forms answer with CSV when datatype=csv; the others always
+// answer in the form they have.
+type result struct {
+	json any
+	csv  [][]string // Header row first
+}
+
+type generator func(r *request) result
+
+var generators = map[string]generator{}
+
+func init() {
+	for function, generate := range map[string]generator{
+		"TIME_SERIES_INTRADAY":          stockSeries,
+		"TIME_SERIES_INTRADAY_EXTENDED": extendedIntraday,
+		"TIME_SERIES_DAILY":             stockSeries,
+		"TIME_SERIES_DAILY_ADJUSTED":    stockSeries,
+		"TIME_SERIES_WEEKLY":            stockSeries,
+		"TIME_SERIES_WEEKLY_ADJUSTED":   stockSeries,
+		"TIME_SERIES_MONTHLY":           stockSeries,
+		"TIME_SERIES_MONTHLY_ADJUSTED":  stockSeries,
+		"GLOBAL_QUOTE":                  globalQuote,
+		"SYMBOL_SEARCH":                 symbolSearch,
+		"MARKET_STATUS":                 marketStatus,
+
+		"CURRENCY_EXCHANGE_RATE": exchangeRate,
+		"FX_INTRADAY":            fxSeries,
+		"FX_DAILY":               fxSeries,
+		"FX_WEEKLY":              fxSeries,
+		"FX_MONTHLY":             fxSeries,
+
+		"CRYPTO_INTRADAY":          cryptoIntraday,
+		"DIGITAL_CURRENCY_DAILY":   digitalCurrencySeries,
+		"DIGITAL_CURRENCY_WEEKLY":  digitalCurrencySeries,
+		"DIGITAL_CURRENCY_MONTHLY": digitalCurrencySeries,
+
+		"OVERVIEW":          overview,
+		"INCOME_STATEMENT":  statement,
+		"BALANCE_SHEET":     statement,
+		"CASH_FLOW":         statement,
+		"EARNINGS":          earnings,
+		"LISTING_STATUS":    listingStatus,
+		"EARNINGS_CALENDAR": earningsCalendar,
+		"IPO_CALENDAR":      ipoCalendar,
+
+		"NEWS_SENTIMENT": newsSentiment,
+	} {
+		generators[function] = generate
+	}
+
+	for function := range economicIndicators {
+		generators[function] = economicIndicator
+	}
+	for _, function := range technicalIndicators {
+		generators[function] = technicalIndicator
+	}
+}
+
+// requiresSymbol are the functions whose symbol must be a known equity even if it is left out.
+var requiresSymbol = map[string]bool{
+	"TIME_SERIES_INTRADAY": true, "TIME_SERIES_INTRADAY_EXTENDED": true, "TIME_SERIES_DAILY": true,
+	"TIME_SERIES_DAILY_ADJUSTED": true, "TIME_SERIES_WEEKLY": true, "TIME_SERIES_WEEKLY_ADJUSTED": true,
+	"TIME_SERIES_MONTHLY": true, "TIME_SERIES_MONTHLY_ADJUSTED": true, "GLOBAL_QUOTE": true, "OVERVIEW": true,
+	"INCOME_STATEMENT": true, "BALANCE_SHEET": true, "CASH_FLOW": true, "EARNINGS": true,
+}
+
+// cryptoFunctions take a digital currency code as their symbol.
+var cryptoFunctions = map[string]bool{
+	"CRYPTO_INTRADAY": true, "DIGITAL_CURRENCY_DAILY": true, "DIGITAL_CURRENCY_WEEKLY": true,
+	"DIGITAL_CURRENCY_MONTHLY": true,
+}
+
+// intradayFunctions require an intraday interval such as 5min.
+var intradayFunctions = map[string]bool{
+	"TIME_SERIES_INTRADAY": true, "TIME_SERIES_INTRADAY_EXTENDED": true, "FX_INTRADAY": true,
+	"CRYPTO_INTRADAY": true,
+}
+
+// param returns the value of a parameter, or fallback if it is not given.
+func (r *request) param(name, fallback string) string {
+	if value := r.params.Get(name); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// basePrice is the price a symbol's generated prices wander around.
+func (r *request) basePrice(symbol string) float64 {
+	if known, found := r.server.symbols[symbol]; found && known.Price > 0 {
+		return known.Price
+	}
+	return 100
+}
+
+// bar is one point of a generated price series.
+type bar struct {
+	time                   time.Time
+	open, high, low, close float64
+	volume                 int64
+}
+
+// walk generates a random walk of prices around base, one bar for each of the first points timestamps, which are
+// newest first.
+func (r *request) walk(base float64, points int, timestamps []time.Time) []bar {
+	bars := make([]bar, 0, points)
+	price := base * (0.9 + 0.2*r.rand.Float64())
+	for _, timestamp := range timestamps[:points] {
+		open := price
+		close := math.Max(open*(1+0.02*r.rand.NormFloat64()), 0.01)
+		bars = append(bars, bar{
+			time:   timestamp,
+			open:   open,
+			high:   math.Max(open, close) * (1 + 0.01*r.rand.Float64()),
+			low:    math.Min(open, close) * (1 - 0.01*r.rand.Float64()),
+			close:  close,
+			volume: int64(1e5 + r.rand.Float64()*5e6),
+		})
+		// Walking back in time, the previous bar closes around where this one opens.
+		price = open * (1 + 0.002*r.rand.NormFloat64())
+	}
+	return bars
+}
+
+// timestamps returns count times, newest first, at the given spacing: an intraday interval such as 5min, or daily,
+// weekly or monthly.  Daily series skip weekends, weekly ones fall on Fridays and monthly ones on month ends.
+// fiscalPeriodEnds returns the ends of the last count calendar periods of the given number of months that were
+// reported by now, most recent first.  Results are reported 25 days after the end of the period.
+func (r *request) fiscalPeriodEnds(months int, count int) []time.Time {
+	var ends []time.Time
+	for _, monthEnd := range r.timestamps("monthly", months*(count+2)) {
+		if int(monthEnd.Month())%months == 0 && monthEnd.AddDate(0, 0, 25).Before(r.now) && len(ends) < count {
+			ends = append(ends, monthEnd)
+		}
+	}
+	return ends
+}
+
+func (r *request) timestamps(spacing string, count int) []time.Time {
+	var times []time.Time
+	day := time.Date(r.now.Year(), r.now.Month(), r.now.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch spacing {
+	case "daily":
+		for ; len(times) < count; day = day.AddDate(0, 0, -1) {
+			if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+				times = append(times, day)
+			}
+		}
+	case "weekly":
+		for day.Weekday() != time.Friday {
+			day = day.AddDate(0, 0, -1)
+		}
+		for ; len(times) < count; day = day.AddDate(0, 0, -7) {
+			times = append(times, day)
+		}
+	case "monthly":
+		monthEnd := time.Date(r.now.Year(), r.now.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+		for ; len(times) < count; monthEnd = time.Date(monthEnd.Year(), monthEnd.Month(), 0, 0, 0, 0, 0, time.UTC) {
+			times = append(times, monthEnd)
+		}
+	default:
+		minutes, _ := strconv.Atoi(strings.TrimSuffix(spacing, "min"))
+		if minutes <= 0 {
+			minutes = 5
+		}
+		step := time.Duration(minutes) * time.Minute
+		latest := r.now.UTC().Truncate(step)
+		for ; len(times) < count; latest = latest.Add(-step) {
+			times = append(times, latest)
+		}
+	}
+	return times
+}
+
+// points returns the number of points of a series with the requested outputsize.
+func (r *request) points() int {
+	if r.param("outputsize", "compact") == "full" {
+		return 1000
+	}
+	return 100
+}
+
+func formatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', 4, 64)
+}
+
+func formatTimestamp(t time.Time, spacing string) string {
+	if strings.HasSuffix(spacing, "min") {
+		return t.Format("2006-01-02 15:04:05")
+	}
+	return t.Format("2006-01-02")
+}
+
+func titleCase(word string) string {
+	return strings.ToUpper(word[:1]) + word[1:]
+}
+
+// stockSeries answers the TIME_SERIES_* functions.
+func stockSeries(r *request) result {
+	symbol := r.params.Get("symbol")
+	adjusted := strings.HasSuffix(r.function, "_ADJUSTED")
+	spacing := strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(r.function, "TIME_SERIES_"), "_ADJUSTED"))
+	points := r.points()
+	if spacing == "intraday" {
+		spacing = r.params.Get("interval")
+	} else if spacing != "daily" {
+		points = 200 // Weekly and monthly series have no outputsize and are always complete
+	}
+
+	bars := r.walk(r.basePrice(symbol), points, r.timestamps(spacing, points))
+	last := formatTimestamp(bars[0].time, spacing)
+
+	var seriesKey string
+	metadata := map[string]string{"2. Symbol": symbol, "3. Last Refreshed": last}
+	switch {
+	case strings.HasSuffix(spacing, "min"):
+		seriesKey = fmt.Sprintf("Time Series (%v)", spacing)
+		metadata["1. Information"] = fmt.Sprintf("Intraday (%v) open, high, low, close prices and volume", spacing)
+		metadata["4. Interval"] = spacing
+		metadata["5. Output Size"] = titleCase(r.param("outputsize", "compact"))
+		metadata["6. Time Zone"] = "US/Eastern"
+	case spacing == "daily":
+		seriesKey = "Time Series (Daily)"
+		metadata["1. Information"] = "Daily Prices (open, high, low, close) and Volumes"
+		if adjusted {
+			metadata["1. Information"] = "Daily Time Series with Splits and Dividend Events"
+		}
+		metadata["4. Output Size"] = titleCase(r.param("outputsize", "compact"))
+		metadata["5. Time Zone"] = "US/Eastern"
+	default:
+		seriesKey = titleCase(spacing) + " Time Series"
+		metadata["1. Information"] = titleCase(spacing) + " Prices (open, high, low, close) and Volumes"
+		if adjusted {
+			seriesKey = titleCase(spacing) + " Adjusted Time Series"
+			metadata["1. Information"] = titleCase(spacing) + " Adjusted Prices and Volumes"
+		}
+		metadata["4. Time Zone"] = "US/Eastern"
+	}
+
+	header := []string{"timestamp", "open", "high", "low", "close", "volume"}
+	if adjusted {
+		header = []string{"timestamp", "open", "high", "low", "close", "adjusted close", "volume", "dividend amount"}
+		if spacing == "daily" {
+			header = append(header, "split coefficient")
+		}
+	}
+
+	series := map[string]map[string]string{}
+	rows := [][]string{header}
+	for _, bar := range bars {
+		values := []string{formatPrice(bar.open), formatPrice(bar.high), formatPrice(bar.low), formatPrice(bar.close)}
+		if adjusted {
+			values = append(values, formatPrice(bar.close*0.98))
+		}
+		values = append(values, strconv.FormatInt(bar.volume, 10))
+		if adjusted {
+			values = append(values, "0.0000")
+			if spacing == "daily" {
+				values = append(values, "1.0")
+			}
+		}
+
+		point := map[string]string{}
+		for i, value := range values {
+			point[fmt.Sprintf("%d. %v", i+1, header[i+1])] = value
+		}
+		timestamp := formatTimestamp(bar.time, spacing)
+		series[timestamp] = point
+		rows = append(rows, append([]string{timestamp}, values...))
+	}
+
+	return result{
+		json: map[string]any{"Meta Data": metadata, seriesKey: series},
+		csv:  csvHeaderNames(rows),
+	}
+}
+
+// csvHeaderNames converts the JSON field names of a header row to the snake case the CSV form uses.
+func csvHeaderNames(rows [][]string) [][]string {
+	header := make([]string, len(rows[0]))
+	for i, name := range rows[0] {
+		header[i] = strings.ReplaceAll(name, " ", "_")
+	}
+	rows[0] = header
+	return rows
+}
+
+// extendedIntraday answers TIME_SERIES_INTRADAY_EXTENDED, which is only available as CSV.
+func extendedIntraday(r *request) result {
+	symbol := r.params.Get("symbol")
+	spacing := r.params.Get("interval")
+	bars := r.walk(r.basePrice(symbol), 300, r.timestamps(spacing, 300))
+
+	rows := [][]string{{"time", "open", "high", "low", "close", "volume"}}
+	for _, bar := range bars {
+		rows = append(rows, []string{formatTimestamp(bar.time, spacing), formatPrice(bar.open), formatPrice(bar.high),
+			formatPrice(bar.low), formatPrice(bar.close), strconv.FormatInt(bar.volume, 10)})
+	}
+	return result{csv: rows}
+}
+
+func globalQuote(r *request) result {
+	symbol := r.params.Get("symbol")
+	bars := r.walk(r.basePrice(symbol), 2, r.timestamps("daily", 2))
+	today, previous := bars[0], bars[1]
+	change := today.close - previous.close
+
+	quote := [][2]string{
+		{"01. symbol", symbol},
+		{"02. open", formatPrice(today.open)},
+		{"03. high", formatPrice(today.high)},
+		{"04. low", formatPrice(today.low)},
+		{"05. price", formatPrice(today.close)},
+		{"06. volume", strconv.FormatInt(today.volume, 10)},
+		{"07. latest trading day", today.time.Format("2006-01-02")},
+		{"08. previous close", formatPrice(previous.close)},
+		{"09. change", formatPrice(change)},
+		{"10. change percent", strconv.FormatFloat(100*change/previous.close, 'f', 4, 64) + "%"},
+	}
+
+	fields := map[string]string{}
+	header := []string{"symbol", "open", "high", "low", "price", "volume", "latestDay", "previousClose", "change",
+		"changePercent"}
+	var row []string
+	for _, field := range quote {
+		fields[field[0]] = field[1]
+		row = append(row, field[1])
+	}
+	return result{
+		json: map[string]any{"Global Quote": fields},
+		csv:  [][]string{header, row},
+	}
+}
+
+func symbolSearch(r *request) result {
+	keywords := strings.ToUpper(r.params.Get("keywords"))
+
+	var matches []map[string]string
+	rows := [][]string{{"symbol", "name", "type", "region", "marketOpen", "marketClose", "timezone", "currency",
+		"matchScore"}}
+	for _, symbol := range r.server.config.Symbols {
+		name := strings.ToUpper(symbol.Name)
+		if keywords == "" || (!strings.Contains(symbol.Symbol, keywords) && !strings.Contains(name, keywords)) {
+			continue
+		}
+
+		score := float64(len(keywords)) / float64(len(symbol.Symbol)+len(symbol.Name))
+		if strings.HasPrefix(symbol.Symbol, keywords) {
+			score = float64(len(keywords)) / float64(len(symbol.Symbol))
+		}
+		fields := []string{symbol.Symbol, symbol.Name, "Equity", "United States", "09:30", "16:00", "UTC-04", "USD",
+			strconv.FormatFloat(math.Min(score, 1), 'f', 4, 64)}
+
+		match := map[string]string{}
+		for i, value := range fields {
+			match[fmt.Sprintf("%d. %v", i+1, rows[0][i])] = value
+		}
+		matches = append(matches, match)
+		rows = append(rows, fields)
+	}
+	if matches == nil {
+		matches = []map[string]string{}
+	}
+
+	return result{json: map[string]any{"bestMatches": matches}, csv: rows}
+}
+
+func marketStatus(r *request) result {
+	type market struct {
+		MarketType       string `json:"market_type"`
+		Region           string `json:"region"`
+		PrimaryExchanges string `json:"primary_exchanges"`
+		LocalOpen        string `json:"local_open"`
+		LocalClose       string `json:"local_close"`
+		CurrentStatus    string `json:"current_status"`
+		Notes            string `json:"notes"`
+	}
+
+	markets := []market{
+		{"Equity", "United States", "NASDAQ, NYSE, AMEX, BATS", "09:30", "16:00", "", ""},
+		{"Equity", "United Kingdom", "London Stock Exchange", "08:00", "16:30", "", ""},
+		{"Equity", "Japan", "Tokyo Stock Exchange", "09:00", "15:00", "", ""},
+		{"Forex", "Global", "N/A", "00:00", "23:59", "", ""},
+	}
+	zones := []string{"America/New_York", "Europe/London", "Asia/Tokyo", "UTC"}
+	for i := range markets {
+		markets[i].CurrentStatus = "closed"
+		location, err := time.LoadLocation(zones[i])
+		if err != nil {
+			continue
+		}
+		local := r.now.In(location).Format("15:04")
+		weekday := r.now.In(location).Weekday()
+		if local >= markets[i].LocalOpen && local < markets[i].LocalClose &&
+			weekday != time.Saturday && weekday != time.Sunday {
+			markets[i].CurrentStatus = "open"
+		}
+	}
+
+	return result{json: map[string]any{"endpoint": "Global Market Open & Close Status", "markets": markets}}
+}
+
+// currencyRate is the generated price of one unit of from in to.  It is stable across requests, so that series and
+// quotes of the same pair agree.
+func (r *request) currencyRate(from, to string) float64 {
+	return currencyValue(from) / currencyValue(to)
+}
+
+// currencyValue is an arbitrary but stable value in US dollars for a currency code.
+func currencyValue(code string) float64 {
+	known := map[string]float64{"USD": 1, "EUR": 1.08, "GBP": 1.25, "JPY": 0.0072, "CAD": 0.74, "CNY": 0.14,
+		"BTC": 27000, "ETH": 1800}
+	if value, found := known[code]; found {
+		return value
+	}
+
+	sum := 0
+	for _, char := range code {
+		sum += int(char)
+	}
+	return 0.1 + float64(sum%97)/10
+}
+
+func exchangeRate(r *request) result {
+	from, to := r.params.Get("from_currency"), r.params.Get("to_currency")
+	rate := r.currencyRate(from, to) * (1 + 0.001*r.rand.NormFloat64())
+
+	return result{json: map[string]any{"Realtime Currency Exchange Rate": map[string]string{
+		"1. From_Currency Code": from,
+		"2. From_Currency Name": from,
+		"3. To_Currency Code":   to,
+		"4. To_Currency Name":   to,
+		"5. Exchange Rate":      formatPrice(rate),
+		"6. Last Refreshed":     r.now.UTC().Format("2006-01-02 15:04:05"),
+		"7. Time Zone":          "UTC",
+		"8. Bid Price":          formatPrice(rate * 0.9999),
+		"9. Ask Price":          formatPrice(rate * 1.0001),
+	}}}
+}
+
+func fxSeries(r *request) result {
+	from, to := r.params.Get("from_symbol"), r.params.Get("to_symbol")
+	spacing := strings.ToLower(strings.TrimPrefix(r.function, "FX_"))
+	if spacing == "intraday" {
+		spacing = r.params.Get("interval")
+	}
+	points := r.points()
+	bars := r.walk(r.currencyRate(from, to), points, r.timestamps(spacing, points))
+
+	seriesName := titleCase(spacing)
+	information := fmt.Sprintf("Forex %v Prices (open, high, low, close)", seriesName)
+	if strings.HasSuffix(spacing, "min") {
+		seriesName = spacing
+		information = fmt.Sprintf("FX Intraday (%v) Time Series", spacing)
+	}
+	metadata := map[string]string{
+		"1. Information":    information,
+		"2. From Symbol":    from,
+		"3. To Symbol":      to,
+		"4. Last Refreshed": formatTimestamp(bars[0].time, spacing),
+		"5. Time Zone":      "UTC",
+	}
+
+	series := map[string]map[string]string{}
+	rows := [][]string{{"timestamp", "open", "high", "low", "close"}}
+	for _, bar := range bars {
+		timestamp := formatTimestamp(bar.time, spacing)
+		series[timestamp] = map[string]string{
+			"1. open": formatPrice(bar.open), "2. high": formatPrice(bar.high), "3. low": formatPrice(bar.low),
+			"4. close": formatPrice(bar.close),
+		}
+		rows = append(rows, []string{timestamp, formatPrice(bar.open), formatPrice(bar.high), formatPrice(bar.low),
+			formatPrice(bar.close)})
+	}
+
+	return result{
+		json: map[string]any{"Meta Data": metadata, fmt.Sprintf("Time Series FX (%v)", seriesName): series},
+		csv:  rows,
+	}
+}
+
+func cryptoIntraday(r *request) result {
+	symbol, market := r.params.Get("symbol"), r.params.Get("market")
+	spacing := r.params.Get("interval")
+	points := r.points()
+	bars := r.walk(r.currencyRate(symbol, market), points, r.timestamps(spacing, points))
+
+	metadata := map[string]string{
+		"1. Information":           fmt.Sprintf("Crypto Intraday (%v) Time Series", spacing),
+		"2. Digital Currency Code": symbol,
+		"3. Digital Currency Name": symbol,
+		"4. Market Code":           market,
+		"5. Market Name":           market,
+		"6. Last Refreshed":        formatTimestamp(bars[0].time, spacing),
+		"7. Interval":              spacing,
+		"8. Output Size":           titleCase(r.param("outputsize", "compact")),
+		"9. Time Zone":             "UTC",
+	}
+
+	series := map[string]map[string]string{}
+	rows := [][]string{{"timestamp", "open", "high", "low", "close", "volume"}}
+	for _, bar := range bars {
+		timestamp := formatTimestamp(bar.time, spacing)
+		volume := strconv.FormatInt(bar.volume/1000, 10)
+		series[timestamp] = map[string]string{
+			"1. open": formatPrice(bar.open), "2. high": formatPrice(bar.high), "3. low": formatPrice(bar.low),
+			"4. close": formatPrice(bar.close), "5. volume": volume,
+		}
+		rows = append(rows, []string{timestamp, formatPrice(bar.open), formatPrice(bar.high), formatPrice(bar.low),
+			formatPrice(bar.close), volume})
+	}
+
+	return result{
+		json: map[string]any{"Meta Data": metadata, fmt.Sprintf("Time Series Crypto (%v)", spacing): series},
+		csv:  rows,
+	}
+}
+
+// digitalCurrencySeries answers the DIGITAL_CURRENCY_* functions, whose prices come in both the requested market's
+// currency and US dollars.
+func digitalCurrencySeries(r *request) result {
+	symbol, market := r.params.Get("symbol"), r.params.Get("market")
+	spacing := strings.ToLower(strings.TrimPrefix(r.function, "DIGITAL_CURRENCY_"))
+	bars := r.walk(r.currencyRate(symbol, "USD"), 200, r.timestamps(spacing, 200))
+	toMarket := r.currencyRate("USD", market)
+
+	metadata := map[string]string{
+		"1. Information":           fmt.Sprintf("%v Prices and Volumes for Digital Currency", titleCase(spacing)),
+		"2. Digital Currency Code": symbol,
+		"3. Digital Currency Name": symbol,
+		"4. Market Code":           market,
+		"5. Market Name":           market,
+		"6. Last Refreshed":        formatTimestamp(bars[0].time, spacing),
+		"7. Time Zone":             "UTC",
+	}
+
+	series := map[string]map[string]string{}
+	rows := [][]string{{"timestamp", "open (" + market + ")", "high (" + market + ")", "low (" + market + ")",
+		"close (" + market + ")", "open (USD)", "high (USD)", "low (USD)", "close (USD)", "volume",
+		"market cap (USD)"}}
+	for _, bar := range bars {
+		timestamp := formatTimestamp(bar.time, spacing)
+		volume := float64(bar.volume) / 100
+		point := map[string]string{
+			"1a. open (" + market + ")":  formatPrice(bar.open * toMarket),
+			"1b. open (USD)":             formatPrice(bar.open),
+			"2a. high (" + market + ")":  formatPrice(bar.high * toMarket),
+			"2b. high (USD)":             formatPrice(bar.high),
+			"3a. low (" + market + ")":   formatPrice(bar.low * toMarket),
+			"3b. low (USD)":              formatPrice(bar.low),
+			"4a. close (" + market + ")": formatPrice(bar.close * toMarket),
+			"4b. close (USD)":            formatPrice(bar.close),
+			"5. volume":                  formatPrice(volume),
+			"6. market cap (USD)":        formatPrice(volume * bar.close),
+		}
+		series[timestamp] = point
+		rows = append(rows, []string{timestamp, point["1a. open ("+market+")"], point["2a. high ("+market+")"],
+			point["3a. low ("+market+")"], point["4a. close ("+market+")"], point["1b. open (USD)"],
+			point["2b. high (USD)"], point["3b. low (USD)"], point["4b. close (USD)"], point["5. volume"],
+			point["6. market cap (USD)"]})
+	}
+
+	seriesKey := fmt.Sprintf("Time Series (Digital Currency %v)", titleCase(spacing))
+	return result{json: map[string]any{"Meta Data": metadata, seriesKey: series}, csv: rows}
+}
+
+// technicalIndicators are the indicator functions, all answered by technicalIndicator.
+var technicalIndicators = []string{"SMA", "EMA", "WMA", "DEMA", "TEMA", "TRIMA", "KAMA", "MAMA", "VWAP", "T3", "MACD",
+	"MACDEXT", "STOCH", "STOCHF", "RSI", "STOCHRSI", "WILLR", "ADX", "ADXR", "APO", "PPO", "MOM", "BOP", "CCI", "CMO",
+	"ROC", "ROCR", "AROON", "AROONOSC", "MFI", "TRIX", "ULTOSC", "DX", "MINUS_DI", "PLUS_DI", "MINUS_DM", "PLUS_DM",
+	"BBANDS", "MIDPOINT", "MIDPRICE", "SAR", "TRANGE", "ATR", "NATR", "AD", "ADOSC", "OBV", "HT_TRENDLINE", "HT_SINE",
+	"HT_TRENDMODE", "HT_DCPERIOD", "HT_DCPHASE", "HT_PHASOR"}
+
+// indicatorOutputs are the values of the indicators that have more than one; the others have a single value named
+// after the indicator.
+var indicatorOutputs = map[string][]string{
+	"MAMA":      {"MAMA", "FAMA"},
+	"MACD":      {"MACD", "MACD_Hist", "MACD_Signal"},
+	"MACDEXT":   {"MACD", "MACD_Hist", "MACD_Signal"},
+	"STOCH":     {"SlowK", "SlowD"},
+	"STOCHF":    {"FastK", "FastD"},
+	"STOCHRSI":  {"FastK", "FastD"},
+	"AROON":     {"Aroon Down", "Aroon Up"},
+	"BBANDS":    {"Real Upper Band", "Real Middle Band", "Real Lower Band"},
+	"HT_SINE":   {"LEAD SINE", "SINE"},
+	"HT_PHASOR": {"PHASE", "QUADRATURE"},
+}
+
+func technicalIndicator(r *request) result {
+	symbol := r.params.Get("symbol")
+	spacing := r.param("interval", "daily")
+	outputs := indicatorOutputs[r.function]
+	if outputs == nil {
+		outputs = []string{r.function}
+	}
+
+	bars := r.walk(r.basePrice(symbol), 200, r.timestamps(spacing, 200))
+	metadata := map[string]string{
+		"1: Symbol":         symbol,
+		"2: Indicator":      r.function,
+		"3: Last Refreshed": formatTimestamp(bars[0].time, spacing),
+		"4: Interval":       spacing,
+		"5: Time Period":    r.param("time_period", "14"),
+		"6: Series Type":    r.param("series_type", "close"),
+		"7: Time Zone":      "US/Eastern",
+	}
+
+	series := map[string]map[string]string{}
+	rows := [][]string{append([]string{"time"}, outputs...)}
+	for _, bar := range bars {
+		timestamp := formatTimestamp(bar.time, spacing)
+		point := map[string]string{}
+		row := []string{timestamp}
+		for i, output := range outputs {
+			// Values near the price suit the averages and bands; the oscillators only need to be plausible numbers.
+			value := formatPrice(bar.close * (1 + 0.01*float64(i)))
+			point[output] = value
+			row = append(row, value)
+		}
+		series[timestamp] = point
+		rows = append(rows, row)
+	}
+
+	return result{
+		json: map[string]any{"Meta Data": metadata, "Technical Analysis: " + r.function: series},
+		csv:  rows,
+	}
+}
+
+// economicIndicators are the economic and commodity functions, with the name and unit of their series.
+var economicIndicators = map[string][2]string{
+	"REAL_GDP":            {"Real Gross Domestic Product", "billions of dollars"},
+	"REAL_GDP_PER_CAPITA": {"Real Gross Domestic Product per Capita", "chained 2012 dollars"},
+	"TREASURY_YIELD":      {"10-Year Treasury Constant Maturity Rate", "percent"},
+	"FEDERAL_FUNDS_RATE":  {"Effective Federal Funds Rate", "percent"},
+	"CPI":                 {"Consumer Price Index for all Urban Consumers", "index 1982-1984=100"},
+	"INFLATION":           {"Inflation - US Consumer Prices", "percent"},
+	"RETAIL_SALES":        {"Advance Retail Sales: Retail Trade", "millions of dollars"},
+	"DURABLES":            {"Manufacturer New Orders: Durable Goods", "millions of dollars"},
+	"UNEMPLOYMENT":        {"Unemployment Rate", "percent"},
+	"NONFARM_PAYROLL":     {"Total Nonfarm Payroll", "thousands of persons"},
+	"WTI":                 {"Crude Oil Prices WTI", "dollars per barrel"},
+	"BRENT":               {"Crude Oil Prices Brent", "dollars per barrel"},
+	"NATURAL_GAS":         {"Henry Hub Natural Gas Spot Price", "dollars per million BTU"},
+	"COPPER":              {"Global Price of Copper", "dollar per metric ton"},
+	"ALUMINUM":            {"Global Price of Aluminum", "dollar per metric ton"},
+	"WHEAT":               {"Global Price of Wheat", "dollar per metric ton"},
+	"CORN":                {"Global Price of Corn", "dollar per metric ton"},
+	"COTTON":              {"Global Price of Cotton", "cents per pound"},
+	"SUGAR":               {"Global Price of Sugar", "cents per pound"},
+	"COFFEE":              {"Global Price of Coffee", "cents per pound"},
+	"ALL_COMMODITIES":     {"Global Price Index of All Commodities", "index 2016=100"},
+}
+
+// economicIndicator answers the economic and commodity functions.  Like the real service, the odd missing
+// observation is reported as ".".
+func economicIndicator(r *request) result {
+	description := economicIndicators[r.function]
+	defaultInterval := "monthly"
+	switch r.function {
+	case "REAL_GDP":
+		defaultInterval = "quarterly"
+	case "REAL_GDP_PER_CAPITA", "INFLATION":
+		defaultInterval = "annual"
+	}
+	interval := r.param("interval", defaultInterval)
+
+	spacing := interval
+	count := 120
+	if interval == "quarterly" || interval == "semiannual" || interval == "annual" {
+		spacing = "monthly"
+	}
+	timestamps := r.timestamps(spacing, count*12)
+	var dates []time.Time
+	for i, timestamp := range timestamps {
+		switch {
+		case interval == "quarterly" && i%3 != 0, interval == "semiannual" && i%6 != 0,
+			interval == "annual" && i%12 != 0:
+			continue
+		}
+		// The observations of monthly and longer series are dated on the first day of their period.
+		if spacing == "monthly" {
+			timestamp = time.Date(timestamp.Year(), timestamp.Month(), 1, 0, 0, 0, 0, time.UTC)
+		}
+		dates = append(dates, timestamp)
+		if len(dates) == count {
+			break
+		}
+	}
+
+	bars := r.walk(50+r.rand.Float64()*200, len(dates), dates)
+	data := make([]map[string]string, 0, len(bars))
+	rows := [][]string{{"timestamp", "value"}}
+	for _, bar := range bars {
+		value := formatPrice(bar.close)
+		if r.rand.Intn(40) == 0 {
+			value = "."
+		}
+		date := bar.time.Format("2006-01-02")
+		data = append(data, map[string]string{"date": date, "value": value})
+		rows = append(rows, []string{date, value})
+	}
+
+	return result{
+		json: map[string]any{"name": description[0], "interval": interval, "unit": description[1], "data": data},
+		csv:  rows,
+	}
+}
+
+func overview(r *request) result {
+	symbol := r.server.symbols[r.params.Get("symbol")]
+	price := r.basePrice(symbol.Symbol)
+	shares := int64(1e8 + r.rand.Float64()*5e9)
+	eps := price / (10 + r.rand.Float64()*30)
+
+	fields := map[string]string{
+		"Symbol":               symbol.Symbol,
+		"AssetType":            "Common Stock",
+		"Name":                 symbol.Name,
+		"Description":          symbol.Name + " is a company generated for testing.",
+		"CIK":                  strconv.Itoa(10000 + r.rand.Intn(90000)),
+		"Exchange":             symbol.Exchange,
+		"Currency":             "USD",
+		"Country":              "USA",
+		"Sector":               "TECHNOLOGY",
+		"Industry":             "COMPUTER & OFFICE EQUIPMENT",
+		"FiscalYearEnd":        "December",
+		"LatestQuarter":        r.timestamps("monthly", 3)[2].Format("2006-01-02"),
+		"MarketCapitalization": strconv.FormatInt(int64(price*float64(shares)), 10),
+		"EBITDA":               strconv.FormatInt(int64(eps*float64(shares)*1.6), 10),
+		"PERatio":              strconv.FormatFloat(price/eps, 'f', 2, 64),
+		"PEGRatio":             strconv.FormatFloat(0.5+r.rand.Float64()*2, 'f', 3, 64),
+		"BookValue":            strconv.FormatFloat(price/(1+r.rand.Float64()*10), 'f', 2, 64),
+		"DividendPerShare":     "None",
+		"DividendYield":        "0",
+		"EPS":                  strconv.FormatFloat(eps, 'f', 2, 64),
+		"SharesOutstanding":    strconv.FormatInt(shares, 10),
+		"52WeekHigh":           formatPrice(price * 1.2),
+		"52WeekLow":            formatPrice(price * 0.8),
+		"DividendDate":         "None",
+		"ExDividendDate":       "None",
+	}
+	// Some companies pay dividends.
+	if r.rand.Intn(2) == 0 {
+		dividend := price * 0.01 * (1 + r.rand.Float64()*4)
+		fields["DividendPerShare"] = strconv.FormatFloat(dividend, 'f', 2, 64)
+		fields["DividendYield"] = strconv.FormatFloat(dividend/price, 'f', 4, 64)
+		fields["DividendDate"] = r.now.AddDate(0, 1, 0).Format("2006-01-02")
+		fields["ExDividendDate"] = r.now.AddDate(0, 0, 14).Format("2006-01-02")
+	}
+
+	return result{json: fields}
+}
+
+// statementItems are the line items generated for each financial statement, as a share of revenue.
+var statementItems = map[string]map[string]float64{
+	"INCOME_STATEMENT": {"totalRevenue": 1, "grossProfit": 0.55, "operatingIncome": 0.2, "netIncome": 0.12,
+		"ebitda": 0.28, "researchAndDevelopment": 0.1, "interestExpense": 0.02},
+	"BALANCE_SHEET": {"totalAssets": 2.2, "totalLiabilities": 1.6, "totalShareholderEquity": 0.6,
+		"cashAndCashEquivalentsAtCarryingValue": 0.15, "inventory": 0.03, "goodwill": 0.9},
+	"CASH_FLOW": {"operatingCashflow": 0.18, "capitalExpenditures": 0.03, "dividendPayout": 0.08,
+		"cashflowFromInvestment": -0.05, "cashflowFromFinancing": -0.1},
+}
+
+func statement(r *request) result {
+	symbol := r.params.Get("symbol")
+	items := statementItems[r.function]
+	revenue := 1e9 + r.rand.Float64()*1e11
+
+	report := func(fiscalDateEnding time.Time, share float64) map[string]string {
+		fields := map[string]string{
+			"fiscalDateEnding": fiscalDateEnding.Format("2006-01-02"),
+			"reportedCurrency": "USD",
+		}
+		for item, ratio := range items {
+			fields[item] = strconv.FormatInt(int64(revenue*share*ratio*(0.9+0.2*r.rand.Float64())), 10)
+		}
+		// Items the company does not report come as "None".
+		for _, item := range sortedKeys(items) {
+			if r.rand.Intn(10) == 0 {
+				fields[item] = "None"
+			}
+		}
+		return fields
+	}
+
+	var annual, quarterly []map[string]string
+	for _, yearEnd := range r.fiscalPeriodEnds(12, 5) {
+		annual = append(annual, report(yearEnd, 1))
+	}
+	for _, quarterEnd := range r.fiscalPeriodEnds(3, 20) {
+		quarterly = append(quarterly, report(quarterEnd, 0.25))
+	}
+
+	return result{json: map[string]any{"symbol": symbol, "annualReports": annual, "quarterlyReports": quarterly}}
+}
+
+func earnings(r *request) result {
+	symbol := r.params.Get("symbol")
+	eps := r.basePrice(symbol) / 80
+
+	var annual, quarterly []map[string]string
+	for _, yearEnd := range r.fiscalPeriodEnds(12, 5) {
+		annual = append(annual, map[string]string{
+			"fiscalDateEnding": yearEnd.Format("2006-01-02"),
+			"reportedEPS":      strconv.FormatFloat(eps*4*(0.9+0.2*r.rand.Float64()), 'f', 2, 64),
+		})
+	}
+	for i, quarterEnd := range r.fiscalPeriodEnds(3, 20) {
+		reported := eps * (0.9 + 0.2*r.rand.Float64())
+		estimated := eps
+		quarter := map[string]string{
+			"fiscalDateEnding":   quarterEnd.Format("2006-01-02"),
+			"reportedDate":       quarterEnd.AddDate(0, 0, 25).Format("2006-01-02"),
+			"reportedEPS":        strconv.FormatFloat(reported, 'f', 2, 64),
+			"estimatedEPS":       strconv.FormatFloat(estimated, 'f', 2, 64),
+			"surprise":           strconv.FormatFloat(reported-estimated, 'f', 2, 64),
+			"surprisePercentage": strconv.FormatFloat(100*(reported-estimated)/estimated, 'f', 4, 64),
+		}
+		// Old quarters have no estimate.
+		if i >= 16 {
+			quarter["estimatedEPS"], quarter["surprise"], quarter["surprisePercentage"] = "None", "0", "None"
+		}
+		quarterly = append(quarterly, quarter)
+	}
+
+	return result{json: map[string]any{"symbol": symbol, "annualEarnings": annual, "quarterlyEarnings": quarterly}}
+}
+
+func listingStatus(r *request) result {
+	state := r.param("state", "active")
+
+	rows := [][]string{{"symbol", "name", "exchange", "assetType", "ipoDate", "delistingDate", "status"}}
+	if state == "delisted" {
+		rows = append(rows, []string{"ZZZT", "Delisted Example Corp", "NYSE", "Stock", "1999-11-18", "2015-03-02",
+			"Delisted"})
+		return result{csv: rows}
+	}
+	for _, symbol := range r.server.config.Symbols {
+		ipoYear := 1960 + r.rand.Intn(60)
+		rows = append(rows, []string{symbol.Symbol, symbol.Name, symbol.Exchange, "Stock",
+			fmt.Sprintf("%d-%02d-%02d", ipoYear, 1+r.rand.Intn(12), 1+r.rand.Intn(28)), "null", "Active"})
+	}
+	return result{csv: rows}
+}
+
+func earningsCalendar(r *request) result {
+	rows := [][]string{{"symbol", "name", "reportDate", "fiscalDateEnding", "estimate", "currency"}}
+	for _, symbol := range r.server.config.Symbols {
+		if wanted := r.params.Get("symbol"); wanted != "" && wanted != symbol.Symbol {
+			continue
+		}
+		estimate := strconv.FormatFloat(r.basePrice(symbol.Symbol)/80, 'f', 2, 64)
+		if r.rand.Intn(4) == 0 {
+			estimate = "" // No analyst coverage
+		}
+		reportDate := r.now.AddDate(0, 0, 1+r.rand.Intn(90))
+		quarterEnd := time.Date(reportDate.Year(), reportDate.Month()-((reportDate.Month()-1)%3), 0, 0, 0, 0, 0,
+			time.UTC)
+		rows = append(rows, []string{symbol.Symbol, symbol.Name, reportDate.Format("2006-01-02"),
+			quarterEnd.Format("2006-01-02"), estimate, "USD"})
+	}
+	return result{csv: rows}
+}
+
+func ipoCalendar(r *request) result {
+	rows := [][]string{{"symbol", "name", "ipoDate", "priceRangeLow", "priceRangeHigh", "currency", "exchange"}}
+	for i := 0; i < 5; i++ {
+		low := 5 + r.rand.Intn(20)
+		priceLow, priceHigh := strconv.Itoa(low), strconv.Itoa(low+2)
+		if i == 0 {
+			priceLow, priceHigh = "0", "0" // Not announced yet
+		}
+		rows = append(rows, []string{fmt.Sprintf("NEW%c", 'A'+i), fmt.Sprintf("New Listing %c Inc", 'A'+i),
+			r.now.AddDate(0, 0, 7*(i+1)).Format("2006-01-02"), priceLow, priceHigh, "USD", "NASDAQ"})
+	}
+	return result{csv: rows}
+}
+
+func newsSentiment(r *request) result {
+	tickers := strings.Split(r.param("tickers", "IBM"), ",")
+	limit, err := strconv.Atoi(r.param("limit", "50"))
+	if err != nil || limit <= 0 {
+		limit = 50
+	}
+	count := 1 + r.rand.Intn(int(math.Min(float64(limit), 20)))
+
+	label := func(score float64) string {
+		switch {
+		case score <= -0.35:
+			return "Bearish"
+		case score <= -0.15:
+			return "Somewhat-Bearish"
+		case score < 0.15:
+			return "Neutral"
+		case score < 0.35:
+			return "Somewhat-Bullish"
+		}
+		return "Bullish"
+	}
+
+	feed := make([]map[string]any, 0, count)
+	for i := 0; i < count; i++ {
+		score := r.rand.Float64()*1.2 - 0.6
+		var tickerSentiment []map[string]string
+		for _, ticker := range tickers {
+			tickerScore := score + 0.1*r.rand.NormFloat64()
+			tickerSentiment = append(tickerSentiment, map[string]string{
+				"ticker":                 ticker,
+				"relevance_score":        strconv.FormatFloat(r.rand.Float64(), 'f', 6, 64),
+				"ticker_sentiment_score": strconv.FormatFloat(tickerScore, 'f', 6, 64),
+				"ticker_sentiment_label": label(tickerScore),
+			})
+		}
+
+		feed = append(feed, map[string]any{
+			"title":                   fmt.Sprintf("Generated headline %d about %v", i+1, strings.Join(tickers, ", ")),
+			"url":                     fmt.Sprintf("https://news.example.com/%d", i+1),
+			"time_published":          r.now.Add(-time.Duration(i) * time.Hour).UTC().Format("20060102T150405"),
+			"authors":                 []string{"Test Author"},
+			"summary":                 "Synthetic article generated for testing.",
+			"source":                  "Example News",
+			"topics":                  []map[string]string{{"topic": "Technology", "relevance_score": "0.5"}},
+			"overall_sentiment_score": score,
+			"overall_sentiment_label": label(score),
+			"ticker_sentiment":        tickerSentiment,
+		})
+	}
+
+	return result{json: map[string]any{
+		"items": strconv.Itoa(count),
+		"sentiment_score_definition": "x <= -0.35: Bearish; -0.35 < x <= -0.15: Somewhat-Bearish; " +
+			"-0.15 < x < 0.15: Neutral; 0.15 <= x < 0.35: Somewhat_Bullish; x >= 0.35: Bullish",
+		"relevance_score_definition": "0 < x <= 1, with a higher score indicating higher relevance.",
+		"feed":                       feed,
+	}}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,200 @@
+package avtest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Fault is a failure a FaultTransport injects into a request.
+type Fault int
+
+const (
+	// NoFault passes the request through untouched.
+	NoFault Fault = iota
+	// Latency delays the request by FaultConfig.Latency before passing it through.
+	Latency
+	// ConnectionReset fails the request with a connection reset, without sending it.
+	ConnectionReset
+	// ServerError answers with a 503 status and an HTML body, without sending the request.
+	ServerError
+	// TruncatedBody passes the request through, and cuts the connection halfway through the response body.
+	TruncatedBody
+	// ThrottleNote answers with the note the service sends instead of data when called too often, without sending
+	// the request.
+	ThrottleNote
+	// MalformedJSON passes the request through, and cuts the response body in half so that it no longer parses.
+	MalformedJSON
+)
+
+func (f Fault) String() string {
+	switch f {
+	case NoFault:
+		return "NoFault"
+	case Latency:
+		return "Latency"
+	case ConnectionReset:
+		return "ConnectionReset"
+	case ServerError:
+		return "ServerError"
+	case TruncatedBody:
+		return "TruncatedBody"
+	case ThrottleNote:
+		return "ThrottleNote"
+	case MalformedJSON:
+		return "MalformedJSON"
+	}
+	return fmt.Sprintf("Fault(%d)", int(f))
+}
+
+// FaultConfig sets up a FaultTransport.  The zero value injects no faults.
+type FaultConfig struct {
+	// Script is the faults of the first requests, in order.  NoFault leaves a request to Probabilities.
+	Script []Fault
+
+	// Probabilities are the chances of each fault for the requests the script does not cover.  They should add up to
+	// 1 at most; at most one fault is injected per request.
+	Probabilities map[Fault]float64
+
+	// Seed makes the faults drawn from Probabilities reproducible.
+	Seed int64
+
+	// Latency is the delay of Latency faults, 2 seconds if 0.
+	Latency time.Duration
+}
+
+// FaultTransport is an http.RoundTripper that injects the failures the Alpha Vantage service really has into the
+// requests it passes on, to test how a client copes with them.  Give it to a client with net.WithTransport:
+//
+//	faults := avtest.NewFaultTransport(nil, avtest.FaultConfig{Script: []avtest.Fault{avtest.ThrottleNote}})
+//	av := alphavantage.New("demo", 5, 500, net.WithBaseURL(server.QueryURL()), net.WithTransport(faults))
+type FaultTransport struct {
+	transport http.RoundTripper
+	config    FaultConfig
+
+	mux      sync.Mutex
+	rand     *rand.Rand
+	injected []Fault
+}
+
+// NewFaultTransport returns a FaultTransport passing requests on to transport, or http.DefaultTransport if it is nil.
+func NewFaultTransport(transport http.RoundTripper, config FaultConfig) *FaultTransport {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if config.Latency == 0 {
+		config.Latency = 2 * time.Second
+	}
+
+	return &FaultTransport{
+		transport: transport,
+		config:    config,
+		rand:      rand.New(rand.NewSource(config.Seed)),
+	}
+}
+
+// Injected returns the fault injected into each request so far, in order, NoFault for those passed through.
+func (t *FaultTransport) Injected() []Fault {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	return append([]Fault(nil), t.injected...)
+}
+
+func (t *FaultTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	fault := t.next()
+
+	switch fault {
+	case Latency:
+		timer := time.NewTimer(t.config.Latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		}
+	case ConnectionReset:
+		return nil, fmt.Errorf("read %v: %w", request.URL.Host, syscall.ECONNRESET)
+	case ServerError:
+		return faultResponse(request, http.StatusServiceUnavailable, "text/html",
+			"<html><body><h1>503 Service Temporarily Unavailable</h1></body></html>"), nil
+	case ThrottleNote:
+		return faultResponse(request, http.StatusOK, "application/json",
+			messageBody("Note", throttleNote)), nil
+	}
+
+	response, err := t.transport.RoundTrip(request)
+	if err != nil || (fault != TruncatedBody && fault != MalformedJSON) {
+		return response, err
+	}
+
+	body, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	half := body[:len(body)/2]
+
+	if fault == TruncatedBody {
+		response.Body = io.NopCloser(io.MultiReader(bytes.NewReader(half), failingReader{io.ErrUnexpectedEOF}))
+		return response, nil
+	}
+	response.Body = io.NopCloser(bytes.NewReader(half))
+	response.ContentLength = int64(len(half))
+	response.Header.Del("Content-Length")
+	return response, nil
+}
+
+// next picks the fault of the next request and records it.
+func (t *FaultTransport) next() Fault {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+
+	fault := NoFault
+	if len(t.injected) < len(t.config.Script) {
+		fault = t.config.Script[len(t.injected)]
+	}
+	if fault == NoFault && len(t.config.Probabilities) > 0 {
+		draw := t.rand.Float64()
+		// Map iteration order is random, so the faults are walked in a fixed order for the seed to mean something.
+		for candidate := Latency; candidate <= MalformedJSON; candidate++ {
+			draw -= t.config.Probabilities[candidate]
+			if draw < 0 {
+				fault = candidate
+				break
+			}
+		}
+	}
+
+	t.injected = append(t.injected, fault)
+	return fault
+}
+
+func faultResponse(request *http.Request, status int, contentType, body string) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %v", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{contentType}},
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}
+}
+
+// failingReader fails every read with err, e.g. to end a body cut short.
+type failingReader struct {
+	err error
+}
+
+func (r failingReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
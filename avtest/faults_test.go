@@ -0,0 +1,104 @@
+package avtest
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jay9909/alphavantage"
+	"github.com/jay9909/alphavantage/net"
+)
+
+func TestFaultScript(t *testing.T) {
+	server := NewServer(Config{Seed: 1, Now: func() time.Time { return testNow }})
+	defer server.Close()
+
+	faults := NewFaultTransport(nil, FaultConfig{
+		Script:  []Fault{ConnectionReset, ServerError, TruncatedBody, ThrottleNote, MalformedJSON, Latency, NoFault},
+		Latency: 10 * time.Millisecond,
+	})
+	av := alphavantage.New("demo", 100, 0, net.WithBaseURL(server.QueryURL()), net.WithTransport(faults))
+
+	if _, err := getJson(av.GetOverview("IBM")); !errors.Is(err, syscall.ECONNRESET) {
+		t.Errorf("connection reset error = %v", err)
+	}
+
+	response := av.GetOverview("IBM")
+	if response.Error != nil || response.Response.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("server error response = %+v", response)
+	}
+
+	response = av.GetOverview("IBM")
+	if _, err := response.GetText(); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("truncated body error = %v", err)
+	}
+
+	if _, err := getJson(av.GetOverview("IBM")); err == nil ||
+		!strings.Contains(err.Error(), "exceeded free api rate limit") {
+		t.Errorf("throttle note error = %v, want the note recognized", err)
+	}
+
+	if _, err := getJson(av.GetOverview("IBM")); err == nil || !strings.Contains(err.Error(), "could not parse JSON") {
+		t.Errorf("malformed JSON error = %v", err)
+	}
+
+	start := time.Now()
+	if _, err := getJson(av.GetOverview("IBM")); err != nil || time.Since(start) < 10*time.Millisecond {
+		t.Errorf("delayed request error = %v after %v", err, time.Since(start))
+	}
+
+	if _, err := getJson(av.GetOverview("IBM")); err != nil {
+		t.Errorf("request past the script error = %v", err)
+	}
+
+	// Only the requests that were passed on reached the server.
+	if server.Calls() != 4 {
+		t.Errorf("Calls() = %d, want 4", server.Calls())
+	}
+}
+
+func TestFaultProbabilities(t *testing.T) {
+	service := roundTripFunc(func(request *http.Request) (*http.Response, error) {
+		return faultResponse(request, http.StatusOK, "application/json", `{"Symbol": "IBM"}`), nil
+	})
+	draw := func(seed int64) []Fault {
+		faults := NewFaultTransport(service, FaultConfig{
+			Seed:          seed,
+			Probabilities: map[Fault]float64{ServerError: 0.25, ThrottleNote: 0.25},
+		})
+		for i := 0; i < 200; i++ {
+			request, _ := http.NewRequest(http.MethodGet, "http://avtest/query?function=OVERVIEW", nil)
+			_, _ = faults.RoundTrip(request)
+		}
+		return faults.Injected()
+	}
+
+	injected := draw(1)
+	counts := map[Fault]int{}
+	for _, fault := range injected {
+		counts[fault]++
+	}
+	for _, fault := range []Fault{NoFault, ServerError, ThrottleNote} {
+		if counts[fault] < 30 {
+			t.Errorf("%v injected %d times out of 200, counts %v", fault, counts[fault], counts)
+		}
+	}
+	if len(counts) != 3 {
+		t.Errorf("faults injected %v, want only ServerError and ThrottleNote", counts)
+	}
+
+	if again := draw(1); !slices.Equal(injected, again) {
+		t.Error("the same seed injected different faults")
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
@@ -0,0 +1,307 @@
+// Package avtest provides a fake Alpha Vantage service for integration tests.  A Server answers /query requests with
+// synthetic but plausibly shaped data, and behaves like the real service when it is misused: it throttles, caps the
+// number of requests per day, refuses premium endpoints and reports unknown symbols.
+//
+//	server := avtest.NewServer(avtest.Config{Seed: 1, CallsPerMinute: 5})
+//	defer server.Close()
+//	av := alphavantage.New("demo", 5, 500, net.WithBaseURL(server.QueryURL()))
+package avtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Config sets up a Server.  The zero value is a server without limits that accepts any API key.
+type Config struct {
+	// Seed makes the generated data reproducible.  The data for a request only depends on the seed and the request,
+	// not on the requests made before it.
+	Seed int64
+
+	// APIKey is the only key accepted, if set.
+	APIKey string
+
+	// CallsPerMinute is the number of requests answered in any minute before the others get a throttle note.  0 means
+	// no limit.
+	CallsPerMinute int
+
+	// CallsPerDay is the number of requests answered in a day before the others are refused.  0 means no limit.
+	CallsPerDay int
+
+	// Premium gives access to the premium endpoints, which are refused otherwise.
+	Premium bool
+
+	// PremiumFunctions are the functions that need Premium.  Nil means DefaultPremiumFunctions.
+	PremiumFunctions []string
+
+	// Symbols are the equity symbols that exist; the others get an error message.  Nil means DefaultSymbols.
+	Symbols []Symbol
+
+	// Now is the server's clock, time.Now if nil.  It dates the generated data and drives the limits.
+	Now func() time.Time
+}
+
+// Symbol is an equity the Server knows about.
+type Symbol struct {
+	Symbol   string
+	Name     string
+	Exchange string
+	Price    float64 // Around which prices are generated
+}
+
+// DefaultPremiumFunctions are the endpoints the documentation marks as premium.
+var DefaultPremiumFunctions = []string{"CRYPTO_INTRADAY", "FX_INTRADAY", "STOCH", "RSI", "ADX", "CCI",
+	"TIME_SERIES_DAILY"}
+
+// DefaultSymbols are the symbols a Server knows about unless configured otherwise.
+var DefaultSymbols = []Symbol{
+	{"IBM", "International Business Machines Corp", "NYSE", 130},
+	{"AAPL", "Apple Inc", "NASDAQ", 175},
+	{"MSFT", "Microsoft Corporation", "NASDAQ", 320},
+	{"TSCO.LON", "Tesco PLC", "LSE", 260},
+	{"SHOP.TRT", "Shopify Inc", "TSX", 85},
+	{"RELIANCE.BSE", "Reliance Industries Ltd", "BSE", 2450},
+}
+
+// The messages the real service answers with instead of data.  They come with a 200 status.
+const (
+	throttleNote = "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute and 500 " +
+		"calls per day. Please visit https://www.alphavantage.co/premium/ if you would like to target a higher API " +
+		"call frequency."
+	dailyCapInformation = "Thank you for using Alpha Vantage! You have reached the daily request limit of your API " +
+		"key. Please visit https://www.alphavantage.co/premium/ if you would like to have a higher daily limit."
+	premiumInformation = "Thank you for using Alpha Vantage! This is a premium endpoint. You may subscribe to any of " +
+		"the premium plans at https://www.alphavantage.co/premium/ to instantly unlock all premium endpoints"
+	invalidApiKey = "the parameter apikey is invalid or missing. Please claim your free API key on " +
+		"(https://www.alphavantage.co/support/#api-key). It should take less than 20 seconds."
+	invalidCallFormat = "Invalid API call. Please retry or visit the documentation " +
+		"(https://www.alphavantage.co/documentation/) for %v."
+)
+
+// Server is a fake Alpha Vantage service.  Point a client at QueryURL.
+type Server struct {
+	*httptest.Server
+
+	config  Config
+	premium map[string]bool
+	symbols map[string]Symbol
+
+	mux       sync.Mutex
+	recent    []time.Time // Times of the answered requests of the last minute
+	day       string      // Date the daily count is for
+	dayCalls  int
+	callCount int
+}
+
+// NewServer starts a Server.  Close it once done.
+func NewServer(config Config) *Server {
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+	if config.PremiumFunctions == nil {
+		config.PremiumFunctions = DefaultPremiumFunctions
+	}
+	if config.Symbols == nil {
+		config.Symbols = DefaultSymbols
+	}
+
+	server := &Server{
+		config:  config,
+		premium: map[string]bool{},
+		symbols: map[string]Symbol{},
+	}
+	for _, function := range config.PremiumFunctions {
+		server.premium[function] = true
+	}
+	for _, symbol := range config.Symbols {
+		server.symbols[symbol.Symbol] = symbol
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/query", server.serveQuery)
+	server.Server = httptest.NewServer(mux)
+	return server
+}
+
+// QueryURL is the address of the server's query endpoint, for net.WithBaseURL.
+func (s *Server) QueryURL() string {
+	return s.URL + "/query"
+}
+
+// Calls returns the number of requests the server has received, including refused ones.
+func (s *Server) Calls() int {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return s.callCount
+}
+
+func (s *Server) serveQuery(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	function := query.Get("function")
+	now := s.config.Now()
+
+	if query.Get("apikey") == "" || (s.config.APIKey != "" && query.Get("apikey") != s.config.APIKey) {
+		s.countRefused()
+		writeMessage(w, "Error Message", invalidApiKey)
+		return
+	}
+	if message, limited := s.limit(now); limited {
+		writeMessage(w, message.key, message.text)
+		return
+	}
+
+	generate, found := generators[function]
+	if !found {
+		writeMessage(w, "Error Message", fmt.Sprintf(invalidCallFormat, function))
+		return
+	}
+	if s.premium[function] && !s.config.Premium {
+		writeMessage(w, "Information", premiumInformation)
+		return
+	}
+
+	request := request{
+		function: function,
+		params:   query,
+		now:      now,
+		rand:     rand.New(rand.NewSource(s.config.Seed ^ requestHash(query))),
+		server:   s,
+	}
+	if problem := request.check(); problem != "" {
+		writeMessage(w, "Error Message", problem)
+		return
+	}
+
+	result := generate(&request)
+	if result.csv != nil && (result.json == nil || query.Get("datatype") == "csv") {
+		writeCsv(w, result.csv)
+		return
+	}
+	writeJson(w, result.json)
+}
+
+// serviceMessage is a message answered instead of data.
+type serviceMessage struct {
+	key  string
+	text string
+}
+
+// limit counts a request against the limits, and returns the message to answer with if it is over them.
+func (s *Server) limit(now time.Time) (serviceMessage, bool) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.callCount++
+
+	day := now.Format("2006-01-02")
+	if day != s.day {
+		s.day = day
+		s.dayCalls = 0
+	}
+	if s.config.CallsPerDay > 0 && s.dayCalls >= s.config.CallsPerDay {
+		return serviceMessage{"Information", dailyCapInformation}, true
+	}
+
+	recent := s.recent[:0]
+	for _, call := range s.recent {
+		if now.Sub(call) < time.Minute {
+			recent = append(recent, call)
+		}
+	}
+	s.recent = recent
+	if s.config.CallsPerMinute > 0 && len(s.recent) >= s.config.CallsPerMinute {
+		return serviceMessage{"Note", throttleNote}, true
+	}
+
+	s.recent = append(s.recent, now)
+	s.dayCalls++
+	return serviceMessage{}, false
+}
+
+// countRefused records a request refused before the limits apply.
+func (s *Server) countRefused() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.callCount++
+}
+
+// requestHash hashes the parameters of a request other than the API key, in a fixed order.
+func requestHash(query url.Values) int64 {
+	hash := fnv.New64a()
+	for _, name := range sortedKeys(query) {
+		if name != "apikey" {
+			_, _ = fmt.Fprintf(hash, "%v=%v&", name, query.Get(name))
+		}
+	}
+	return int64(hash.Sum64())
+}
+
+func writeMessage(w http.ResponseWriter, key, text string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = fmt.Fprint(w, messageBody(key, text))
+}
+
+// messageBody is a message answered instead of data, formatted like the service does, which api.Response.GetJson
+// relies on to recognize the throttle note.
+func messageBody(key, text string) string {
+	return fmt.Sprintf("{\n    %q: %q\n}", key, text)
+}
+
+func writeJson(w http.ResponseWriter, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "    ")
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(value)
+}
+
+func writeCsv(w http.ResponseWriter, rows [][]string) {
+	w.Header().Set("Content-Type", "application/x-download")
+	w.Header().Set("Content-Disposition", "attachment; filename=data.csv")
+	for _, row := range rows {
+		_, _ = fmt.Fprint(w, strings.Join(row, ",")+"\r\n")
+	}
+}
+
+var (
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3,5}$`)
+	intervalPattern = regexp.MustCompile(`^(1|5|15|30|60)min$`)
+)
+
+// check returns the error message for a request whose parameters the real service would reject, or "".
+func (r *request) check() string {
+	invalid := fmt.Sprintf(invalidCallFormat, r.function)
+
+	if symbol := r.params.Get("symbol"); cryptoFunctions[r.function] {
+		if !currencyPattern.MatchString(symbol) {
+			return invalid
+		}
+	} else if symbol != "" || requiresSymbol[r.function] {
+		if _, known := r.server.symbols[symbol]; !known {
+			return invalid
+		}
+	}
+	for _, name := range []string{"from_symbol", "to_symbol", "from_currency", "to_currency", "market"} {
+		if value := r.params.Get(name); value != "" && !currencyPattern.MatchString(value) {
+			return invalid
+		}
+	}
+	if interval := r.params.Get("interval"); intradayFunctions[r.function] && !intervalPattern.MatchString(interval) {
+		return invalid
+	}
+	if datatype := r.params.Get("datatype"); datatype != "" && datatype != "json" && datatype != "csv" {
+		return invalid
+	}
+	return ""
+}
@@ -0,0 +1,194 @@
+package avtest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jay9909/alphavantage"
+	"github.com/jay9909/alphavantage/api"
+	"github.com/jay9909/alphavantage/net"
+)
+
+var testNow = time.Date(2023, 5, 18, 15, 0, 0, 0, time.UTC)
+
+// newClient returns a client of server with enough workers that no test waits on the rate limiter.
+func newClient(server *Server) *alphavantage.Alphavantage {
+	return alphavantage.New("demo", 100, 0, net.WithBaseURL(server.QueryURL()))
+}
+
+func TestTypedDecoders(t *testing.T) {
+	server := NewServer(Config{Seed: 1, Now: func() time.Time { return testNow }})
+	defer server.Close()
+	av := newClient(server)
+
+	quote, err := av.GlobalQuote("IBM")
+	if err != nil || quote.Symbol != "IBM" || quote.Price <= 0 || quote.LatestTradingDay.IsZero() {
+		t.Errorf("GlobalQuote = %+v, %v", quote, err)
+	}
+
+	matches, err := av.SymbolSearch("micro")
+	if err != nil || len(matches) != 1 || matches[0].Symbol != "MSFT" {
+		t.Errorf("SymbolSearch = %+v, %v", matches, err)
+	}
+
+	status, err := av.MarketStatus()
+	if err != nil || len(status.Markets) == 0 {
+		t.Errorf("MarketStatus = %+v, %v", status, err)
+	}
+
+	listings, err := av.ListingStatus("", "")
+	if err != nil || len(listings) != len(DefaultSymbols) {
+		t.Errorf("ListingStatus = %+v, %v", listings, err)
+	}
+
+	events, err := av.EarningsCalendar("", "")
+	if err != nil || len(events) != len(DefaultSymbols) {
+		t.Errorf("EarningsCalendar = %+v, %v", events, err)
+	}
+
+	ipos, err := av.IpoCalendar()
+	if err != nil || len(ipos) == 0 || ipos[0].PriceRangeLow == nil {
+		t.Errorf("IpoCalendar = %+v, %v", ipos, err)
+	}
+}
+
+func TestEndpointShapes(t *testing.T) {
+	server := NewServer(Config{Seed: 1, Premium: true, Now: func() time.Time { return testNow }})
+	defer server.Close()
+	av := newClient(server)
+
+	tests := []struct {
+		name string
+		get  func() (map[string]any, error)
+		keys []string
+	}{
+		{"daily adjusted", func() (map[string]any, error) {
+			return getJson(av.GetTimeSeriesDailyAdjusted("IBM", "", ""))
+		}, []string{"Meta Data", "Time Series (Daily)"}},
+		{"intraday", func() (map[string]any, error) {
+			return getJson(av.QueryTimeSeriesIntraday(alphavantage.TimeSeriesIntradayParams{Symbol: "AAPL",
+				Interval: "5min"}))
+		}, []string{"Meta Data", "Time Series (5min)"}},
+		{"fx", func() (map[string]any, error) {
+			return getJson(av.GetFxWeekly("EUR", "USD", ""))
+		}, []string{"Meta Data", "Time Series FX (Weekly)"}},
+		{"exchange rate", func() (map[string]any, error) {
+			return getJson(av.GetCurrencyExchangeRate("BTC", "EUR"))
+		}, []string{"Realtime Currency Exchange Rate"}},
+		{"crypto", func() (map[string]any, error) {
+			return getJson(av.GetDigitalCurrencyDaily("BTC", "CNY"))
+		}, []string{"Meta Data", "Time Series (Digital Currency Daily)"}},
+		{"indicator", func() (map[string]any, error) {
+			return getJson(av.GetSma("IBM", "weekly", "10", "open", ""))
+		}, []string{"Meta Data", "Technical Analysis: SMA"}},
+		{"fundamentals", func() (map[string]any, error) {
+			return getJson(av.GetIncomeStatement("IBM"))
+		}, []string{"symbol", "annualReports", "quarterlyReports"}},
+		{"economic", func() (map[string]any, error) {
+			return getJson(av.GetCpi("", ""))
+		}, []string{"name", "interval", "unit", "data"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body, err := test.get()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, key := range test.keys {
+				if _, found := body[key]; !found {
+					t.Errorf("response has no %q: %v", key, keysOf(body))
+				}
+			}
+		})
+	}
+
+	response := av.GetTimeSeriesDaily("MSFT", "", "csv")
+	csv, err := response.GetCsv()
+	if err != nil || !strings.HasPrefix(csv, "timestamp,open,high,low,close,volume\r\n") {
+		t.Errorf("CSV daily series = %.80q, %v", csv, err)
+	}
+}
+
+func TestServiceBehaviors(t *testing.T) {
+	now := testNow
+	server := NewServer(Config{Seed: 1, APIKey: "demo", CallsPerMinute: 2, CallsPerDay: 3,
+		Now: func() time.Time { return now }})
+	defer server.Close()
+	av := newClient(server)
+
+	if _, err := av.GlobalQuote("NOPE"); err == nil || !strings.Contains(err.Error(), "Invalid API call") {
+		t.Errorf("unknown symbol error = %v, want an error message", err)
+	}
+	if _, err := getJson(av.GetFxIntraday("EUR", "USD", "5min", "", "")); err == nil ||
+		!strings.Contains(err.Error(), "premium endpoint") {
+		t.Errorf("premium endpoint error = %v, want a premium notice", err)
+	}
+	if _, err := getJson(av.GetOverview("IBM")); err == nil || !strings.Contains(err.Error(), "rate limit") {
+		t.Errorf("third call in a minute error = %v, want the throttle note", err)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := getJson(av.GetOverview("IBM")); err != nil {
+		t.Errorf("call a minute later error = %v", err)
+	}
+	if _, err := getJson(av.GetOverview("IBM")); err == nil || !strings.Contains(err.Error(), "daily request limit") {
+		t.Errorf("fourth call of the day error = %v, want the daily cap", err)
+	}
+
+	wrongKey := alphavantage.New("other", 100, 0, net.WithBaseURL(server.QueryURL()))
+	if _, err := getJson(wrongKey.GetOverview("IBM")); err == nil || !strings.Contains(err.Error(), "apikey") {
+		t.Errorf("wrong API key error = %v", err)
+	}
+
+	if server.Calls() != 6 {
+		t.Errorf("Calls() = %d, want 6", server.Calls())
+	}
+}
+
+func TestSeededData(t *testing.T) {
+	bodies := map[int64][]string{}
+	for _, seed := range []int64{1, 1, 2} {
+		server := NewServer(Config{Seed: seed, Now: func() time.Time { return testNow }})
+		av := newClient(server)
+		response := av.GetTimeSeriesMonthly("IBM", "")
+		body, err := response.GetText()
+		server.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		bodies[seed] = append(bodies[seed], body)
+	}
+
+	if bodies[1][0] != bodies[1][1] {
+		t.Error("the same seed generated different data")
+	}
+	if bodies[1][0] == bodies[2][0] {
+		t.Error("different seeds generated the same data")
+	}
+}
+
+// getJson decodes a JSON response, turning the messages the service answers with instead of data into errors.
+func getJson(response api.Response) (map[string]any, error) {
+	var body map[string]any
+	err := response.GetJson(&body)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range []string{"Error Message", "Information", "Note"} {
+		if message, found := body[key]; found {
+			return nil, fmt.Errorf("%v: %v", key, message)
+		}
+	}
+	return body, nil
+}
+
+func keysOf(body map[string]any) []string {
+	var keys []string
+	for key := range body {
+		keys = append(keys, key)
+	}
+	return keys
+}
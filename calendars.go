@@ -0,0 +1,29 @@
+package alphavantage
+
+import "github.com/jay9909/alphavantage/api"
+
+// ListingStatus returns the typed rows of GetListingStatus.  Both arguments are optional and may be left empty:
+// date is a YYYY-MM-DD date after 2010-01-01, and state is "active" (the default) or "delisted".
+func (av *Alphavantage) ListingStatus(date, state string) ([]api.Listing, error) {
+	response := av.GetListingStatus(date, state)
+	return response.GetListings()
+}
+
+// ListingStatusReader is the streaming form of ListingStatus.  The caller must Close the returned reader.
+func (av *Alphavantage) ListingStatusReader(date, state string) (*api.ListingReader, error) {
+	response := av.GetListingStatus(date, state)
+	return response.GetListingReader()
+}
+
+// EarningsCalendar returns the typed rows of GetEarningsCalendar.  Both arguments are optional: symbol restricts
+// the calendar to one company, and horizon is "3month" (the default), "6month" or "12month".
+func (av *Alphavantage) EarningsCalendar(symbol, horizon string) ([]api.EarningsEvent, error) {
+	response := av.GetEarningsCalendar(symbol, horizon)
+	return response.GetEarningsEvents()
+}
+
+// IpoCalendar returns the typed rows of GetIpoCalendar.
+func (av *Alphavantage) IpoCalendar() ([]api.IPOEvent, error) {
+	response := av.GetIpoCalendar()
+	return response.GetIPOEvents()
+}
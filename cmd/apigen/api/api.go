@@ -95,6 +95,15 @@ type Parameter struct {
 	Required bool
 	Name     string
 	Desc     string
+
+	// The fields below are inferred from Desc by the parser.  They are empty when the description doesn't say.
+	Type     string   // "string", "integer", "number", "date", "datetime" or "enum"
+	Enum     []string // Accepted values, for enum parameters
+	List     bool     // The parameter takes a comma-separated list of values
+	Positive bool     // Only numbers greater than zero are accepted
+	Default  string   // Value the service uses when the parameter is left out
+	Examples []string // Sample values given in the description
+	Format   string   // Layout of date and datetime values, e.g. YYYY-MM-DD
 }
 
 func (p Parameter) String() string {
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/jay9909/alphavantage/cmd/apigen/parse"
+	"github.com/jay9909/alphavantage/cmd/apigen/spec"
+	"os"
+)
+
+// runDiff implements `apigen diff [-json] OLD NEW`, which reports the API changes between two spec files or saved
+// documentation pages.  Bad usage exits with status 2; any other failure is returned.
+func runDiff(args []string) error {
+	flags := flag.NewFlagSet("diff", flag.ExitOnError)
+	asJson := flags.Bool("json", false, "print the report as JSON instead of text")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: apigen diff [-json] OLD NEW")
+		fmt.Fprintln(flags.Output(), "OLD and NEW may each be a spec file or a saved documentation HTML page.")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	older, err := loadSnapshot(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	newer, err := loadSnapshot(flags.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	report := spec.Compare(older, newer)
+
+	if *asJson {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(report)
+		if err != nil {
+			return fmt.Errorf("could not write report: %w", err)
+		}
+	} else {
+		fmt.Print(report)
+	}
+	return nil
+}
+
+// loadSnapshot reads a spec file, or parses a documentation page into a spec.  Spec files are recognised by their
+// content rather than their name so that either can be piped through a temporary file.
+func loadSnapshot(path string) (spec.Spec, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return spec.Spec{}, fmt.Errorf("could not read %v: %w", path, err)
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(contents), []byte("{")) {
+		return spec.Read(path)
+	}
+
+	endpoints, accessRecord, diagnostics, err := parse.FindEndpoints(contents)
+	if len(diagnostics) > 0 {
+		fmt.Fprintf(os.Stderr, "%v: %v", path, diagnostics)
+	}
+	if err != nil {
+		return spec.Spec{}, fmt.Errorf("could not parse documentation page %v: %w", path, err)
+	}
+	return spec.FromEndpoints(endpoints, accessRecord), nil
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunDiffErrors(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.json")
+	if err := os.WriteFile(valid, []byte(`{"specVersion": 2, "categories": []}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	malformed := filepath.Join(dir, "malformed.json")
+	if err := os.WriteFile(malformed, []byte(`{"specVersion": `), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		old, new string
+		wantErr  string
+	}{
+		{"missing old", filepath.Join(dir, "missing.json"), valid, "could not read"},
+		{"missing new", valid, filepath.Join(dir, "missing.json"), "could not read"},
+		{"malformed", valid, malformed, "could not parse spec file"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := runDiff([]string{test.old, test.new})
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("runDiff() = %v, want an error containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package gen
 
 import (
-	"encoding/base64"
+	"bytes"
 	"fmt"
 	"github.com/jay9909/alphavantage/cmd/apigen/api"
 	"go/format"
@@ -16,67 +16,52 @@ import (
 const generatedFileName = "api_generated.go"
 const documentationPage = "https://www.alphavantage.co/documentation/"
 
-func GetPreviousChecksum() ([32]byte, error) {
-	// Find the last line in the generated file, which should look like this:
-	// Checksum: CIktsNg2arwunITY/h7J5dfhhT+AqdwzqmWQEAtZLeI=\n
-
-	var previousChecksum [32]byte
-
-	fileInfo, err := os.Stat(generatedFileName)
+func GenerateApi(endpoints api.Endpoints, accessRecord api.AccessRecord) error {
+	f, err := os.Create(generatedFileName)
 	if err != nil {
-		if os.IsNotExist(err) {
-			// File doesn't exist.  Return the zeroed out checksum, which won't match whatever it's
-			// being compared to and force re-generation of doc page.
-			return previousChecksum, nil
-		} else {
-			return previousChecksum, fmt.Errorf("could not access %v: %w", generatedFileName, err)
-		}
+		panic(err)
 	}
 
-	// The Checksum line that we are looking for should be 58 bytes including the trailing newline
-	const checksumLength = 60
-	fileSize := fileInfo.Size()
-	if fileSize < checksumLength {
-		// If the file is less than this, it can't possibly contain the needed checksum line, let alone the
-		// generated code.  Bail out.
-		return previousChecksum, nil
+	err = Generate(f, endpoints, accessRecord)
+	if err != nil {
+		panic(err)
 	}
 
-	file, err := os.Open(generatedFileName)
+	err = f.Close()
 	if err != nil {
-		return previousChecksum, fmt.Errorf("could not open the generated api file: %w", err)
+		fmt.Printf("Error closing generated file: %v", err)
 	}
 
-	fileEnd := make([]byte, checksumLength, checksumLength)
-	_, err = file.ReadAt(fileEnd, fileSize-checksumLength)
-	if err != nil && err != io.EOF {
-		return previousChecksum, fmt.Errorf("error reading the end of the generated api file: %w", err)
+	fake, err := os.Create(generatedFakeFileName)
+	if err != nil {
+		panic(err)
 	}
 
-	checksumTag := "// Checksum: "
-	checksumLine := strings.TrimSpace(string(fileEnd))
-
-	checksumPos := strings.Index(checksumLine, "// Checksum: ")
-	checksumStr := checksumLine[checksumPos+len(checksumTag):]
-
-	checksumBytes, err := base64.StdEncoding.DecodeString(checksumStr)
+	err = GenerateFake(fake, endpoints, accessRecord)
 	if err != nil {
-		return previousChecksum, fmt.Errorf("error decoding previous checksum: %w", err)
+		panic(err)
 	}
 
-	previousChecksum = [32]byte(checksumBytes)
-	return previousChecksum, nil
-}
+	err = fake.Close()
+	if err != nil {
+		fmt.Printf("Error closing generated fake: %v", err)
+	}
 
-func GenerateApi(endpoints api.Endpoints, accessRecord api.AccessRecord) error {
-	f, err := os.Create(generatedFileName)
+	err = writeMetadata(accessRecord)
 	if err != nil {
 		panic(err)
 	}
 
-	err = writeHeader(f, accessRecord)
+	return nil
+}
+
+// Generate writes the gofmt'ed source of the generated API file to w.
+func Generate(w io.Writer, endpoints api.Endpoints, accessRecord api.AccessRecord) error {
+	var f bytes.Buffer
+
+	err := writeHeader(&f, endpoints, accessRecord)
 	if err != nil {
-		panic(fmt.Errorf("could not write file header to file: %w", err))
+		return fmt.Errorf("could not write file header to file: %w", err)
 	}
 
 	categories := maps.Keys(endpoints)
@@ -84,100 +69,103 @@ func GenerateApi(endpoints api.Endpoints, accessRecord api.AccessRecord) error {
 		return cat1.LinkName < cat2.LinkName
 	})
 	for _, category := range categories {
-		err = writeCategory(f, category)
+		err = writeCategory(&f, category)
 		if err != nil {
-			panic(fmt.Errorf("could not write category header for %v: %w", category.ReadableName, err))
+			return fmt.Errorf("could not write category header for %v: %w", category.ReadableName, err)
 		}
 
 		endpointList := endpoints[category]
 		for _, endpoint := range endpointList {
-			err = writeEndpoint(f, endpoint)
+			err = writeEndpoint(&f, endpoint)
 			if err != nil {
-				panic(fmt.Errorf("could not write endpoint %v function to file: %w",
-					endpoint.Function, err))
+				return fmt.Errorf("could not write endpoint %v function to file: %w",
+					endpoint.Function, err)
 			}
 		}
 	}
 
-	err = writeChecksum(f, accessRecord)
+	err = writeRequiredParams(&f, categories, endpoints)
 	if err != nil {
-		panic(fmt.Errorf("could not write file header to file: %w", err))
+		return fmt.Errorf("could not write required parameter table to file: %w", err)
 	}
 
-	err = f.Close()
+	err = writeDocumentedRules(&f, categories, endpoints)
 	if err != nil {
-		fmt.Printf("Error closing generated file: %v", err)
+		return fmt.Errorf("could not write documented rules table to file: %w", err)
 	}
 
-	// Gofmt the file
-	generatedContents, err := os.ReadFile(generatedFileName)
+	err = writeInterfaces(&f, categories, endpoints)
 	if err != nil {
-		panic(fmt.Errorf("error reading the generated file prior to formatting: %w", err))
+		return fmt.Errorf("could not write API interfaces to file: %w", err)
 	}
 
-	formattedGeneratedContents, err := format.Source(generatedContents)
+	// Gofmt the file
+	formattedGeneratedContents, err := format.Source(f.Bytes())
 	if err != nil {
-		panic(fmt.Errorf("error formatting the generated file: %w", err))
+		return fmt.Errorf("error formatting the generated file: %w", err)
 	}
-	err = os.WriteFile(generatedFileName, formattedGeneratedContents, 666)
+
+	_, err = w.Write(formattedGeneratedContents)
 	if err != nil {
-		panic(fmt.Errorf("error writing the go-fmt'ed generated code to disk: %w", err))
+		return fmt.Errorf("error writing the go-fmt'ed generated code: %w", err)
 	}
 
 	return nil
 }
 
-func writeHeader(f *os.File, accessRecord api.AccessRecord) error {
-	headerParams := map[string]string{
-		"Date": accessRecord.Date.Format(time.DateTime),
+func writeHeader(f io.Writer, endpoints api.Endpoints, accessRecord api.AccessRecord) error {
+	// The time package is only needed if some params struct has a date field or a date rule.
+	usesTime := false
+	for _, endpointList := range endpoints {
+		for _, endpoint := range endpointList {
+			for _, param := range endpoint.Params {
+				if typeOf(param).goType == "time.Time" || strings.Contains(ruleOf(param), "time.") {
+					usesTime = true
+				}
+			}
+		}
+	}
+
+	headerParams := map[string]any{
+		"Date":     accessRecord.Date.Format(time.DateTime),
+		"UsesTime": usesTime,
 	}
 
 	return fileHeaderTemplate.Execute(f, headerParams)
 }
 
-func writeCategory(f *os.File, category api.Category) error {
+func writeCategory(f io.Writer, category api.Category) error {
+	links := newDocLinks()
 	categoryParams := map[string]string{
 		"LinkName":     documentationPage + category.LinkName,
 		"ReadableName": category.ReadableName,
-		"Desc":         wrapInComments(category.Desc),
+		"Desc":         commentBlock(withDefinitions(commentLines(category.Desc, links), links)),
 	}
 
 	return categoryTemplate.Execute(f, categoryParams)
 }
 
-func writeEndpoint(f *os.File, endpoint api.Endpoint) error {
-	// The function CURRENCY_EXCHANGE_RATE is in the documentation twice, once under Foreign Exchange and
-	// once under Digital & Crypto Currencies.  The "function" and other parameters are identical.  Keep
-	// the one under Foreign Exchange and skip the one under Digital & Crypto Currencies
-	if endpoint.LinkName == "#crypto-exchange" {
-		return nil
-	}
-
+func writeEndpoint(f io.Writer, endpoint api.Endpoint) error {
 	function := endpoint.Function
-	funcLower := strings.ToLower(function)
-	splitFunc := strings.Split(funcLower, "_")
-	for i, word := range splitFunc {
-		splitFunc[i] = strings.ToTitle(string(word[0])) + word[1:]
-	}
-	funcName := strings.Join(splitFunc, "")
-
-	var docCommentBuilder strings.Builder
+	funcName := camelCase(function)
 
+	// The doc comment starts with the endpoint's name, so that go doc's one-line summary is just that, followed by the
+	// description and a link to the endpoint's documentation.  The parameters are listed after that.
+	links := newDocLinks()
+	title := endpoint.ReadableName
 	if endpoint.Premium == true {
-		docCommentBuilder.WriteString("// [PREMIUM] ")
-	} else {
-		docCommentBuilder.WriteString("// ")
+		title = "[PREMIUM] " + title
 	}
-
-	// Add the name, endpoint description, and link to the doc comment.
-	docCommentBuilder.WriteString(fmt.Sprintf("%v\n// %v\n// %v\n//\n",
-		endpoint.ReadableName, wrapInComments(endpoint.Desc), documentationPage+endpoint.LinkName))
+	docLines := []string{title, ""}
+	docLines = append(docLines, commentLines(endpoint.Desc, links)...)
+	docLines = append(docLines, "", documentationPage+endpoint.LinkName)
 
 	// Now collect the parameters.  Add them to the doc comment and set up the function body params.
-	docCommentBuilder.WriteString("// Parameters:")
+	var paramDocs []string
 
-	var argList []string // Argument list for the function signature
-	var params []string  // Function body shuttling from arguments to parameter map
+	var params []string    // Builder body shuttling from arguments to parameter map
+	var fields []string    // Fields of the params struct
+	var typedArgs []string // Typed method body formatting struct fields as the builder's arguments
 
 	for _, param := range endpoint.Params {
 		if param.Name != "function" && param.Name != "apikey" {
@@ -186,39 +174,142 @@ func writeEndpoint(f *os.File, endpoint api.Endpoint) error {
 				paramName = "opt_" + paramName
 			}
 
-			docCommentBuilder.WriteString(fmt.Sprintf("\n// -\t%v: %v",
-				paramName, wrapInComments(param.Desc)))
+			paramDocs = append(paramDocs, listItem(paramName+": "+inlineText(param.Desc, links))...)
 
-			argList = append(argList, strings.ToLower(paramName))
 			params = append(params, fmt.Sprintf("\t\t\"%v\": %v,",
 				param.Name, strings.ToLower(paramName)))
+
+			fieldName := camelCase(param.Name)
+			fieldType := typeOf(param)
+			field := fmt.Sprintf("\t%v %v", fieldName, fieldType.goType)
+			if comment := fieldComment(param, fieldType); comment != "" {
+				field += " // " + comment
+			}
+			fields = append(fields, field)
+			typedArgs = append(typedArgs, fmt.Sprintf("\t\t%v,", fieldType.formatField("p."+fieldName, param.Required)))
 		}
 	}
 
-	arguments := strings.Join(argList, ", ") + " string"
+	if len(paramDocs) > 0 {
+		docLines = append(docLines, "", "Parameters:")
+		docLines = append(docLines, paramDocs...)
+	}
+
+	// Both methods build their query parameters with the same unexported function, so that the parameter names only
+	// appear once.  An endpoint without parameters keeps its calls and map on one line.
+	argNames := argumentNames(endpoint)
+	builder := strings.ToLower(funcName[:1]) + funcName[1:] + "Params"
+	queryParams, typedArgList := "", ""
+	if len(params) > 0 {
+		queryParams = "\n" + strings.Join(params, "\n") + "\n\t"
+		typedArgList = "\n" + strings.Join(typedArgs, "\n") + "\n\t"
+	}
 
 	endpointParams := map[string]string{
 		"FuncName":         funcName,
 		"EndpointFunction": function,
-		"DocComment":       docCommentBuilder.String(),
-		"ArgList":          arguments,
-		"QueryParams":      strings.Join(params, "\n"),
+		"DocComment":       commentBlock(withDefinitions(docLines, links)),
+		"ArgList":          argumentList(argNames),
+		"ArgNames":         strings.Join(argNames, ", "),
+		"Builder":          builder,
+		"QueryParams":      queryParams,
+		"Fields":           strings.Join(fields, "\n"),
+		"TypedArgs":        typedArgList,
 	}
 
 	return endpointTemplate.Execute(f, endpointParams)
 }
 
-func writeChecksum(f *os.File, accessRecord api.AccessRecord) error {
-	checksumBytes := accessRecord.Checksum
-	checksum := base64.StdEncoding.EncodeToString(checksumBytes[:])
+func writeRequiredParams(f io.Writer, categories []api.Category, endpoints api.Endpoints) error {
+	var entries []string
+	for _, category := range categories {
+		for _, endpoint := range endpoints[category] {
+			var required []string
+			for _, param := range endpoint.Params {
+				if param.Required && param.Name != "function" && param.Name != "apikey" {
+					required = append(required, fmt.Sprintf("%q", param.Name))
+				}
+			}
+			entries = append(entries, fmt.Sprintf("\t%q: {%v},", endpoint.Function, strings.Join(required, ", ")))
+		}
+	}
+
+	requiredParams := map[string]string{
+		"Entries": strings.Join(entries, "\n"),
+	}
+
+	return requiredParamsTemplate.Execute(f, requiredParams)
+}
+
+func writeDocumentedRules(f io.Writer, categories []api.Category, endpoints api.Endpoints) error {
+	var entries []string
+	for _, category := range categories {
+		for _, endpoint := range endpoints[category] {
+			var rules []string
+			for _, param := range endpoint.Params {
+				if param.Name == "function" || param.Name == "apikey" {
+					continue
+				}
+				if rule := ruleOf(param); rule != "" {
+					rules = append(rules, fmt.Sprintf("\t\t%q: %v,", param.Name, rule))
+				}
+			}
+			if len(rules) > 0 {
+				entries = append(entries, fmt.Sprintf("\t%q: {\n%v\n\t},", endpoint.Function, strings.Join(rules, "\n")))
+			}
+		}
+	}
+
+	documentedRules := map[string]string{
+		"Entries": strings.Join(entries, "\n"),
+	}
+
+	return documentedRulesTemplate.Execute(f, documentedRules)
+}
+
+// argumentNames returns the names of a generated Get method's arguments, one per documented parameter.  Optional
+// parameters are prefixed with opt_.
+func argumentNames(endpoint api.Endpoint) []string {
+	var names []string
+	for _, param := range endpoint.Params {
+		if param.Name == "function" || param.Name == "apikey" {
+			continue
+		}
+		name := strings.ToLower(param.Name)
+		if param.Required != true {
+			name = "opt_" + name
+		}
+		names = append(names, name)
+	}
+	return names
+}
 
-	footerParams := map[string]string{
-		"Checksum": checksum,
+// argumentList builds the parameter list of a generated Get method from the argument names.  Every argument is a
+// string, so the names share one trailing type; an endpoint without arguments gets an empty list.
+func argumentList(argNames []string) string {
+	if len(argNames) == 0 {
+		return ""
 	}
+	return strings.Join(argNames, ", ") + " string"
+}
 
-	return checksumTemplate.Execute(f, footerParams)
+// camelCase converts an Alpha Vantage identifier such as TIME_SERIES_DAILY or series_type into an exported Go
+// name such as TimeSeriesDaily or SeriesType.
+func camelCase(identifier string) string {
+	words := strings.Split(strings.ToLower(identifier), "_")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToTitle(string(word[0])) + word[1:]
+		}
+	}
+	return strings.Join(words, "")
 }
 
-func wrapInComments(description string) string {
-	return strings.Join(strings.Split(description, "\n"), "\n// ")
+// withDefinitions appends the link definitions of a doc comment to its lines, separated by a blank line.
+func withDefinitions(lines []string, links *docLinks) []string {
+	definitions := links.definitions()
+	if len(definitions) == 0 {
+		return lines
+	}
+	return append(append(lines, ""), definitions...)
 }
@@ -0,0 +1,92 @@
+package gen
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
+	"github.com/jay9909/alphavantage/cmd/apigen/parse"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files from the current generator output")
+
+func TestArgumentList(t *testing.T) {
+	tests := []struct {
+		name     string
+		argNames []string
+		want     string
+	}{
+		{"empty", nil, ""},
+		{"required only", []string{"symbol", "interval"}, "symbol, interval string"},
+		{"optional only", []string{"opt_date", "opt_state"}, "opt_date, opt_state string"},
+		{"mixed", []string{"symbol", "opt_datatype"}, "symbol, opt_datatype string"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := argumentList(test.argNames)
+			if got != test.want {
+				t.Errorf("argumentList(%q) = %q, want %q", test.argNames, got, test.want)
+			}
+		})
+	}
+}
+
+func TestGenerateGolden(t *testing.T) {
+	page, err := os.ReadFile(filepath.Join("testdata", "documentation.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endpoints, diagnostics, err := parse.ParseDocument(page)
+	if err != nil {
+		t.Fatalf("could not parse documentation fixture: %v", err)
+	}
+	if len(diagnostics) > 0 {
+		t.Errorf("unexpected diagnostics for documentation fixture:\n%v", diagnostics)
+	}
+
+	accessRecord := api.AccessRecord{
+		Date: time.Date(2023, 5, 20, 13, 9, 36, 0, time.UTC),
+	}
+
+	tests := []struct {
+		golden   string
+		generate func(io.Writer, api.Endpoints, api.AccessRecord) error
+	}{
+		{"api_generated.golden", Generate},
+		{"fake_generated.golden", GenerateFake},
+	}
+
+	for _, test := range tests {
+		t.Run(test.golden, func(t *testing.T) {
+			var generated bytes.Buffer
+			err := test.generate(&generated, endpoints, accessRecord)
+			if err != nil {
+				t.Fatalf("could not generate: %v", err)
+			}
+
+			goldenFile := filepath.Join("testdata", test.golden)
+			if *update {
+				err = os.WriteFile(goldenFile, generated.Bytes(), 0666)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			golden, err := os.ReadFile(goldenFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(generated.Bytes(), golden) {
+				t.Errorf("generated code does not match %v; if the change is intended, rerun with -update and "+
+					"review the golden file diff\n=====\n%s\n=====", goldenFile, generated.Bytes())
+			}
+		})
+	}
+}
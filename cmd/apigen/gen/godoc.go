@@ -0,0 +1,258 @@
+package gen
+
+import (
+	"fmt"
+	"golang.org/x/net/html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// docWidth is the number of characters of text, not counting the leading "// ", on a line of a generated comment.
+const docWidth = 100
+
+// docBlock is a paragraph or a bulleted list of a description converted from HTML.
+type docBlock struct {
+	list  bool
+	items []string // The paragraph's text, or one entry per list item
+}
+
+// docLinks collects the link definitions of one doc comment.  Go doc comments write a link as [text] and define it
+// with a "[text]: URL" line at the end of the comment.
+type docLinks struct {
+	texts []string
+	urls  map[string]string
+}
+
+func newDocLinks() *docLinks {
+	return &docLinks{urls: map[string]string{}}
+}
+
+// cloudflareEmail is what Cloudflare's email obfuscation leaves behind of an address when the page is scraped
+// without running its script.  Every address on the documentation page belongs to Alpha Vantage support.
+const cloudflareEmail = "[email protected]"
+
+// cloudflareEmailPattern matches cloudflareEmail in text whose whitespace has not been collapsed.  The page separates
+// the words with &nbsp;, which the tokenizer decodes to U+00A0.
+var cloudflareEmailPattern = regexp.MustCompile(`\[email[\s\x{a0}]+protected\]`)
+
+// reference returns the comment text of an <a> element and records its link definition.
+func (links *docLinks) reference(text, href string) string {
+	if strings.Contains(href, "/cdn-cgi/l/email-protection") || text == cloudflareEmail {
+		text, href = "Alpha Vantage support", "https://www.alphavantage.co/support/"
+	}
+
+	base, _ := url.Parse(documentationPage)
+	target, err := base.Parse(href)
+	if text == "" || err != nil || (target.Scheme != "http" && target.Scheme != "https") {
+		return text
+	}
+	link := target.String()
+
+	// A comment can only define each link text once.  Spell out any other URL the same text points to.
+	if existing, ok := links.urls[text]; ok && existing != link {
+		return fmt.Sprintf("%v (%v)", text, link)
+	}
+	if _, ok := links.urls[text]; !ok {
+		links.texts = append(links.texts, text)
+		links.urls[text] = link
+	}
+	return "[" + text + "]"
+}
+
+// definitions returns the "[text]: URL" lines of the collected links, in the order they first appeared.
+func (links *docLinks) definitions() []string {
+	var lines []string
+	for _, text := range links.texts {
+		lines = append(lines, fmt.Sprintf("[%v]: %v", text, links.urls[text]))
+	}
+	return lines
+}
+
+// parseDescription converts an HTML description from the documentation page into doc comment blocks.  Line breaks
+// separate paragraphs and <li> elements become list items.  Go doc comments have no syntax for inline code, so
+// <code> spans, like <u> and other inline markup, are reduced to their text.  Images are dropped.
+func parseDescription(description string, links *docLinks) []docBlock {
+	var blocks []docBlock
+	var text, anchorText strings.Builder
+	var href string
+	inAnchor, inItem := false, false
+
+	endParagraph := func() {
+		if paragraph := collapseSpace(text.String()); paragraph != "" {
+			blocks = append(blocks, docBlock{items: []string{paragraph}})
+		}
+		text.Reset()
+	}
+
+	tokenizer := html.NewTokenizer(strings.NewReader(description))
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break // io.EOF; the tokenizer doesn't fail on malformed markup.
+		}
+		token := tokenizer.Token()
+
+		switch {
+		case tokenType == html.TextToken && inAnchor:
+			anchorText.WriteString(token.Data)
+
+		case tokenType == html.TextToken:
+			content := cloudflareEmailPattern.ReplaceAllString(token.Data, "Alpha Vantage support")
+			lines := strings.Split(content, "\n")
+			for i, line := range lines {
+				if i > 0 && inItem {
+					text.WriteString(" ")
+				} else if i > 0 {
+					endParagraph()
+				}
+				text.WriteString(line)
+			}
+
+		case token.Data == "a" && tokenType == html.StartTagToken:
+			inAnchor = true
+			href = ""
+			for _, attribute := range token.Attr {
+				if attribute.Key == "href" {
+					href = attribute.Val
+				}
+			}
+
+		case token.Data == "a" && tokenType == html.EndTagToken && inAnchor:
+			// Keep the whitespace around the anchor text, which is sometimes inside the element.
+			raw := anchorText.String()
+			if strings.TrimLeft(raw, " \t\n") != raw {
+				text.WriteString(" ")
+			}
+			text.WriteString(links.reference(collapseSpace(raw), href))
+			if strings.TrimRight(raw, " \t\n") != raw {
+				text.WriteString(" ")
+			}
+			anchorText.Reset()
+			inAnchor = false
+
+		case token.Data == "li" && tokenType == html.StartTagToken:
+			endParagraph()
+			inItem = true
+
+		case token.Data == "li" && tokenType == html.EndTagToken:
+			item := collapseSpace(text.String())
+			text.Reset()
+			inItem = false
+			if item == "" {
+				continue
+			}
+			if len(blocks) > 0 && blocks[len(blocks)-1].list {
+				blocks[len(blocks)-1].items = append(blocks[len(blocks)-1].items, item)
+			} else {
+				blocks = append(blocks, docBlock{list: true, items: []string{item}})
+			}
+		}
+	}
+	endParagraph()
+
+	return blocks
+}
+
+// commentLines converts an HTML description into the lines of a doc comment, without the leading "// ".
+// Paragraphs and lists are separated by blank lines.
+func commentLines(description string, links *docLinks) []string {
+	var lines []string
+	for i, block := range parseDescription(description, links) {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		if !block.list {
+			lines = append(lines, wrapText(block.items[0], docWidth)...)
+			continue
+		}
+		for _, item := range block.items {
+			lines = append(lines, listItem(item)...)
+		}
+	}
+	return lines
+}
+
+// inlineText converts an HTML description into a single run of text, for places such as list items that can't
+// hold paragraphs or lists of their own.  Nested list items are joined with semicolons.
+func inlineText(description string, links *docLinks) string {
+	var parts []string
+	for _, block := range parseDescription(description, links) {
+		if block.list {
+			parts = append(parts, strings.Join(block.items, "; "))
+		} else {
+			parts = append(parts, block.items[0])
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// listItem wraps the text of a bulleted list item, indented the way gofmt formats doc comment lists.
+func listItem(text string) []string {
+	lines := wrapText(text, docWidth-4)
+	for i := range lines {
+		if i == 0 {
+			lines[i] = "  - " + lines[i]
+		} else {
+			lines[i] = "    " + lines[i]
+		}
+	}
+	return lines
+}
+
+// wrapText breaks text into lines of at most width characters, except for single words that are longer.  The text
+// of a [link] is kept on one line.
+func wrapText(text string, width int) []string {
+	var words []string
+	for _, word := range strings.Fields(text) {
+		if len(words) > 0 && openBracket(words[len(words)-1]) {
+			words[len(words)-1] += " " + word
+		} else {
+			words = append(words, word)
+		}
+	}
+
+	var lines []string
+	var line string
+	for _, word := range words {
+		switch {
+		case line == "":
+			line = word
+		case len(line)+1+len(word) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// openBracket reports whether a word starts a [link] that is not closed within it.
+func openBracket(word string) bool {
+	start := strings.LastIndex(word, "[")
+	return start >= 0 && !strings.Contains(word[start:], "]")
+}
+
+// commentBlock prefixes each line with "// ", leaving blank lines as a bare "//".
+func commentBlock(lines []string) string {
+	var builder strings.Builder
+	for i, line := range lines {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		if line == "" {
+			builder.WriteString("//")
+		} else {
+			builder.WriteString("// " + line)
+		}
+	}
+	return builder.String()
+}
+
+func collapseSpace(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,79 @@
+package gen
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCommentLines(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		want        string
+	}{
+		{
+			"code spans and entities",
+			`By default, <code>outputsize=compact</code>. The &#34;slice&#34; &amp; <u>not</u> more.`,
+			`By default, outputsize=compact. The "slice" & not more.`,
+		},
+		{
+			"paragraphs",
+			"First paragraph.\nSecond paragraph.",
+			"First paragraph.\n\nSecond paragraph.",
+		},
+		{
+			"links",
+			`See the <a href="#dailyadj">Daily Adjusted API</a> and the<a href="https://example.com/list"> market list</a>.`,
+			"See the [Daily Adjusted API] and the [market list].\n\n" +
+				"[Daily Adjusted API]: https://www.alphavantage.co/documentation/#dailyadj\n" +
+				"[market list]: https://example.com/list",
+		},
+		{
+			"cloudflare email",
+			`Contact <a href="/cdn-cgi/l/email-protection" class="__cf_email__">[email protected]</a> today.`,
+			"Contact [Alpha Vantage support] today.\n\n" +
+				"[Alpha Vantage support]: https://www.alphavantage.co/support/",
+		},
+		{
+			"cloudflare email in text",
+			"Email [email&#160;protected] or [email\n protected] for help.",
+			"Email Alpha Vantage support or Alpha Vantage support for help.",
+		},
+		{
+			"cloudflare email with a no-break space",
+			`Contact <a href="mailto:x">[email&nbsp;protected]</a> today.`,
+			"Contact [Alpha Vantage support] today.\n\n" +
+				"[Alpha Vantage support]: https://www.alphavantage.co/support/",
+		},
+		{
+			"list",
+			"Topics:\n\n\t<li>IPO: <code>ipo</code></li>\n\t<li>Finance: <code>finance</code></li>",
+			"Topics:\n\n  - IPO: ipo\n  - Finance: finance",
+		},
+		{
+			"image",
+			`Like the one below?<br><img src="/static/img/autocomplete.jpg"/>`,
+			"Like the one below?",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			links := newDocLinks()
+			got := strings.Join(withDefinitions(commentLines(test.description, links), links), "\n")
+			if got != test.want {
+				t.Errorf("commentLines(%q) =\n%v\nwant\n%v", test.description, got, test.want)
+			}
+		})
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	text := "Read the [Investopedia article] for details."
+	want := []string{"Read the", "[Investopedia article]", "for details."}
+
+	got := wrapText(text, 12)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("wrapText(%q, 12) = %q, want %q", text, got, want)
+	}
+}
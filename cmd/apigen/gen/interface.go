@@ -0,0 +1,126 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
+	"go/format"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"io"
+	"strings"
+	"time"
+)
+
+const generatedFakeFileName = "fake/fake_generated.go"
+
+// method is a generated client method, as it appears in the interfaces and the fake.
+type method struct {
+	Name       string
+	ArgNames   []string // Names of the arguments, in order
+	ParamsType string   // Type of the single params struct argument of a Query method; "" for Get methods
+}
+
+// arguments returns the parameter list of the method's signature.  The qualifier, e.g. "alphavantage.", is put in
+// front of the params struct type for code outside the alphavantage package.
+func (m method) arguments(qualifier string) string {
+	if m.ParamsType != "" {
+		return "p " + qualifier + m.ParamsType
+	}
+	return argumentList(m.ArgNames)
+}
+
+// endpointMethods returns the Get and Query methods writeEndpoint generates for an endpoint.
+func endpointMethods(endpoint api.Endpoint) []method {
+	funcName := camelCase(endpoint.Function)
+
+	return []method{
+		{Name: "Get" + funcName, ArgNames: argumentNames(endpoint)},
+		{Name: "Query" + funcName, ArgNames: []string{"p"}, ParamsType: funcName + "Params"},
+	}
+}
+
+// categoryInterface is the name of the interface holding a category's methods, e.g. TechnicalIndicatorsAPI for
+// #technical-indicators.
+func categoryInterface(category api.Category) string {
+	name := strings.ReplaceAll(strings.TrimPrefix(category.LinkName, "#"), "-", "_")
+	return camelCase(name) + "API"
+}
+
+func writeInterfaces(f io.Writer, categories []api.Category, endpoints api.Endpoints) error {
+	var embedded, interfaces []string
+	for _, category := range categories {
+		var methods []string
+		for _, endpoint := range endpoints[category] {
+			for _, method := range endpointMethods(endpoint) {
+				methods = append(methods, fmt.Sprintf("\t%v(%v) api.Response", method.Name, method.arguments("")))
+			}
+		}
+
+		name := categoryInterface(category)
+		embedded = append(embedded, "\t"+name)
+		interfaces = append(interfaces, fmt.Sprintf(
+			"// %v holds the methods of the %v endpoints.\ntype %v interface {\n%v\n}",
+			name, category.ReadableName, name, strings.Join(methods, "\n")))
+	}
+
+	interfaceParams := map[string]string{
+		"Embedded":   strings.Join(embedded, "\n"),
+		"Interfaces": strings.Join(interfaces, "\n\n"),
+	}
+
+	return interfacesTemplate.Execute(f, interfaceParams)
+}
+
+// GenerateFake writes the gofmt'ed source of the generated fake client to w.  The fake implements every method of
+// the API interface generated into api_generated.go.
+func GenerateFake(w io.Writer, endpoints api.Endpoints, accessRecord api.AccessRecord) error {
+	categories := maps.Keys(endpoints)
+	slices.SortFunc(categories, func(cat1, cat2 api.Category) bool {
+		return cat1.LinkName < cat2.LinkName
+	})
+
+	var fields, methods []string
+	for _, category := range categories {
+		for _, endpoint := range endpoints[category] {
+			for _, method := range endpointMethods(endpoint) {
+				arguments := method.arguments("alphavantage.")
+
+				fields = append(fields, fmt.Sprintf("\t%vFunc func(%v) api.Response", method.Name, arguments))
+
+				var buffer bytes.Buffer
+				err := fakeMethodTemplate.Execute(&buffer, map[string]string{
+					"Name":      method.Name,
+					"Arguments": arguments,
+					"ArgNames":  strings.Join(method.ArgNames, ", "),
+				})
+				if err != nil {
+					return fmt.Errorf("could not write fake method %v: %w", method.Name, err)
+				}
+				methods = append(methods, buffer.String())
+			}
+		}
+	}
+
+	var f bytes.Buffer
+	err := fakeTemplate.Execute(&f, map[string]string{
+		"Date":    accessRecord.Date.Format(time.DateTime),
+		"Fields":  strings.Join(fields, "\n"),
+		"Methods": strings.Join(methods, ""),
+	})
+	if err != nil {
+		return fmt.Errorf("could not write fake client: %w", err)
+	}
+
+	formatted, err := format.Source(f.Bytes())
+	if err != nil {
+		return fmt.Errorf("error formatting the generated fake: %w", err)
+	}
+
+	_, err = w.Write(formatted)
+	if err != nil {
+		return fmt.Errorf("error writing the go-fmt'ed generated fake: %w", err)
+	}
+
+	return nil
+}
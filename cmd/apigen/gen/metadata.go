@@ -0,0 +1,62 @@
+package gen
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
+	"os"
+	"time"
+)
+
+const metadataFileName = "api_generated.json"
+
+// Metadata is the sidecar file recording what api_generated.go was last generated from.
+type Metadata struct {
+	Source   string    `json:"source"`
+	Scraped  time.Time `json:"scraped"`
+	Checksum string    `json:"checksum"` // Base64 checksum of the spec, see spec.Spec.Checksum
+}
+
+// ReadMetadata loads the sidecar file of the previous generation.  A missing file gives zero Metadata, whose
+// checksum matches nothing.
+func ReadMetadata() (Metadata, error) {
+	var metadata Metadata
+
+	contents, err := os.ReadFile(metadataFileName)
+	if os.IsNotExist(err) {
+		return metadata, nil
+	} else if err != nil {
+		return metadata, fmt.Errorf("could not read %v: %w", metadataFileName, err)
+	}
+
+	err = json.Unmarshal(contents, &metadata)
+	if err != nil {
+		return metadata, fmt.Errorf("could not parse %v: %w", metadataFileName, err)
+	}
+	return metadata, nil
+}
+
+// Matches reports whether the previous generation used a spec with the access record's checksum.
+func (m Metadata) Matches(accessRecord api.AccessRecord) bool {
+	return m.Checksum == base64.StdEncoding.EncodeToString(accessRecord.Checksum[:])
+}
+
+func writeMetadata(accessRecord api.AccessRecord) error {
+	metadata := Metadata{
+		Source:   documentationPage,
+		Scraped:  accessRecord.Date.UTC().Truncate(time.Second),
+		Checksum: base64.StdEncoding.EncodeToString(accessRecord.Checksum[:]),
+	}
+
+	contents, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode %v: %w", metadataFileName, err)
+	}
+
+	err = os.WriteFile(metadataFileName, append(contents, '\n'), 0644)
+	if err != nil {
+		return fmt.Errorf("could not write %v: %w", metadataFileName, err)
+	}
+	return nil
+}
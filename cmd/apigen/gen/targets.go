@@ -0,0 +1,229 @@
+package gen
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
+	"go/format"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// categoryPlaceholder in a template's file name makes it a per-category target: it is executed once for every
+// category, with the placeholder replaced by the category's link name, e.g. facade_[category].go.tmpl renders
+// facade_fundamentals.go, facade_fx.go and so on.
+const categoryPlaceholder = "[category]"
+
+// Model is the data every user-supplied template is executed with.
+type Model struct {
+	Date       time.Time
+	Checksum   string // Base64 checksum of the spec the model was built from
+	Categories []CategoryModel
+
+	// Category is the category being rendered by a per-category target, and nil for other targets.
+	Category *CategoryModel
+}
+
+// CategoryModel is a documentation category with its endpoints, in documentation order.
+type CategoryModel struct {
+	api.Category
+	Endpoints []api.Endpoint
+}
+
+// NewModel sorts the parsed endpoints into the form given to templates.  Categories are ordered the same way as in
+// api_generated.go.
+func NewModel(endpoints api.Endpoints, accessRecord api.AccessRecord) Model {
+	categories := maps.Keys(endpoints)
+	slices.SortFunc(categories, func(cat1, cat2 api.Category) bool {
+		return cat1.LinkName < cat2.LinkName
+	})
+
+	model := Model{
+		Date:     accessRecord.Date,
+		Checksum: base64.StdEncoding.EncodeToString(accessRecord.Checksum[:]),
+	}
+	for _, category := range categories {
+		model.Categories = append(model.Categories, CategoryModel{Category: category, Endpoints: endpoints[category]})
+	}
+	return model
+}
+
+// templateFuncs are the helpers available to user-supplied templates, on top of the text/template builtins.  They
+// are the same ones used to generate api_generated.go, so that extra artifacts can match its names and types.
+var templateFuncs = template.FuncMap{
+	// CamelCase turns an identifier such as TIME_SERIES_DAILY or series_type into TimeSeriesDaily or SeriesType.
+	"CamelCase": camelCase,
+
+	// Params returns an endpoint's parameters without function and apikey, which the client fills in itself.
+	"Params": func(endpoint api.Endpoint) []api.Parameter {
+		var params []api.Parameter
+		for _, param := range endpoint.Params {
+			if param.Name != "function" && param.Name != "apikey" {
+				params = append(params, param)
+			}
+		}
+		return params
+	},
+
+	// GoType is the type of a parameter's field in the generated params structs, e.g. Interval or time.Time.
+	"GoType": func(param api.Parameter) string {
+		return typeOf(param).goType
+	},
+
+	// FormatField is the expression converting the given field expression into the parameter's query value.
+	"FormatField": func(param api.Parameter, field string) string {
+		return typeOf(param).formatField(field, param.Required)
+	},
+
+	// FieldComment summarizes a parameter's documented values, e.g. "Optional; one of compact, full".
+	"FieldComment": func(param api.Parameter) string {
+		return fieldComment(param, typeOf(param))
+	},
+
+	// Rule is the validation rule expression generated into documentedRules, or "" for none.
+	"Rule": ruleOf,
+
+	// IsPremium reports whether an endpoint needs a premium API key.
+	"IsPremium": func(endpoint api.Endpoint) bool {
+		return endpoint.Premium
+	},
+
+	// DocComment converts an HTML description into a "// " prefixed Go doc comment, links included.
+	"DocComment": func(description string) string {
+		links := newDocLinks()
+		return commentBlock(withDefinitions(commentLines(description, links), links))
+	},
+
+	// DocText converts an HTML description into a single line of plain text.
+	"DocText": func(description string) string {
+		return inlineText(description, newDocLinks())
+	},
+
+	// DocumentationUrl is the link to an endpoint or category on the documentation page.
+	"DocumentationUrl": func(linkName string) string {
+		return documentationPage + linkName
+	},
+
+	"ToLower": strings.ToLower,
+	"ToUpper": strings.ToUpper,
+	"Join":    strings.Join,
+}
+
+// TemplateDir is a directory of templates to execute and the directory their output is written to.
+type TemplateDir struct {
+	Templates string
+	Output    string
+}
+
+// target is one output of a template directory.
+type target struct {
+	template *template.Template
+	source   string // Path of the template file
+	output   string
+	model    Model
+}
+
+// GenerateTargets executes every template in each of dirs and writes the results to the matching output directory.
+// Each NAME.tmpl file is a target rendering to NAME; files starting with an underscore only hold {{define}}d
+// templates shared by the others.  Targets whose output is a .go file are gofmt'ed.  Nothing is written if two
+// targets would render to the same file.
+func GenerateTargets(dirs []TemplateDir, model Model) error {
+	var targets []target
+	sources := map[string]string{} // Absolute output path to the template rendering it
+	for _, dir := range dirs {
+		dirTargets, err := planTargets(dir, model)
+		if err != nil {
+			return err
+		}
+
+		for _, planned := range dirTargets {
+			path, err := filepath.Abs(planned.output)
+			if err != nil {
+				path = filepath.Clean(planned.output)
+			}
+			if source, ok := sources[path]; ok {
+				return fmt.Errorf("%v and %v both render to %v", source, planned.source, planned.output)
+			}
+			sources[path] = planned.source
+		}
+		targets = append(targets, dirTargets...)
+	}
+
+	for _, planned := range targets {
+		err := generateTarget(planned.template, planned.output, planned.model)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// planTargets parses the templates of dir and lists the outputs they render to.
+func planTargets(dir TemplateDir, model Model) ([]target, error) {
+	paths, err := filepath.Glob(filepath.Join(dir.Templates, "*.tmpl"))
+	if err != nil {
+		return nil, fmt.Errorf("could not list templates in %v: %w", dir.Templates, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no *.tmpl files in %v", dir.Templates)
+	}
+
+	templates, err := template.New(dir.Templates).Funcs(templateFuncs).ParseFiles(paths...)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse templates in %v: %w", dir.Templates, err)
+	}
+
+	var targets []target
+	for _, path := range paths {
+		name := filepath.Base(path)
+		if strings.HasPrefix(name, "_") {
+			continue
+		}
+		output := strings.TrimSuffix(name, ".tmpl")
+
+		if !strings.Contains(output, categoryPlaceholder) {
+			targets = append(targets, target{templates.Lookup(name), path, filepath.Join(dir.Output, output), model})
+			continue
+		}
+
+		for i := range model.Categories {
+			categoryModel := model
+			categoryModel.Category = &model.Categories[i]
+			categoryName := strings.TrimPrefix(model.Categories[i].LinkName, "#")
+			categoryOutput := strings.ReplaceAll(output, categoryPlaceholder, categoryName)
+
+			targets = append(targets, target{templates.Lookup(name), path, filepath.Join(dir.Output, categoryOutput),
+				categoryModel})
+		}
+	}
+
+	return targets, nil
+}
+
+func generateTarget(target *template.Template, outputPath string, model Model) error {
+	var contents bytes.Buffer
+	err := target.Execute(&contents, model)
+	if err != nil {
+		return fmt.Errorf("could not execute template %v: %w", target.Name(), err)
+	}
+
+	generated := contents.Bytes()
+	if strings.HasSuffix(outputPath, ".go") {
+		generated, err = format.Source(generated)
+		if err != nil {
+			return fmt.Errorf("error formatting the output of template %v: %w", target.Name(), err)
+		}
+	}
+
+	err = os.WriteFile(outputPath, generated, 0644)
+	if err != nil {
+		return fmt.Errorf("could not write %v: %w", outputPath, err)
+	}
+	return nil
+}
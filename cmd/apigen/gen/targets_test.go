@@ -0,0 +1,119 @@
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
+)
+
+func TestGenerateTargets(t *testing.T) {
+	templateDir, outputDir := t.TempDir(), t.TempDir()
+	templates := map[string]string{
+		"_shared.tmpl": `{{define "name"}}{{CamelCase .Function}}{{if IsPremium .}}*{{end}}{{end}}`,
+		"all.txt.tmpl": `{{range .Categories}}{{.ReadableName}}:{{range .Endpoints}} {{template "name" .}}{{end}}
+{{end}}`,
+		"facade_[category].go.tmpl": `package facade
+{{range .Category.Endpoints}}{{range Params .}}
+var {{CamelCase .Name}} {{GoType .}}{{end}}{{end}}
+`,
+	}
+	for name, contents := range templates {
+		err := os.WriteFile(filepath.Join(templateDir, name), []byte(contents), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fundamentals := api.Category{LinkName: "#fundamentals", ReadableName: "Fundamental Data"}
+	fx := api.Category{LinkName: "#fx", ReadableName: "Foreign Exchange (FX)"}
+	endpoints := api.Endpoints{
+		fundamentals: {{Function: "LISTING_STATUS", Params: []api.Parameter{
+			{Name: "date", Type: "date"}, {Name: "apikey", Required: true},
+		}}},
+		fx: {{Function: "FX_INTRADAY", Premium: true, Params: []api.Parameter{
+			{Name: "interval", Required: true}, {Name: "outputsize"},
+		}}},
+	}
+
+	err := GenerateTargets([]TemplateDir{{templateDir, outputDir}}, NewModel(endpoints, api.AccessRecord{}))
+	if err != nil {
+		t.Fatalf("GenerateTargets: %v", err)
+	}
+
+	want := map[string]string{
+		"all.txt":                "Fundamental Data: ListingStatus\nForeign Exchange (FX): FxIntraday*\n",
+		"facade_fundamentals.go": "package facade\n\nvar Date time.Time\n",
+		"facade_fx.go":           "package facade\n\nvar Interval Interval\nvar Outputsize OutputSize\n",
+	}
+	entries, _ := os.ReadDir(outputDir)
+	if len(entries) != len(want) {
+		t.Errorf("got %v output files, want %v", len(entries), len(want))
+	}
+	for name, contents := range want {
+		got, err := os.ReadFile(filepath.Join(outputDir, name))
+		if err != nil {
+			t.Errorf("missing output %v: %v", name, err)
+		} else if string(got) != contents {
+			t.Errorf("%v =\n%q\nwant\n%q", name, got, contents)
+		}
+	}
+}
+
+func TestGenerateTargetsOutputs(t *testing.T) {
+	writeTemplates := func(templates map[string]string) string {
+		dir := t.TempDir()
+		for name, contents := range templates {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return dir
+	}
+	model := NewModel(api.Endpoints{
+		api.Category{LinkName: "#fx", ReadableName: "Foreign Exchange (FX)"}: {{Function: "FX_DAILY"}},
+	}, api.AccessRecord{})
+
+	// Each directory renders to its own output directory.
+	wrappers := writeTemplates(map[string]string{"names.txt.tmpl": "wrappers"})
+	docs := writeTemplates(map[string]string{"names.txt.tmpl": "docs"})
+	wrappersOut, docsOut := t.TempDir(), t.TempDir()
+	err := GenerateTargets([]TemplateDir{{wrappers, wrappersOut}, {docs, docsOut}}, model)
+	if err != nil {
+		t.Fatalf("GenerateTargets: %v", err)
+	}
+	for dir, want := range map[string]string{wrappersOut: "wrappers", docsOut: "docs"} {
+		if got, err := os.ReadFile(filepath.Join(dir, "names.txt")); err != nil || string(got) != want {
+			t.Errorf("%v/names.txt = %q, %v, want %q", dir, got, err, want)
+		}
+	}
+
+	tests := []struct {
+		name string
+		dirs func(out string) []TemplateDir
+	}{
+		{"two directories", func(out string) []TemplateDir {
+			return []TemplateDir{{wrappers, out}, {docs, out + "/."}}
+		}},
+		{"category target", func(out string) []TemplateDir {
+			return []TemplateDir{{writeTemplates(map[string]string{
+				"facade_[category].go.tmpl": "package facade\n",
+				"facade_fx.go.tmpl":         "package facade\n",
+			}), out}}
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			out := t.TempDir()
+			err := GenerateTargets(test.dirs(out), model)
+			if err == nil || !strings.Contains(err.Error(), "both render to") {
+				t.Errorf("GenerateTargets() = %v, want an error for the duplicate output", err)
+			}
+			if entries, _ := os.ReadDir(out); len(entries) != 0 {
+				t.Errorf("wrote %d files despite the duplicate output", len(entries))
+			}
+		})
+	}
+}
@@ -9,7 +9,10 @@ var fileHeaderTemplate = template.Must(template.New("File Header").
 
 package alphavantage
 
-import "github.com/jay9909/alphavantage/api"
+import (
+	"github.com/jay9909/alphavantage/api"{{if .UsesTime}}
+	"time"{{end}}
+)
 
 `))
 
@@ -17,22 +20,91 @@ var categoryTemplate = template.Must(template.New("Category").Parse(`
 // Endpoint Category: {{.ReadableName}}
 // {{.LinkName}}
 //
-// {{.Desc}}
+{{.Desc}}
 `))
 
 var endpointTemplate = template.Must(template.New("Function").Parse(`
 {{.DocComment}}
 func (a *Alphavantage) Get{{.FuncName}}({{.ArgList}}) api.Response {
-	function := "{{.EndpointFunction}}"
-	params := map[string]string{
-{{.QueryParams}}
-	}
+	return a.query("{{.EndpointFunction}}", {{.Builder}}({{.ArgNames}}))
+}
+
+// {{.FuncName}}Params holds the parameters of [Alphavantage.Query{{.FuncName}}].
+// See [Alphavantage.Get{{.FuncName}}] for their documentation.
+type {{.FuncName}}Params struct {
+{{.Fields}}
+}
+
+// Query{{.FuncName}} is the typed form of [Alphavantage.Get{{.FuncName}}].
+func (a *Alphavantage) Query{{.FuncName}}(p {{.FuncName}}Params) api.Response {
+	return a.query("{{.EndpointFunction}}", {{.Builder}}({{.TypedArgs}}))
+}
+
+// {{.Builder}} maps the arguments of [Alphavantage.Get{{.FuncName}}] onto their query parameters.
+func {{.Builder}}({{.ArgList}}) map[string]string {
+	return map[string]string{{"{"}}{{.QueryParams}}}
+}
+
+`))
+
+var requiredParamsTemplate = template.Must(template.New("Required Params").Parse(`
+// requiredParams lists, for each endpoint function, the parameters it cannot be called without.
+var requiredParams = map[string][]string{
+{{.Entries}}
+}
+`))
+
+var documentedRulesTemplate = template.Must(template.New("Documented Rules").Parse(`
+// documentedRules holds, for each endpoint function, the checks implied by its parameter descriptions: accepted
+// values, positive numbers and date formats.
+var documentedRules = map[string]map[string]paramRule{
+{{.Entries}}
+}
+`))
 
-	return a.client.Query(function, params)
+var interfacesTemplate = template.Must(template.New("Interfaces").Parse(`
+// API is the set of methods generated from the documentation page.  It is implemented by *Alphavantage and by
+// fake.Client, so code that only needs these methods can be tested without the network.
+type API interface {
+{{.Embedded}}
 }
 
+var _ API = (*Alphavantage)(nil)
+
+{{.Interfaces}}
 `))
 
-var checksumTemplate = template.Must(template.New("Checksum").Parse(`
-// Checksum: {{.Checksum}}
+var fakeTemplate = template.Must(template.New("Fake").Parse(`// Code generated by go generate; DO NOT EDIT.
+// This file was generated on {{.Date}}
+// using data from https://www.alphavantage.co/documentation
+
+package fake
+
+import (
+	"github.com/jay9909/alphavantage"
+	"github.com/jay9909/alphavantage/api"
+	"sync"
+)
+
+// Client is a programmable implementation of alphavantage.API.  Each method returns the result of its Func field
+// when that is set, and otherwise the response given to Respond for the method's name.  Every call is recorded.
+type Client struct {
+{{.Fields}}
+
+	calls     []Call
+	responses map[string]api.Response
+	mutex     sync.Mutex
+}
+
+var _ alphavantage.API = (*Client)(nil)
+{{.Methods}}`))
+
+var fakeMethodTemplate = template.Must(template.New("Fake Method").Parse(`
+func (c *Client) {{.Name}}({{.Arguments}}) api.Response {
+	c.record("{{.Name}}"{{if .ArgNames}}, {{.ArgNames}}{{end}})
+	if c.{{.Name}}Func != nil {
+		return c.{{.Name}}Func({{.ArgNames}})
+	}
+	return c.response("{{.Name}}")
+}
 `))
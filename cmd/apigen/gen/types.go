@@ -0,0 +1,160 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
+	"github.com/jay9909/alphavantage/cmd/apigen/parse"
+	"strings"
+)
+
+// paramType describes how a documented parameter is represented in a generated params struct.
+type paramType struct {
+	goType   string // Go type of the struct field
+	format   string // Expression turning the field into a query value; %v stands in for the field
+	required string // format for required parameters, if their zero value is not to be left out as unset
+}
+
+var (
+	stringParam     = paramType{goType: "string", format: "%v"}
+	intParam        = paramType{goType: "int", format: "formatInt(%v)", required: "formatRequiredInt(%v)"}
+	floatParam      = paramType{goType: "float64", format: "formatFloat(%v)"}
+	dateParam       = paramType{goType: "time.Time", format: "formatDate(%v)"}
+	dateTimeParam   = paramType{goType: "time.Time", format: "formatDateTime(%v)"}
+	intervalParam   = paramType{goType: "Interval", format: "string(%v)"}
+	seriesTypeParam = paramType{goType: "SeriesType", format: "string(%v)"}
+	maTypeParam     = paramType{goType: "MAType", format: "formatInt(int(%v))"}
+	outputSizeParam = paramType{goType: "OutputSize", format: "string(%v)"}
+	dataTypeParam   = paramType{goType: "DataType", format: "string(%v)"}
+)
+
+// paramTypes is the curated table of parameters that are not plain strings.  It takes precedence over the types
+// inferred from the documentation prose, and is the only source of the named enum types.
+var paramTypes = map[string]paramType{
+	"interval":    intervalParam,
+	"series_type": seriesTypeParam,
+	"outputsize":  outputSizeParam,
+	"datatype":    dataTypeParam,
+
+	"matype":       maTypeParam,
+	"fastmatype":   maTypeParam,
+	"slowmatype":   maTypeParam,
+	"signalmatype": maTypeParam,
+	"slowkmatype":  maTypeParam,
+	"slowdmatype":  maTypeParam,
+	"fastdmatype":  maTypeParam,
+
+	"time_period":  intParam,
+	"fastperiod":   intParam,
+	"slowperiod":   intParam,
+	"signalperiod": intParam,
+	"fastkperiod":  intParam,
+	"slowkperiod":  intParam,
+	"slowdperiod":  intParam,
+	"fastdperiod":  intParam,
+	"timeperiod1":  intParam,
+	"timeperiod2":  intParam,
+	"timeperiod3":  intParam,
+	"limit":        intParam,
+
+	"fastlimit":    floatParam,
+	"slowlimit":    floatParam,
+	"nbdevup":      intParam,
+	"nbdevdn":      intParam,
+	"acceleration": floatParam,
+	"maximum":      floatParam,
+
+	"date":      dateParam,
+	"time_from": dateTimeParam,
+	"time_to":   dateTimeParam,
+}
+
+// inferredTypes maps the types the parser infers from parameter descriptions onto Go types.  Enumerated values stay
+// strings unless paramTypes gives them a named type.
+var inferredTypes = map[string]paramType{
+	parse.TypeInteger:  intParam,
+	parse.TypeNumber:   floatParam,
+	parse.TypeDate:     dateParam,
+	parse.TypeDateTime: dateTimeParam,
+}
+
+// typeOf returns the type of a parameter's params struct field.  The curated table wins over the type inferred from
+// the documentation, which is only as good as the description's phrasing.
+func typeOf(param api.Parameter) paramType {
+	if t, ok := paramTypes[param.Name]; ok {
+		return t
+	}
+	if t, ok := inferredTypes[param.Type]; ok {
+		return t
+	}
+	return stringParam
+}
+
+// formatField returns the expression that converts the named params struct field into its query value.
+func (t paramType) formatField(field string, required bool) string {
+	if required && t.required != "" {
+		return fmt.Sprintf(t.required, field)
+	}
+	return fmt.Sprintf(t.format, field)
+}
+
+// ruleOf returns the Go expression of the validation rule implied by a parameter's inferred fields, or "" when the
+// documentation doesn't constrain its values.  The expression refers to the rule builders in validate.go.
+func ruleOf(param api.Parameter) string {
+	var rule string
+	switch {
+	case len(param.Enum) > 0:
+		quoted := make([]string, len(param.Enum))
+		for i, value := range param.Enum {
+			quoted[i] = fmt.Sprintf("%q", value)
+		}
+		rule = fmt.Sprintf("oneOf(%v)", strings.Join(quoted, ", "))
+	case param.Type == parse.TypeInteger && param.Positive:
+		rule = "intBetween(1, maxInt)"
+	case param.Type == parse.TypeNumber && param.Positive:
+		rule = "positiveFloat"
+	case param.Type == parse.TypeDate:
+		rule = `timeLayout("2006-01-02", "YYYY-MM-DD", time.Time{})`
+	case param.Type == parse.TypeDateTime:
+		rule = `timeLayout("20060102T1504", "YYYYMMDDTHHMM", time.Time{})`
+	default:
+		return ""
+	}
+
+	if param.List {
+		rule = fmt.Sprintf("listOf(%v)", rule)
+	}
+	return rule
+}
+
+// fieldComment summarizes what the documentation says about a parameter's values for the trailing comment of its
+// params struct field, e.g. "Optional; one of compact, full; default compact".
+func fieldComment(param api.Parameter, fieldType paramType) string {
+	var details []string
+	if !param.Required {
+		details = append(details, "Optional")
+	}
+
+	switch {
+	case len(param.Enum) > 0 && param.List:
+		details = append(details, "comma-separated list of "+strings.Join(param.Enum, ", "))
+	case len(param.Enum) > 0:
+		details = append(details, "one of "+strings.Join(param.Enum, ", "))
+	case param.List:
+		details = append(details, "comma-separated list")
+	}
+
+	// Formats of time.Time fields are taken care of by the typed method.
+	if param.Format != "" && fieldType.goType == "string" {
+		details = append(details, "formatted as "+param.Format)
+	}
+
+	if param.Default != "" {
+		details = append(details, "default "+param.Default)
+	}
+
+	comment := strings.Join(details, "; ")
+	if comment != "" {
+		comment = strings.ToUpper(comment[:1]) + comment[1:]
+	}
+	return comment
+}
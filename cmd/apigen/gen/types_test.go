@@ -0,0 +1,59 @@
+package gen
+
+import (
+	"testing"
+
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
+	"github.com/jay9909/alphavantage/cmd/apigen/parse"
+)
+
+func TestRuleOf(t *testing.T) {
+	tests := []struct {
+		name  string
+		param api.Parameter
+		want  string
+	}{
+		{"string", api.Parameter{Type: parse.TypeString}, ""},
+		{"enum", api.Parameter{Type: parse.TypeEnum, Enum: []string{"compact", "full"}}, `oneOf("compact", "full")`},
+		{"enum list", api.Parameter{Type: parse.TypeEnum, Enum: []string{"ipo", "earnings"}, List: true},
+			`listOf(oneOf("ipo", "earnings"))`},
+		{"positive integer", api.Parameter{Type: parse.TypeInteger, Positive: true}, "intBetween(1, maxInt)"},
+		{"integer", api.Parameter{Type: parse.TypeInteger}, ""},
+		{"positive number", api.Parameter{Type: parse.TypeNumber, Positive: true}, "positiveFloat"},
+		{"number", api.Parameter{Type: parse.TypeNumber}, ""},
+		{"date", api.Parameter{Type: parse.TypeDate, Format: "YYYY-MM-DD"},
+			`timeLayout("2006-01-02", "YYYY-MM-DD", time.Time{})`},
+		{"date time", api.Parameter{Type: parse.TypeDateTime, Format: "YYYYMMDDTHHMM"},
+			`timeLayout("20060102T1504", "YYYYMMDDTHHMM", time.Time{})`},
+		{"list without a rule", api.Parameter{Type: parse.TypeString, List: true}, ""},
+	}
+
+	for _, test := range tests {
+		if got := ruleOf(test.param); got != test.want {
+			t.Errorf("%s: ruleOf() = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestTypeOf(t *testing.T) {
+	tests := []struct {
+		param api.Parameter
+		want  string
+	}{
+		{api.Parameter{Name: "interval", Type: parse.TypeEnum}, "Interval"},
+		{api.Parameter{Name: "nbdevup", Type: parse.TypeInteger}, "int"},
+		{api.Parameter{Name: "acceleration", Type: parse.TypeNumber}, "float64"},
+		{api.Parameter{Name: "sort", Type: parse.TypeEnum}, "string"},
+		{api.Parameter{Name: "count", Type: parse.TypeInteger}, "int"},
+		{api.Parameter{Name: "ratio", Type: parse.TypeNumber}, "float64"},
+		{api.Parameter{Name: "since", Type: parse.TypeDate}, "time.Time"},
+		{api.Parameter{Name: "symbol", Type: parse.TypeString}, "string"},
+	}
+
+	for _, test := range tests {
+		if got := typeOf(test.param).goType; got != test.want {
+			t.Errorf("typeOf(%v) = %v, want %v", test.param.Name, got, test.want)
+		}
+	}
+
+}
@@ -1,22 +1,131 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
 	"github.com/jay9909/alphavantage/cmd/apigen/gen"
 	"github.com/jay9909/alphavantage/cmd/apigen/parse"
+	"github.com/jay9909/alphavantage/cmd/apigen/spec"
+	"os"
+	"strings"
 )
 
 func main() {
-	// Comment out the first line and un-comment the second to force re-generation
-	previousChecksum, err := gen.GetPreviousChecksum()
-	// var previousChecksum [32]byte
-
-	endpoints, accessRecord, err := parse.FindEndpoints(previousChecksum)
-	if err == parse.NoChangeError {
-		fmt.Printf("No change to API documentation since previous generation")
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		if err := runDiff(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, "apigen diff:", err)
+			os.Exit(1)
+		}
 		return
-	} else if err != nil {
-		panic(err)
+	}
+
+	input := flag.String("input", "", "read the documentation page from this saved HTML file (\"-\" for stdin)")
+	fetch := flag.Bool("fetch", false, "download the documentation page from alphavantage.co")
+	specIn := flag.String("spec", "", "generate from this spec file instead of the documentation page")
+	specOut := flag.String("spec-out", "", "also write the scraped API surface to this spec file")
+	openApiOut := flag.String("openapi-out", "", "also write an OpenAPI 3 document to this file")
+	force := flag.Bool("force", false, "regenerate even if the scraped API has not changed since the last generation")
+	var templateDirs stringList
+	flag.Var(&templateDirs, "templates",
+		"also execute the *.tmpl files in this directory, given as DIR or DIR=OUT to write them to OUT (repeatable)")
+	templatesOut := flag.String("templates-out", ".", "directory to write the output of -templates without OUT to")
+	flag.Parse()
+
+	sources := 0
+	for _, given := range []bool{*input != "", *fetch, *specIn != ""} {
+		if given {
+			sources++
+		}
+	}
+	if sources != 1 {
+		fmt.Fprintln(os.Stderr, "apigen: exactly one of -input, -fetch or -spec is required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var endpoints api.Endpoints
+	var accessRecord api.AccessRecord
+	var err error
+
+	if *specIn != "" {
+		// The spec is the source of truth, so always regenerate from it.
+		var apiSpec spec.Spec
+		apiSpec, err = spec.Read(*specIn)
+		if err != nil {
+			panic(err)
+		}
+		endpoints, accessRecord, err = apiSpec.Endpoints()
+		if err != nil {
+			panic(err)
+		}
+	} else {
+		var documentationPage []byte
+		if *fetch {
+			documentationPage, err = parse.FetchDocumentation()
+		} else {
+			documentationPage, err = parse.ReadDocumentation(*input)
+		}
+		if err != nil {
+			panic(err)
+		}
+
+		var diagnostics parse.Diagnostics
+		endpoints, accessRecord, diagnostics, err = parse.FindEndpoints(documentationPage)
+		if len(diagnostics) > 0 {
+			fmt.Fprint(os.Stderr, diagnostics)
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	apiSpec := spec.FromEndpoints(endpoints, accessRecord)
+	accessRecord.Checksum = apiSpec.Checksum()
+
+	// The spec outputs describe this scrape, so they are written even when the code is up to date.
+	if *specOut != "" {
+		err = apiSpec.Write(*specOut)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if *openApiOut != "" {
+		err = apiSpec.WriteOpenApi(*openApiOut)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// User templates may have changed even if the API has not, e.g. a template was just added, so they are executed
+	// on every run.
+	if len(templateDirs) > 0 {
+		var dirs []gen.TemplateDir
+		for _, templateDir := range templateDirs {
+			dir, out, hasOut := strings.Cut(templateDir, "=")
+			if !hasOut {
+				out = *templatesOut
+			}
+			dirs = append(dirs, gen.TemplateDir{Templates: dir, Output: out})
+		}
+
+		err = gen.GenerateTargets(dirs, gen.NewModel(endpoints, accessRecord))
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// A spec given with -spec is the source of truth, so it is always generated from.  A scrape is only generated
+	// from if it differs from the spec of the previous generation.
+	if *specIn == "" && !*force {
+		previous, err := gen.ReadMetadata()
+		if err != nil {
+			panic(err)
+		}
+		if previous.Matches(accessRecord) {
+			fmt.Println("No change to the API since the previous generation; use -force to regenerate anyway")
+			return
+		}
 	}
 
 	err = gen.GenerateApi(endpoints, accessRecord)
@@ -24,3 +133,15 @@ func main() {
 		panic(err)
 	}
 }
+
+// stringList is a flag that can be given more than once.
+type stringList []string
+
+func (list *stringList) String() string {
+	return strings.Join(*list, ",")
+}
+
+func (list *stringList) Set(value string) error {
+	*list = append(*list, value)
+	return nil
+}
@@ -0,0 +1,121 @@
+package parse
+
+import (
+	"fmt"
+	"github.com/PuerkitoBio/goquery"
+	"strings"
+)
+
+// snippetLength caps the HTML quoted in errors and diagnostics.
+const snippetLength = 200
+
+// ParseError reports a part of the documentation page that did not have the expected structure.
+type ParseError struct {
+	Location string // Link name of the category or endpoint being parsed, e.g. "#fx-intraday"
+	Snippet  string // The offending HTML, truncated
+	Err      error
+}
+
+func (e *ParseError) Error() string {
+	if e.Snippet == "" {
+		return fmt.Sprintf("%v: %v", e.Location, e.Err)
+	}
+	return fmt.Sprintf("%v: %v\n\t%v", e.Location, e.Err, e.Snippet)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// parseErrorf builds a ParseError quoting the outer HTML of node, which may be nil or empty.
+func parseErrorf(location string, node *goquery.Selection, format string, args ...any) *ParseError {
+	return &ParseError{
+		Location: location,
+		Snippet:  snippet(node),
+		Err:      fmt.Errorf(format, args...),
+	}
+}
+
+func snippet(node *goquery.Selection) string {
+	if node == nil || node.Length() == 0 {
+		return ""
+	}
+
+	html, err := goquery.OuterHtml(node.First())
+	if err != nil {
+		return ""
+	}
+	html = strings.Join(strings.Fields(html), " ")
+	if len(html) > snippetLength {
+		html = html[:snippetLength] + "..."
+	}
+	return html
+}
+
+// Diagnostic is one problem found while parsing the documentation page.
+type Diagnostic struct {
+	Location string `json:"location"`
+	Message  string `json:"message"`
+	Snippet  string `json:"snippet,omitempty"`
+	Skipped  bool   `json:"skipped"` // The endpoint at Location was left out of the result
+}
+
+func (d Diagnostic) String() string {
+	var builder strings.Builder
+
+	if d.Skipped {
+		builder.WriteString("SKIPPED ")
+	} else {
+		builder.WriteString("WARNING ")
+	}
+	_, _ = fmt.Fprintf(&builder, "%v: %v", d.Location, d.Message)
+	if d.Snippet != "" {
+		_, _ = fmt.Fprintf(&builder, "\n\t%v", d.Snippet)
+	}
+
+	return builder.String()
+}
+
+// Diagnostics is the report of every problem found while parsing the documentation page.
+type Diagnostics []Diagnostic
+
+// Skipped returns the number of endpoints left out of the result.
+func (d Diagnostics) Skipped() int {
+	skipped := 0
+	for _, diagnostic := range d {
+		if diagnostic.Skipped {
+			skipped++
+		}
+	}
+	return skipped
+}
+
+func (d Diagnostics) String() string {
+	var builder strings.Builder
+
+	_, _ = fmt.Fprintf(&builder, "Documentation diagnostics: %d problem(s), %d endpoint(s) skipped\n",
+		len(d), d.Skipped())
+	for _, diagnostic := range d {
+		_, _ = fmt.Fprintf(&builder, "%v\n", diagnostic)
+	}
+
+	return builder.String()
+}
+
+// warn records a problem that did not stop anything from being parsed.
+func (d *Diagnostics) warn(location, message string, node *goquery.Selection) {
+	*d = append(*d, Diagnostic{Location: location, Message: message, Snippet: snippet(node)})
+}
+
+// skip records an endpoint that could not be parsed and was left out.
+func (d *Diagnostics) skip(err error) {
+	diagnostic := Diagnostic{Message: err.Error(), Skipped: true}
+
+	if parseErr, ok := err.(*ParseError); ok {
+		diagnostic.Location = parseErr.Location
+		diagnostic.Message = parseErr.Err.Error()
+		diagnostic.Snippet = parseErr.Snippet
+	}
+
+	*d = append(*d, diagnostic)
+}
@@ -0,0 +1,144 @@
+package parse
+
+import (
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Parameter types inferred from the documentation text.
+const (
+	TypeString   = "string"
+	TypeInteger  = "integer"
+	TypeNumber   = "number"
+	TypeDate     = "date"
+	TypeDateTime = "datetime"
+	TypeEnum     = "enum"
+)
+
+var (
+	codePattern     = regexp.MustCompile(`<code>(.*?)</code>`)
+	intRangePattern = regexp.MustCompile(`Integers (\d+) - (\d+) are accepted`)
+	datePattern     = regexp.MustCompile(`\bYYYY-MM-DD\b`)
+	dateTimePattern = regexp.MustCompile(`\bYYYYMMDDTHHMM\b`)
+)
+
+// InferParameter fills in the type, accepted values, default, examples and format of a parameter from the prose of
+// its description.  The documentation is consistent enough in its phrasing for this to work on every current
+// parameter, but nothing here is guaranteed: callers should treat an empty field as "unknown", not "none".
+func InferParameter(param *api.Parameter) {
+	desc := param.Desc
+
+	// Every value the description mentions is in a <code> span, either on its own (`<code>compact</code>`) or as an
+	// assignment (`<code>outputsize=compact</code>`).  The words before an assignment say what kind of value it is:
+	// "By default, <code>sort=LATEST</code> ... You can also set <code>sort=EARLIEST</code> or
+	// <code>sort=RELEVANCE</code>".  A bare "or" or comma continues the previous kind.
+	var bareValues, alternatives []string
+	mode := ""
+	previousEnd := 0
+	for _, match := range codePattern.FindAllStringSubmatchIndex(desc, -1) {
+		before := strings.ToLower(desc[previousEnd:match[0]])
+		previousEnd = match[1]
+		code := desc[match[2]:match[3]]
+
+		switch {
+		case strings.Contains(before, "by default"):
+			mode = "default"
+		case strings.Contains(before, "set "):
+			mode = "alternative"
+		case strings.Contains(before, "example") || strings.Contains(before, "e.g."):
+			mode = "example"
+		}
+
+		name, value, isAssignment := strings.Cut(code, "=")
+		if !isAssignment {
+			bareValues = appendUnique(bareValues, code)
+			continue
+		}
+		if name != param.Name {
+			continue
+		}
+
+		switch mode {
+		case "default":
+			if param.Default == "" {
+				param.Default = value
+			}
+		case "alternative":
+			alternatives = appendUnique(alternatives, value)
+		default:
+			param.Examples = appendUnique(param.Examples, value)
+		}
+	}
+
+	for _, example := range append([]string{param.Default}, param.Examples...) {
+		if strings.Contains(example, ",") {
+			param.List = true
+		}
+	}
+
+	param.Positive = strings.Contains(desc, "Positive integers") || strings.Contains(desc, "Positive floats")
+
+	switch {
+	case dateTimePattern.MatchString(desc):
+		param.Type = TypeDateTime
+		param.Format = "YYYYMMDDTHHMM"
+
+	case datePattern.MatchString(desc):
+		param.Type = TypeDate
+		param.Format = "YYYY-MM-DD"
+
+	case intRangePattern.MatchString(desc):
+		bounds := intRangePattern.FindStringSubmatch(desc)
+		low, _ := strconv.Atoi(bounds[1])
+		high, _ := strconv.Atoi(bounds[2])
+		param.Type = TypeEnum
+		for value := low; value <= high; value++ {
+			param.Enum = append(param.Enum, strconv.Itoa(value))
+		}
+
+	case strings.Contains(desc, "Positive integers"):
+		param.Type = TypeInteger
+
+	case strings.Contains(desc, "Positive floats"):
+		param.Type = TypeNumber
+
+	case len(bareValues) > 0 && (strings.Contains(desc, "accepted") || strings.Contains(desc, "supported")) &&
+		!strings.Contains(desc, "..."):
+		// A list of accepted values.  Lists abbreviated with "..." are incomplete and can't be used as an enum.
+		param.Type = TypeEnum
+		param.Enum = appendUnique(param.Enum, param.Default)
+		for _, value := range append(bareValues, alternatives...) {
+			param.Enum = appendUnique(param.Enum, value)
+		}
+
+	case param.Default != "" && len(alternatives) > 0 && !isInteger(param.Default):
+		// "By default, state=active ... Set state=delisted"
+		param.Type = TypeEnum
+		param.Enum = append([]string{param.Default}, alternatives...)
+
+	case param.Default != "" && isInteger(param.Default):
+		param.Type = TypeInteger
+
+	default:
+		param.Type = TypeString
+	}
+}
+
+func isInteger(value string) bool {
+	_, err := strconv.Atoi(value)
+	return err == nil
+}
+
+func appendUnique(values []string, value string) []string {
+	if value == "" {
+		return values
+	}
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,78 @@
+package parse
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
+)
+
+func TestInferParameter(t *testing.T) {
+	tests := []struct {
+		name string
+		desc string
+		want api.Parameter // Only the inferred fields
+	}{
+		{"symbol", "The name of the equity of your choice. For example: <code>symbol=IBM</code>",
+			api.Parameter{Type: TypeString, Examples: []string{"IBM"}}},
+		{"interval", "Time interval between two consecutive data points in the time series. The following values " +
+			"are supported: <code>1min</code>, <code>5min</code>, <code>daily</code>",
+			api.Parameter{Type: TypeEnum, Enum: []string{"1min", "5min", "daily"}}},
+		{"outputsize", "By default, <code>outputsize=compact</code>. Strings <code>compact</code> and " +
+			"<code>full</code> are accepted with the following specifications",
+			api.Parameter{Type: TypeEnum, Enum: []string{"compact", "full"}, Default: "compact"}},
+		{"sort", "By default, <code>sort=LATEST</code> and the API will return the latest articles first. You can " +
+			"also set <code>sort=EARLIEST</code> or <code>sort=RELEVANCE</code> based on your use case.",
+			api.Parameter{Type: TypeEnum, Enum: []string{"LATEST", "EARLIEST", "RELEVANCE"}, Default: "LATEST"}},
+		{"state", "By default, <code>state=active</code> and the API will return a list of actively traded " +
+			"stocks and ETFs. Set <code>state=delisted</code> to query a list of delisted assets.",
+			api.Parameter{Type: TypeEnum, Enum: []string{"active", "delisted"}, Default: "active"}},
+		{"matype", "Moving average type. By default, <code>matype=0</code>. Integers 0 - 8 are accepted with the " +
+			"following mappings. 0 = Simple Moving Average (SMA)",
+			api.Parameter{Type: TypeEnum, Enum: []string{"0", "1", "2", "3", "4", "5", "6", "7", "8"}, Default: "0"}},
+		{"time_period", "Number of data points used to calculate each moving average value. Positive integers " +
+			"are accepted (e.g., <code>time_period=60</code>, <code>time_period=200</code>)",
+			api.Parameter{Type: TypeInteger, Positive: true, Examples: []string{"60", "200"}}},
+		{"acceleration", "The acceleration factor. Positive floats are accepted. By default, " +
+			"<code>acceleration=0.01</code>.",
+			api.Parameter{Type: TypeNumber, Positive: true, Default: "0.01"}},
+		{"limit", "By default, <code>limit=50</code> and the API will return up to 50 matching results.",
+			api.Parameter{Type: TypeInteger, Default: "50"}},
+		{"date", "If no date is set, the API endpoint will return a list of active or delisted symbols as of the " +
+			"latest trading day. If a date is set, the API endpoint will \"travel back\" in time. Any YYYY-MM-DD " +
+			"date later than 2010-01-01 is supported. For example, <code>date=2013-08-03</code>",
+			api.Parameter{Type: TypeDate, Format: "YYYY-MM-DD", Examples: []string{"2013-08-03"}}},
+		{"time_from", "The time range of the news articles you are targeting, in YYYYMMDDTHHMM format. For " +
+			"example: <code>time_from=20220410T0130</code>.",
+			api.Parameter{Type: TypeDateTime, Format: "YYYYMMDDTHHMM", Examples: []string{"20220410T0130"}}},
+		{"tickers", "The stock/crypto/forex symbols of your choice. For example: <code>tickers=IBM</code> will " +
+			"filter for articles that mention the IBM ticker; <code>tickers=COIN,CRYPTO:BTC,FOREX:USD</code> will " +
+			"filter for articles that simultaneously mention all of them.",
+			api.Parameter{Type: TypeString, List: true, Examples: []string{"IBM", "COIN,CRYPTO:BTC,FOREX:USD"}}},
+		{"topics", "The news topics of your choice. For example: <code>topics=technology</code> will filter for " +
+			"articles that write about the technology sector. The following topics are supported: " +
+			"<code>blockchain</code>, <code>earnings</code>, <code>ipo</code>",
+			api.Parameter{Type: TypeEnum, Enum: []string{"blockchain", "earnings", "ipo"},
+				Examples: []string{"technology"}}},
+		// An abbreviated list is not an enum.
+		{"slice", "Two years of minute-level intraday data contains over 2 million data points. The following " +
+			"values are accepted: <code>year1month1</code>, <code>year1month2</code>, ..., <code>year2month12</code>",
+			api.Parameter{Type: TypeString}},
+		// Assignments to other parameters are not examples of this one.
+		{"datatype", "By default, <code>datatype=json</code>. Strings <code>json</code> and <code>csv</code> are " +
+			"accepted. For example: <code>function=TIME_SERIES_DAILY</code>",
+			api.Parameter{Type: TypeEnum, Enum: []string{"json", "csv"}, Default: "json"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			param := api.Parameter{Name: test.name, Desc: test.desc}
+			InferParameter(&param)
+
+			test.want.Name, test.want.Desc = test.name, test.desc
+			if !reflect.DeepEqual(param, test.want) {
+				t.Errorf("inferred\n%+v\nwant\n%+v", param, test.want)
+			}
+		})
+	}
+}
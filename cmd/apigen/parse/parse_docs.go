@@ -2,30 +2,32 @@ package parse
 
 import (
 	"bytes"
-	"crypto/sha256"
-	"encoding/base64"
-	"errors"
 	"fmt"
 	"github.com/PuerkitoBio/goquery"
 	"github.com/jay9909/alphavantage/cmd/apigen/api"
 	"html"
 	"io"
 	"net/http"
+	"os"
+	"regexp"
 	"strings"
 	"time"
 )
 
 const documentationUrl = "https://www.alphavantage.co/documentation/"
 
-var NoChangeError = errors.New("no change since previous checksum")
-
-func FindEndpoints(previousChecksum [32]byte) (api.Endpoints, api.AccessRecord, error) {
-	var accessRecord api.AccessRecord
-
+// FetchDocumentation downloads the live documentation page.
+func FetchDocumentation() ([]byte, error) {
 	fmt.Println("Fetching documentation page")
-	resp, err := http.Get(documentationUrl)
+	return fetchDocumentation(documentationUrl)
+}
+
+// fetchDocumentation downloads the documentation page from url.  Any status but 200 is an error, so that an error
+// page is never parsed as documentation.
+func fetchDocumentation(url string) (documentationPage []byte, err error) {
+	resp, err := http.Get(url)
 	if err != nil {
-		return nil, accessRecord, err
+		return nil, err
 	}
 	defer func() {
 		closeErr := resp.Body.Close()
@@ -34,39 +36,81 @@ func FindEndpoints(previousChecksum [32]byte) (api.Endpoints, api.AccessRecord,
 		}
 	}()
 
-	// Get the response body into a byte array.  Checksum it. If it's the same as before, bail out.
-	// If new, keep going.
-	documentationPage, err := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not fetch documentation: %v from %v", resp.Status, url)
+	}
+
+	documentationPage, err = io.ReadAll(resp.Body)
 	if err != nil {
-		panic(fmt.Errorf("could not read documentation from response: %w", err))
+		return nil, fmt.Errorf("could not read documentation from response: %w", err)
 	}
 
-	// Cloudflare adds some single-use values to every page reload, so we need to scrape those out to check for changes
-	// to the actual documentation.
-	documentationPage = removeCloudflareStuff(documentationPage)
+	return documentationPage, nil
+}
 
-	currentChecksum := sha256.Sum256(documentationPage)
-	if previousChecksum == currentChecksum {
-		fmt.Printf("Checksums match!\n")
-		return nil, accessRecord, NoChangeError
-	} else {
-		previousCheckStr := base64.StdEncoding.EncodeToString(previousChecksum[:])
-		newCheckStr := base64.StdEncoding.EncodeToString(currentChecksum[:])
+// ReadDocumentation reads a saved copy of the documentation page from path, or from stdin if path is "-".
+func ReadDocumentation(path string) ([]byte, error) {
+	if path == "-" {
+		fmt.Println("Reading documentation page from stdin")
+		documentationPage, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("could not read documentation from stdin: %w", err)
+		}
+		return documentationPage, nil
+	}
 
-		fmt.Printf("Checksums do not match\nPrevious Checksum: %v\nNew Checksum: %v\n",
-			previousCheckStr, newCheckStr)
+	fmt.Printf("Reading documentation page from %v\n", path)
+	documentationPage, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read documentation file: %w", err)
 	}
+	return documentationPage, nil
+}
+
+// FindEndpoints scrubs the raw documentation page and parses it into endpoints.  Problems that did not stop the page
+// from being parsed are returned as diagnostics.  Whether anything changed since the last generation is decided by
+// the caller from the checksum of the resulting spec, not from the page itself.
+func FindEndpoints(documentationPage []byte) (api.Endpoints, api.AccessRecord, Diagnostics, error) {
+	var accessRecord api.AccessRecord
+	var diagnostics Diagnostics
+
+	// Cloudflare adds some single-use values to every page reload.  Scrape those out so that they don't end up in
+	// the descriptions.
+	documentationPage = removeCloudflareStuff(documentationPage, &diagnostics)
+
+	endpoints, parseDiagnostics, err := ParseDocument(documentationPage)
+	diagnostics = append(diagnostics, parseDiagnostics...)
+	if err != nil {
+		return nil, accessRecord, diagnostics, err
+	}
+
+	accessRecord.Date = time.Now()
+
+	return endpoints, accessRecord, diagnostics, nil
+}
+
+// ParseDocument extracts the endpoint categories, endpoints and parameters from the documentation page.  The page
+// should already have had its Cloudflare values removed.
+//
+// An endpoint that cannot be parsed is left out and reported in the diagnostics, so that one unusual section does
+// not hold up the rest of the API.  An error is only returned if the page as a whole is unusable.
+func ParseDocument(documentationPage []byte) (api.Endpoints, Diagnostics, error) {
+	var diagnostics Diagnostics
 
 	fmt.Println("Parsing documentation page")
 	root, err := goquery.NewDocumentFromReader(bytes.NewReader(documentationPage))
 	if err != nil {
-		return nil, accessRecord, err
+		return nil, diagnostics, err
 	}
 
 	endpoints := api.Endpoints{}
+	var categories []api.Category // In documentation order
 
 	fmt.Println("Building endpoint list")
 	toc := root.Find("#table-of-contents")
+	if toc.Length() == 0 {
+		return nil, diagnostics, parseErrorf("#table-of-contents", nil, "table of contents not found")
+	}
 
 	categoryLi := toc.Next()
 	for categoryLi.Length() != 0 {
@@ -75,38 +119,84 @@ func FindEndpoints(previousChecksum [32]byte) (api.Endpoints, api.AccessRecord,
 
 		a := categoryLi.Children().First() // get the <a>
 		if a.Length() == 0 {
-			return nil, accessRecord, fmt.Errorf("no child node under category LI: %#v", categoryLi)
+			return nil, diagnostics, parseErrorf("#table-of-contents", categoryLi, "no child node under category LI")
 		}
 		if !a.Is("a") {
-			return nil, accessRecord, fmt.Errorf("unexpected node type: %v", a)
+			return nil, diagnostics, parseErrorf("#table-of-contents", a, "unexpected node type")
 		}
 		linkName, isPresent := a.Attr("href")
 
 		if !isPresent {
-			return nil, accessRecord, fmt.Errorf("table of content link did not have href attribute: %v", a)
+			return nil, diagnostics, parseErrorf("#table-of-contents", a,
+				"table of content link did not have href attribute")
 		}
 
 		newCategory := api.Category{}
 		newCategory.LinkName = linkName
 		newCategory.ReadableName, newCategory.Desc, err = findCategoryDetails(linkName, root)
 		if err != nil {
-			return nil, accessRecord, fmt.Errorf("error extracting category details for %v: %w", linkName, err)
+			return nil, diagnostics, err
 		}
 
-		categoryEndpoints, err := findCategoryEndpoints(root, categoryLi)
-		if err != nil {
-			return nil, accessRecord, fmt.Errorf("error extracting endpoints for category %v: %w", linkName, err)
-		}
-		endpoints[newCategory] = categoryEndpoints
+		endpoints[newCategory] = findCategoryEndpoints(root, categoryLi, &diagnostics)
+		categories = append(categories, newCategory)
 
 		fmt.Printf("Category done: %v\n", newCategory.ReadableName)
 		categoryLi = categoryLi.Next()
 	}
 
-	accessRecord.Checksum = currentChecksum
-	accessRecord.Date = time.Now()
+	if len(endpoints) == 0 {
+		return nil, diagnostics, parseErrorf("#table-of-contents", toc, "no categories found after the table of contents")
+	}
+
+	mergeDuplicateEndpoints(categories, endpoints, &diagnostics)
+
+	return endpoints, diagnostics, nil
+}
 
-	return endpoints, accessRecord, nil
+// mergeDuplicateEndpoints keeps only the first of the endpoints documented under several categories with the same
+// function, such as CURRENCY_EXCHANGE_RATE under both Foreign Exchange and Digital & Crypto Currencies.  Parameters
+// only documented in a later listing are added to the first one.  Each duplicate is reported as a warning.
+func mergeDuplicateEndpoints(categories []api.Category, endpoints api.Endpoints, diagnostics *Diagnostics) {
+	type location struct {
+		category api.Category
+		index    int
+	}
+	firstListing := map[string]location{}
+
+	for _, category := range categories {
+		kept := make([]api.Endpoint, 0, len(endpoints[category]))
+		for _, endpoint := range endpoints[category] {
+			first, isDuplicate := firstListing[endpoint.Function]
+			if !isDuplicate {
+				firstListing[endpoint.Function] = location{category, len(kept)}
+				kept = append(kept, endpoint)
+				continue
+			}
+
+			// The first listing may be in this same category, which is still being filtered into kept.
+			original := &endpoints[first.category][first.index]
+			if first.category == category {
+				original = &kept[first.index]
+			}
+
+			var added []string
+			for _, param := range endpoint.Params {
+				if !hasParameter(original.Params, param.Name) {
+					original.Params = append(original.Params, param)
+					added = append(added, param.Name)
+				}
+			}
+
+			message := fmt.Sprintf("function %v is also documented at %v under %v; merged into %v",
+				endpoint.Function, endpoint.LinkName, category.ReadableName, original.LinkName)
+			if len(added) > 0 {
+				message += fmt.Sprintf(", adding parameters %v", strings.Join(added, ", "))
+			}
+			diagnostics.warn(endpoint.LinkName, message, nil)
+		}
+		endpoints[category] = kept
+	}
 }
 
 func findCategoryDetails(linkName string, root *goquery.Document) (readableName, desc string, err error) {
@@ -114,8 +204,7 @@ func findCategoryDetails(linkName string, root *goquery.Document) (readableName,
 	categoryHead := root.Find(selector).First()
 
 	if categoryHead.Length() != 1 {
-		return "", "",
-			fmt.Errorf("found unexpected DOM structure in categoryHead: %v", selector)
+		return "", "", parseErrorf(linkName, nil, "category heading %v not found", selector)
 	}
 
 	readableName = categoryHead.Text()
@@ -127,149 +216,192 @@ func findCategoryDetails(linkName string, root *goquery.Document) (readableName,
 	return readableName, desc, nil
 }
 
-func findCategoryEndpoints(root *goquery.Document, categoryLi *goquery.Selection) ([]api.Endpoint, error) {
+// findCategoryEndpoints parses every endpoint linked from the category's table of contents entry.  Endpoints that
+// cannot be parsed are recorded in diagnostics and left out.
+func findCategoryEndpoints(root *goquery.Document, categoryLi *goquery.Selection,
+	diagnostics *Diagnostics) []api.Endpoint {
+
 	endpoints := make([]api.Endpoint, 0)
 
 	endpointLinks := categoryLi.Children().Find("ul > li > a")
 	endpointLinks.Each(func(i int, link *goquery.Selection) {
-		endpoint := api.Endpoint{}
-		// A link should look like this:
-		// <a href="#fx-intraday">Intraday <span class="premium-label">Premium</span></a>
-
-		////////////////////////////////////////////////////////////////////////////////
-		// Get the contents of the link and determine if it's a Premium link or not
-		// innerHtml, err := link.Html()
-		linkName, isPresent := link.Attr("href")
-		if !isPresent || linkName == "" {
-			panic(fmt.Errorf("did not find an href in the %dth table of contents endpoint link in %v",
-				i, categoryLi.Text()))
-		}
-		endpoint.LinkName = linkName
-
-		////////////////////////////////////////////////////////////////////////////////
-		// Now find it in the main page body and extract the good stuff
-		endpointHead := root.Find("h4" + linkName)
-		if endpointHead.Length() != 1 {
-			linkHtml, _ := link.Html()
-			panic(fmt.Errorf("found %d endpoint heads for link name %v: %v",
-				endpointHead.Length(), linkName, linkHtml))
-		}
-
-		// An endpoint section looks like this:
-		// <h4 id="company-overview">Company Overview</h4>
-		// <p>This API returns the company information, financial ratios, and other key metrics for the equity specified. Data is generally refreshed on the same day a company reports its latest earnings and financials. </p>
-		// <br>
-		// <h6><b>API Parameters</b></h6>
-		// <p><b>❚ Required: <code>function</code></b></p>
-		// <p>The function of your choice. In this case, <code>function=OVERVIEW</code> </p>
-		// <p><b>❚ Required: <code>symbol</code></b></p>
-		// <p>The symbol of the token of your choice. For example: <code>symbol=IBM</code>.
-		// </p>
-		// <p><b>❚ Required: <code>apikey</code></b></p>
-		// <p>Your API key. Claim your free API key <a href="https://www.alphavantage.co/support/#api-key" target="_blank">here</a>. </p>
-		// <br>
-
-		// Is it premium?
-		endpoint.Premium = endpointHead.Find(".premium-label").Length() > 0
-
-		// Extract endpoint name
-		readableName, err := endpointHead.Html()
+		endpoint, err := findEndpoint(root, link, diagnostics)
 		if err != nil {
-			panic(fmt.Errorf("could not extract html from %v header", endpointHead.Text()))
+			diagnostics.skip(err)
+			return
 		}
+		endpoints = append(endpoints, endpoint)
+	})
 
-		spanStart := strings.Index(readableName, "<span")
-		if spanStart == -1 {
-			endpoint.ReadableName = html.UnescapeString(readableName)
-		} else {
-			readableName = html.UnescapeString(readableName[0:spanStart])
-			endpoint.ReadableName = readableName
-		}
+	return endpoints
+}
+
+func findEndpoint(root *goquery.Document, link *goquery.Selection, diagnostics *Diagnostics) (api.Endpoint, error) {
+	endpoint := api.Endpoint{}
+	// A link should look like this:
+	// <a href="#fx-intraday">Intraday <span class="premium-label">Premium</span></a>
 
-		// Extract endpoint description
-		descP := endpointHead
+	////////////////////////////////////////////////////////////////////////////////
+	// Get the contents of the link and determine if it's a Premium link or not
+	linkName, isPresent := link.Attr("href")
+	if !isPresent || linkName == "" {
+		return endpoint, parseErrorf("#table-of-contents", link, "did not find an href in the endpoint link")
+	}
+	endpoint.LinkName = linkName
+
+	////////////////////////////////////////////////////////////////////////////////
+	// Now find it in the main page body and extract the good stuff
+	endpointHead := root.Find("h4" + linkName)
+	if endpointHead.Length() != 1 {
+		return endpoint, parseErrorf(linkName, link, "found %d endpoint heads for this link",
+			endpointHead.Length())
+	}
+
+	// An endpoint section looks like this:
+	// <h4 id="company-overview">Company Overview</h4>
+	// <p>This API returns the company information, financial ratios, and other key metrics for the equity specified. Data is generally refreshed on the same day a company reports its latest earnings and financials. </p>
+	// <br>
+	// <h6><b>API Parameters</b></h6>
+	// <p><b>❚ Required: <code>function</code></b></p>
+	// <p>The function of your choice. In this case, <code>function=OVERVIEW</code> </p>
+	// <p><b>❚ Required: <code>symbol</code></b></p>
+	// <p>The symbol of the token of your choice. For example: <code>symbol=IBM</code>.
+	// </p>
+	// <p><b>❚ Required: <code>apikey</code></b></p>
+	// <p>Your API key. Claim your free API key <a href="https://www.alphavantage.co/support/#api-key" target="_blank">here</a>. </p>
+	// <br>
+
+	// Is it premium?
+	endpoint.Premium = endpointHead.Find(".premium-label").Length() > 0
+
+	// Extract endpoint name
+	readableName, err := endpointHead.Html()
+	if err != nil {
+		return endpoint, parseErrorf(linkName, endpointHead, "could not extract html from header: %w", err)
+	}
 
-		// Some endpoints have a <br> after the H4, but some go right to the <p>  Make sure you find the <p>
-		for goquery.NodeName(descP) != "p" {
-			descP = descP.Next()
+	spanStart := strings.Index(readableName, "<span")
+	if spanStart == -1 {
+		endpoint.ReadableName = html.UnescapeString(readableName)
+	} else {
+		readableName = html.UnescapeString(readableName[0:spanStart])
+		endpoint.ReadableName = readableName
+	}
+
+	// Extract endpoint description
+	descP := endpointHead
+
+	// Some endpoints have a <br> after the H4, but some go right to the <p>  Make sure you find the <p>
+	for goquery.NodeName(descP) != "p" {
+		descP = descP.Next()
+		if descP.Length() == 0 || descP.Is("h4, h2") {
+			return endpoint, parseErrorf(linkName, endpointHead, "no description paragraph after the heading")
 		}
+	}
 
-		var descBuffer bytes.Buffer
-		for goquery.NodeName(descP) != "h6" {
-			if goquery.NodeName(descP) == "p" {
-				endpointDesc, err := descP.Html()
-				if err != nil {
-					panic(fmt.Errorf("could not extract html from %v endpoint description tag", linkName))
-				}
-				_, err = fmt.Fprintf(&descBuffer, "%v\n", endpointDesc)
-				if err != nil {
-					panic(fmt.Errorf("could not write to endpoint description buffer: %w", err))
-				}
-				endpoint.Desc = strings.TrimSpace(descBuffer.String())
+	var descBuffer bytes.Buffer
+	for goquery.NodeName(descP) != "h6" {
+		if goquery.NodeName(descP) == "p" {
+			endpointDesc, err := descP.Html()
+			if err != nil {
+				return endpoint, parseErrorf(linkName, descP, "could not extract html from description: %w", err)
 			}
-			descP = descP.Next()
+			_, _ = fmt.Fprintf(&descBuffer, "%v\n", endpointDesc)
+			endpoint.Desc = strings.TrimSpace(descBuffer.String())
 		}
+		descP = descP.Next()
+		if descP.Length() == 0 || descP.Is("h4, h2") {
+			return endpoint, parseErrorf(linkName, endpointHead, "no API Parameters heading (h6) in the section")
+		}
+	}
 
-		// Extract parameters
-
-		// Get to `<p><b>❚ Required: <code>function</code></b></p>`
-		functionParamP := descP.Next()
+	// Extract parameters.  Every parameter block starts with a label paragraph, which is usually one of
+	// <p><b>❚ Required: <code>symbol</code></b></p> or <p>❚ Optional: <code>datatype</code></p>, followed by one or
+	// more description paragraphs.  Read every block up to the next section instead of stopping at apikey, which
+	// is normally the last one, so that nothing documented after it is lost.
+	var params []api.Parameter
+	for node := descP.Next(); node.Length() != 0 && !node.Is("h4, h2"); node = node.Next() {
+		if !isParameterLabel(node) {
+			continue
+		}
 
-		functionParam, err := readParameter(functionParamP, linkName)
+		blockParams, err := readParameters(node, linkName)
 		if err != nil {
-			panic(fmt.Errorf("could not extract function parameter for %v: %w", linkName, err))
-		}
-		if functionParam.Name != "function" || functionParam.Required == false {
-			panic(fmt.Errorf("incorrectly extracted function parameter for %v", linkName))
+			return endpoint, err
 		}
 
-		// Pull the actual function key out of the description, which looks like this:
-		// The API function of your choice. In this case, <code>function=SYMBOL_SEARCH</code>
-		functionDesc := functionParam.Desc
-		funcStart := strings.Index(functionDesc, "function=") + len("function=")
-		funcEnd := strings.Index(functionDesc, "</code>")
-
-		var functionName string
-		if funcStart < 0 || funcEnd < 0 || linkName == "latestPrice" {
-			// Special case: the documentation page has one endpoint that forgets to include the function.
-			// It's endpoint #latestprice, and the function name is `GLOBAL_QUOTE`
-			functionName = "GLOBAL_QUOTE"
-		} else {
-			functionName = functionDesc[funcStart:funcEnd]
-		}
-		endpoint.Function = functionName
-
-		// Scrape the rest of the parameters
-		param := functionParam
-		paramP := functionParamP.Next()
-		for param.Name != "apikey" { // apikey is always the last parameter
-			// Find the next parameter, starting with the Required/Optional label.  It can be an arbitrary number of
-			// elements down from here.
-
-			paramText := paramP.Text()
-			for strings.Contains(paramText, "Required") == false &&
-				strings.Contains(paramText, "Optional") == false {
-				paramP = paramP.Next()
-				paramText = paramP.Text()
+		for _, param := range blockParams {
+			if hasParameter(params, param.Name) {
+				diagnostics.warn(linkName, fmt.Sprintf("parameter %v is documented twice; keeping the first", param.Name),
+					node)
+				continue
 			}
+			params = append(params, param)
+		}
+	}
 
-			param, err = readParameter(paramP, linkName)
-			if err != nil {
-				panic(fmt.Errorf("could not scrape next parameter for %v: %w", linkName, err))
-			}
+	if len(params) == 0 || params[0].Name != "function" || !params[0].Required {
+		return endpoint, parseErrorf(linkName, descP.Next(), "expected the required function parameter first")
+	}
+	if !hasParameter(params, "apikey") {
+		diagnostics.warn(linkName, "no apikey parameter documented", nil)
+	}
 
-			paramP = paramP.Next()
-			endpoint.Params = append(endpoint.Params, *param)
+	// Pull the actual function key out of the description, which looks like this:
+	// The API function of your choice. In this case, <code>function=SYMBOL_SEARCH</code>
+	// Some sections forget it, but their example links have it: ...query?function=GLOBAL_QUOTE&symbol=IBM
+	endpoint.Function = functionName(params[0].Desc)
+	if endpoint.Function == "" {
+		endpointHead.NextUntil("h4, h2").EachWithBreak(func(i int, node *goquery.Selection) bool {
+			nodeHtml, _ := goquery.OuterHtml(node)
+			endpoint.Function = functionName(nodeHtml)
+			return endpoint.Function == ""
+		})
+		if endpoint.Function == "" {
+			return endpoint, parseErrorf(linkName, descP.Next(), "no function name in the section")
 		}
+		diagnostics.warn(linkName, fmt.Sprintf(
+			"function name missing from the function parameter; took %v from the examples", endpoint.Function), nil)
+	}
 
-		endpoints = append(endpoints, endpoint)
-	})
+	// The function parameter is filled in from the endpoint's Function by the generated code.
+	endpoint.Params = params[1:]
 
-	return endpoints, nil
+	return endpoint, nil
 }
 
-func readParameter(paramNode *goquery.Selection, linkName string) (*api.Parameter, error) {
-	// paramNode should have this structure:
+var functionPattern = regexp.MustCompile(`function=([A-Z0-9_]+)`)
+
+// functionName returns the first function=NAME in text, or "" if there is none.
+func functionName(text string) string {
+	match := functionPattern.FindStringSubmatch(text)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// isParameterLabel reports whether node is the label paragraph that starts a parameter block.
+func isParameterLabel(node *goquery.Selection) bool {
+	text := strings.TrimPrefix(strings.TrimSpace(node.Text()), "❚")
+	text = strings.TrimSpace(text)
+	return goquery.NodeName(node) == "p" &&
+		(strings.HasPrefix(text, "Required:") || strings.HasPrefix(text, "Optional:"))
+}
+
+func hasParameter(params []api.Parameter, name string) bool {
+	for _, param := range params {
+		if param.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// readParameters reads the parameter block starting at labelNode.  A label usually names one parameter, but can
+// name several that share a description, as in <p>❚ Optional: <code>time_from</code> and <code>time_to</code></p>.
+func readParameters(labelNode *goquery.Selection, linkName string) ([]api.Parameter, error) {
+	// labelNode should have this structure:
 	// <p><b>❚ Required: <code>from_symbol</code></b></p>
 	// <p>DESCRIPTION HERE</p>
 	//
@@ -278,60 +410,52 @@ func readParameter(paramNode *goquery.Selection, linkName string) (*api.Paramete
 	// <p>❚ Optional: <code>NATURAL_GAS</code></p>    <-- Notice lack of <b> tags around Optional
 	// <p>DESCRIPTION HERE</p>
 
-	param := api.Parameter{}
-
-	// Figure out if this parameter is required or not
-
-	paramNameText := paramNode.Text()
-	paramNameHtml, err := paramNode.Html()
-	if err != nil {
-		return nil, fmt.Errorf("Could not get param name HTML for %v: %w\n\"\"\"%v\"\"\"",
-			linkName, err, paramNameText)
-	}
-
-	if strings.Contains(paramNameHtml, "Required") {
-		param.Required = true
-	} else if strings.Contains(paramNameHtml, "Optional") {
-		param.Required = false
-	} else {
-		return nil, fmt.Errorf("could not determine required/optional for %v\n\"\"\"\n%v\n\"\"\"",
-			linkName, paramNameHtml)
-	}
+	// Figure out if the parameters are required or not
+	labelText := labelNode.Text()
+	required := strings.Contains(labelText, "Required")
 
-	// Get the name
-	nameNode := paramNode.Find("code").First()
-	if nameNode.Length() != 1 {
-		return nil, fmt.Errorf("could not determine parameter name for %v", linkName)
+	// Get the names
+	var names []string
+	labelNode.Find("code").Each(func(i int, nameNode *goquery.Selection) {
+		names = append(names, strings.TrimSpace(nameNode.Text()))
+	})
+	if len(names) == 0 || names[0] == "" {
+		return nil, parseErrorf(linkName, labelNode, "could not determine parameter name")
 	}
-	param.Name = nameNode.Text()
 
-	// Get the description, which can be one or more
-	descP := paramNode.Next()
-	descText := descP.Text()
+	// Get the description, which can be one or more paragraphs
 	var descBuffer bytes.Buffer
-	for strings.Contains(descText, "Required") == false &&
-		strings.Contains(descText, "Optional") == false &&
-		goquery.NodeName(descP) != "br" {
+	for descP := labelNode.Next(); descP.Length() != 0 && !isParameterLabel(descP) &&
+		!descP.Is("br, h2, h4, h6"); descP = descP.Next() {
 
 		descHtml, err := descP.Html()
 		if err != nil {
-			panic(fmt.Errorf("could not extract the parameter description for %v param %v: %w",
-				linkName, param.Name, err))
+			return nil, parseErrorf(linkName, descP, "could not extract the description of parameter %v: %w",
+				names[0], err)
 		}
 
-		_, err = fmt.Fprintf(&descBuffer, "%v\n", descHtml)
-		descP = descP.Next()
-		descText = descP.Text()
+		_, _ = fmt.Fprintf(&descBuffer, "%v\n", descHtml)
 	}
-	param.Desc = strings.TrimSpace(descBuffer.String())
 
-	return &param, nil
+	var params []api.Parameter
+	for _, name := range names {
+		param := api.Parameter{
+			Required: required,
+			Name:     name,
+			Desc:     strings.TrimSpace(descBuffer.String()),
+		}
+		InferParameter(&param)
+		params = append(params, param)
+	}
+
+	return params, nil
 }
 
-// Cloudflare includes several one-time values throughout the document for various protections.  They do not affect
-// the content we need, but mean that every page download is different, and therefore we can't simply checksum the
-// entire page.  removeCloudflareStuff deletes these one-time values, leaving the rest of the document intact.
-func removeCloudflareStuff(documentBytes []byte) []byte {
+// Cloudflare includes several one-time values throughout the document for various protections.  Most do not affect
+// the content we need, but one sits in the description of the news endpoint's limit parameter and would change the
+// spec on every download.  removeCloudflareStuff deletes these one-time values, leaving the rest of the document
+// intact.  A value that cannot be found is left alone and reported as a warning in diagnostics.
+func removeCloudflareStuff(documentBytes []byte, diagnostics *Diagnostics) []byte {
 	// The three known issues are:
 	// * a `data-cfemail="<base64 number>"` tag in the "Market News & Sentiment" endpoint `limit` parameter
 	// * the ContactUs e-mail href in the page footer
@@ -344,43 +468,62 @@ func removeCloudflareStuff(documentBytes []byte) []byte {
 
 	cfemailTag := []byte("data-cfemail=")
 	cfemailIndex := bytes.Index(documentBytes, cfemailTag)
+	closingBracketIndex := -1
+	if cfemailIndex > 0 {
+		closingBracketIndex = bytes.IndexByte(documentBytes[cfemailIndex:], '>')
+	}
 
-	// Dump the entire document up to this point in a new document buffer and clear it from the remainder
-	newDocumentBuffer.Write(documentBytes[:cfemailIndex-1])
-	documentBytes = documentBytes[cfemailIndex:]
+	if closingBracketIndex < 0 {
+		diagnostics.warn("cloudflare", "no data-cfemail attribute found", nil)
+	} else {
+		// Dump the entire document up to this point in a new document buffer and clear it from the remainder
+		newDocumentBuffer.Write(documentBytes[:cfemailIndex-1])
+		documentBytes = documentBytes[cfemailIndex:]
 
-	// The start of the remaining document looks like this:
-	// data-cfemail="b3c0c6c3c3dcc1c7f3d2dfc3dbd2c5d2ddc7d2d4d69dd0dc">[email&#160;protected]</a>
+		// The start of the remaining document looks like this:
+		// data-cfemail="b3c0c6c3c3dcc1c7f3d2dfc3dbd2c5d2ddc7d2d4d69dd0dc">[email&#160;protected]</a>
 
-	// Ignore everything up to the second ", but leave the ">[email..." in tact to close the tag.
-	closingBracketIndex := bytes.Index(documentBytes, []byte{'>'})
-	documentBytes = documentBytes[closingBracketIndex:] // SKIP
+		// Ignore everything up to the second ", but leave the ">[email..." in tact to close the tag.
+		documentBytes = documentBytes[closingBracketIndex:] // SKIP
+	}
 
 	// Next, find and cleanse the Contact Us link from the footer:
 	// <a href="/cdn-cgi/l/email-protection#295a5c5959465b5d6948455941485f48475d484e4c074a46">Contact us</a>
 
 	contactUsTag := []byte("<a href=\"/cdn-cgi/l/email-protection#")
 	contactUsIndex := bytes.Index(documentBytes, contactUsTag)
+	linkEndIndex := -1
+	if contactUsIndex >= 0 {
+		linkEndIndex = bytes.Index(documentBytes[contactUsIndex:], []byte("</li>"))
+	}
 
-	// Write everything from the end of the last problem to the start of this problem
-	newDocumentBuffer.Write(documentBytes[:contactUsIndex])
-	documentBytes = documentBytes[contactUsIndex:]
+	if linkEndIndex < 0 {
+		diagnostics.warn("cloudflare", "no e-mail protected Contact Us link found", nil)
+	} else {
+		// Write everything from the end of the last problem to the start of this problem
+		newDocumentBuffer.Write(documentBytes[:contactUsIndex])
+		documentBytes = documentBytes[contactUsIndex:]
 
-	contactUsText := []byte("Contact us")
-	newDocumentBuffer.Write(contactUsText)
+		contactUsText := []byte("Contact us")
+		newDocumentBuffer.Write(contactUsText)
 
-	linkEndIndex := bytes.Index(documentBytes, []byte("</li>"))
-	documentBytes = documentBytes[linkEndIndex:]
+		documentBytes = documentBytes[linkEndIndex:]
+	}
 
 	// The last thing in the document is a line with two script tag which we can completely ignore.
 	scriptStart := []byte("<script ")
 	scriptStartIndex := bytes.Index(documentBytes, scriptStart)
-	newDocumentBuffer.Write(documentBytes[:scriptStartIndex-1])
-	documentBytes = documentBytes[scriptStartIndex:]
+	scriptEndIndex := -1
+	if scriptStartIndex > 0 {
+		scriptEndIndex = bytes.IndexByte(documentBytes[scriptStartIndex:], '\n')
+	}
 
-	scriptEnd := []byte("\n")
-	scriptEndIndex := bytes.Index(documentBytes, scriptEnd) + len(scriptEnd)
-	documentBytes = documentBytes[scriptEndIndex:]
+	if scriptEndIndex < 0 {
+		diagnostics.warn("cloudflare", "no trailing script line found", nil)
+	} else {
+		newDocumentBuffer.Write(documentBytes[:scriptStartIndex-1])
+		documentBytes = documentBytes[scriptStartIndex+scriptEndIndex+1:]
+	}
 
 	// Whew....
 	newDocumentBuffer.Write(documentBytes)
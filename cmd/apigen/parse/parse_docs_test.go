@@ -0,0 +1,223 @@
+package parse
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
+)
+
+func TestParseDocumentAnomalies(t *testing.T) {
+	page, err := os.ReadFile(filepath.Join("testdata", "anomalies.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endpoints, diagnostics, err := ParseDocument(page)
+	if err != nil {
+		t.Fatalf("could not parse documentation fixture: %v", err)
+	}
+
+	functions := map[string][]api.Endpoint{}
+	for category, categoryEndpoints := range endpoints {
+		for _, endpoint := range categoryEndpoints {
+			functions[endpoint.Function] = append(functions[endpoint.Function], endpoint)
+		}
+		if category.LinkName == "#digital-currency" && len(categoryEndpoints) != 0 {
+			t.Errorf("duplicate endpoint was not merged out of %v: %v", category.LinkName, categoryEndpoints)
+		}
+	}
+
+	tests := []struct {
+		function string
+		linkName string
+		params   []string
+	}{
+		// Listed under two categories; the second listing documents datatype too.
+		{"CURRENCY_EXCHANGE_RATE", "#currency-exchange", []string{"from_currency", "to_currency", "apikey", "datatype"}},
+		// One label names both time_from and time_to, and sort is documented twice.
+		{"NEWS_SENTIMENT", "#news-sentiment", []string{"time_from", "time_to", "sort", "apikey"}},
+		// The function parameter does not name the function; the example link does.
+		{"GLOBAL_QUOTE", "#latestprice", []string{"symbol", "apikey"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.function, func(t *testing.T) {
+			found := functions[test.function]
+			if len(found) != 1 {
+				t.Fatalf("found %d endpoints for %v, want 1", len(found), test.function)
+			}
+			endpoint := found[0]
+
+			if endpoint.LinkName != test.linkName {
+				t.Errorf("link name = %v, want %v", endpoint.LinkName, test.linkName)
+			}
+			var names []string
+			for _, param := range endpoint.Params {
+				names = append(names, param.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(test.params, ",") {
+				t.Errorf("params = %v, want %v", names, test.params)
+			}
+		})
+	}
+
+	wantWarnings := []struct {
+		location string
+		message  string
+	}{
+		{"#news-sentiment", "parameter sort is documented twice"},
+		{"#latestprice", "took GLOBAL_QUOTE from the examples"},
+		{"#crypto-exchange", "merged into #currency-exchange, adding parameters datatype"},
+	}
+
+	if len(diagnostics) != len(wantWarnings) {
+		t.Errorf("got %d diagnostics, want %d:\n%v", len(diagnostics), len(wantWarnings), diagnostics)
+	}
+	for _, want := range wantWarnings {
+		found := false
+		for _, diagnostic := range diagnostics {
+			if diagnostic.Location == want.location && strings.Contains(diagnostic.Message, want.message) &&
+				!diagnostic.Skipped {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("no warning at %v containing %q in:\n%v", want.location, want.message, diagnostics)
+		}
+	}
+}
+
+func TestParseDocumentTimeToShared(t *testing.T) {
+	page, err := os.ReadFile(filepath.Join("testdata", "anomalies.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endpoints, _, err := ParseDocument(page)
+	if err != nil {
+		t.Fatalf("could not parse documentation fixture: %v", err)
+	}
+
+	for _, categoryEndpoints := range endpoints {
+		for _, endpoint := range categoryEndpoints {
+			if endpoint.Function != "NEWS_SENTIMENT" {
+				continue
+			}
+			timeFrom, timeTo := endpoint.Params[0], endpoint.Params[1]
+			if timeTo.Desc != timeFrom.Desc {
+				t.Errorf("time_to description = %q, want the shared %q", timeTo.Desc, timeFrom.Desc)
+			}
+			if timeTo.Type != TypeDateTime || timeTo.Format != "YYYYMMDDTHHMM" || len(timeTo.Examples) != 0 {
+				t.Errorf("time_to inferred as %v %q with examples %v, want a %v without examples",
+					timeTo.Type, timeTo.Format, timeTo.Examples, TypeDateTime)
+			}
+			return
+		}
+	}
+	t.Fatal("NEWS_SENTIMENT not found")
+}
+
+func TestFetchDocumentation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/documentation/" {
+			http.Error(w, "<html>Attention Required! | Cloudflare</html>", http.StatusForbidden)
+			return
+		}
+		_, _ = io.WriteString(w, "<html>documentation</html>")
+	}))
+	defer server.Close()
+
+	page, err := fetchDocumentation(server.URL + "/documentation/")
+	if err != nil || string(page) != "<html>documentation</html>" {
+		t.Errorf("fetchDocumentation() = %q, %v", page, err)
+	}
+
+	page, err = fetchDocumentation(server.URL + "/challenge")
+	if err == nil || !strings.Contains(err.Error(), "403 Forbidden") || page != nil {
+		t.Errorf("fetchDocumentation() of an error page = %q, %v, want a 403 error", page, err)
+	}
+}
+
+func TestReadDocumentation(t *testing.T) {
+	stdin, err := os.CreateTemp(t.TempDir(), "stdin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = io.WriteString(stdin, "<html>from stdin</html>"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = stdin.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	defer func(original *os.File) { os.Stdin = original }(os.Stdin)
+	os.Stdin = stdin
+
+	page, err := ReadDocumentation("-")
+	if err != nil || string(page) != "<html>from stdin</html>" {
+		t.Errorf("ReadDocumentation(-) = %q, %v", page, err)
+	}
+
+	path := filepath.Join(t.TempDir(), "documentation.html")
+	if err = os.WriteFile(path, []byte("<html>from a file</html>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	page, err = ReadDocumentation(path)
+	if err != nil || string(page) != "<html>from a file</html>" {
+		t.Errorf("ReadDocumentation(%v) = %q, %v", path, page, err)
+	}
+
+	_, err = ReadDocumentation(filepath.Join(t.TempDir(), "missing.html"))
+	if !errors.Is(err, fs.ErrNotExist) || !strings.Contains(err.Error(), "could not read documentation file") {
+		t.Errorf("ReadDocumentation of a missing file error = %v, want fs.ErrNotExist", err)
+	}
+}
+
+func TestParseDocumentBrokenEndpoint(t *testing.T) {
+	page, err := os.ReadFile(filepath.Join("testdata", "broken_endpoint.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endpoints, diagnostics, err := ParseDocument(page)
+	if err != nil {
+		t.Fatalf("a broken endpoint stopped the page from being parsed: %v", err)
+	}
+
+	var functions []string
+	for _, categoryEndpoints := range endpoints {
+		for _, endpoint := range categoryEndpoints {
+			functions = append(functions, endpoint.Function)
+		}
+	}
+	if strings.Join(functions, ",") != "OVERVIEW" {
+		t.Errorf("parsed endpoints %v, want only OVERVIEW", functions)
+	}
+
+	wantSkipped := map[string]string{
+		"#earnings":       "no API Parameters heading (h6) in the section",
+		"#listing-status": "expected the required function parameter first",
+	}
+	if diagnostics.Skipped() != len(wantSkipped) || len(diagnostics) != len(wantSkipped) {
+		t.Errorf("got %d diagnostics, %d skipped, want %d skipped:\n%v", len(diagnostics), diagnostics.Skipped(),
+			len(wantSkipped), diagnostics)
+	}
+	for _, diagnostic := range diagnostics {
+		if want, ok := wantSkipped[diagnostic.Location]; !ok || !diagnostic.Skipped || diagnostic.Message != want {
+			t.Errorf("diagnostic %v, want a skipped endpoint with message %q", diagnostic, want)
+		}
+		if diagnostic.Snippet == "" {
+			t.Errorf("diagnostic for %v quotes no HTML", diagnostic.Location)
+		}
+	}
+	if report := diagnostics.String(); !strings.Contains(report, "SKIPPED #earnings: no API Parameters heading") {
+		t.Errorf("report does not name the skipped endpoint:\n%v", report)
+	}
+}
@@ -0,0 +1,215 @@
+package spec
+
+import (
+	"fmt"
+	"golang.org/x/exp/slices"
+	"strings"
+)
+
+// ChangeKind classifies one difference between two specs.
+type ChangeKind string
+
+const (
+	EndpointAdded           ChangeKind = "endpoint-added"
+	EndpointRemoved         ChangeKind = "endpoint-removed"
+	BecamePremium           ChangeKind = "became-premium"
+	NoLongerPremium         ChangeKind = "no-longer-premium"
+	DescriptionChanged      ChangeKind = "description-changed"
+	ParamAdded              ChangeKind = "param-added"
+	ParamRemoved            ChangeKind = "param-removed"
+	ParamNowRequired        ChangeKind = "param-now-required"
+	ParamNowOptional        ChangeKind = "param-now-optional"
+	ParamDescriptionChanged ChangeKind = "param-description-changed"
+	ParamValuesChanged      ChangeKind = "param-values-changed"
+)
+
+// Change is one difference between two specs.  Endpoints are identified by their function name, since that is
+// what the generated code and every request depend on.
+type Change struct {
+	Kind     ChangeKind `json:"kind"`
+	Function string     `json:"function"`
+	Param    string     `json:"param,omitempty"`
+	Old      string     `json:"old,omitempty"`
+	New      string     `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case EndpointAdded:
+		return fmt.Sprintf("+ %v: new endpoint %q", c.Function, c.New)
+	case EndpointRemoved:
+		return fmt.Sprintf("- %v: endpoint removed (was %q)", c.Function, c.Old)
+	case BecamePremium:
+		return fmt.Sprintf("! %v: now requires a premium key", c.Function)
+	case NoLongerPremium:
+		return fmt.Sprintf("! %v: no longer requires a premium key", c.Function)
+	case DescriptionChanged:
+		return fmt.Sprintf("~ %v: description changed\n    old: %v\n    new: %v", c.Function, c.Old, c.New)
+	case ParamAdded:
+		return fmt.Sprintf("+ %v: new %v parameter %v", c.Function, c.New, c.Param)
+	case ParamRemoved:
+		return fmt.Sprintf("- %v: %v parameter %v removed", c.Function, c.Old, c.Param)
+	case ParamNowRequired:
+		return fmt.Sprintf("! %v: parameter %v is now required", c.Function, c.Param)
+	case ParamNowOptional:
+		return fmt.Sprintf("~ %v: parameter %v is now optional", c.Function, c.Param)
+	case ParamDescriptionChanged:
+		return fmt.Sprintf("~ %v: description of %v changed\n    old: %v\n    new: %v",
+			c.Function, c.Param, c.Old, c.New)
+	case ParamValuesChanged:
+		return fmt.Sprintf("! %v: accepted values of %v changed\n    old: %v\n    new: %v",
+			c.Function, c.Param, c.Old, c.New)
+	}
+	return fmt.Sprintf("? %v %v %v: %q -> %q", c.Kind, c.Function, c.Param, c.Old, c.New)
+}
+
+// Report lists every change between two specs, in the order of the newer spec's endpoints with removals last.
+type Report struct {
+	Old     string   `json:"old"` // Scrape dates of the compared specs
+	New     string   `json:"new"`
+	Changes []Change `json:"changes"`
+}
+
+func (r Report) String() string {
+	var builder strings.Builder
+
+	_, _ = fmt.Fprintf(&builder, "API changes from %v to %v:\n", r.Old, r.New)
+	if len(r.Changes) == 0 {
+		builder.WriteString("No changes\n")
+	}
+	for _, change := range r.Changes {
+		_, _ = fmt.Fprintf(&builder, "%v\n", change)
+	}
+
+	return builder.String()
+}
+
+// Compare reports the differences between two specs.
+func Compare(older, newer Spec) Report {
+	report := Report{
+		Old:     older.Scraped.Format("2006-01-02 15:04:05"),
+		New:     newer.Scraped.Format("2006-01-02 15:04:05"),
+		Changes: []Change{},
+	}
+
+	oldEndpoints := older.endpointsByFunction()
+	newEndpoints := newer.endpointsByFunction()
+
+	for _, newEndpoint := range newer.allEndpoints() {
+		oldEndpoint, existed := oldEndpoints[newEndpoint.Function]
+		if !existed {
+			report.Changes = append(report.Changes, Change{
+				Kind: EndpointAdded, Function: newEndpoint.Function, New: newEndpoint.Name,
+			})
+			continue
+		}
+		report.Changes = append(report.Changes, compareEndpoints(oldEndpoint, newEndpoint)...)
+	}
+
+	for _, oldEndpoint := range older.allEndpoints() {
+		if _, exists := newEndpoints[oldEndpoint.Function]; !exists {
+			report.Changes = append(report.Changes, Change{
+				Kind: EndpointRemoved, Function: oldEndpoint.Function, Old: oldEndpoint.Name,
+			})
+		}
+	}
+
+	return report
+}
+
+func compareEndpoints(older, newer Endpoint) []Change {
+	var changes []Change
+	function := newer.Function
+
+	if !older.Premium && newer.Premium {
+		changes = append(changes, Change{Kind: BecamePremium, Function: function})
+	} else if older.Premium && !newer.Premium {
+		changes = append(changes, Change{Kind: NoLongerPremium, Function: function})
+	}
+
+	if older.Description != newer.Description {
+		changes = append(changes, Change{
+			Kind: DescriptionChanged, Function: function, Old: older.Description, New: newer.Description,
+		})
+	}
+
+	oldParams := map[string]Parameter{}
+	for _, param := range older.Params {
+		oldParams[param.Name] = param
+	}
+	newParams := map[string]Parameter{}
+	for _, param := range newer.Params {
+		newParams[param.Name] = param
+	}
+
+	for _, newParam := range newer.Params {
+		oldParam, existed := oldParams[newParam.Name]
+		switch {
+		case !existed:
+			changes = append(changes, Change{
+				Kind: ParamAdded, Function: function, Param: newParam.Name, New: requiredness(newParam),
+			})
+			continue
+		case !oldParam.Required && newParam.Required:
+			changes = append(changes, Change{Kind: ParamNowRequired, Function: function, Param: newParam.Name})
+		case oldParam.Required && !newParam.Required:
+			changes = append(changes, Change{Kind: ParamNowOptional, Function: function, Param: newParam.Name})
+		}
+
+		if oldParam.Description != newParam.Description {
+			changes = append(changes, Change{
+				Kind: ParamDescriptionChanged, Function: function, Param: newParam.Name,
+				Old: oldParam.Description, New: newParam.Description,
+			})
+		}
+		if !slices.Equal(oldParam.Enum, newParam.Enum) {
+			changes = append(changes, Change{
+				Kind: ParamValuesChanged, Function: function, Param: newParam.Name,
+				Old: strings.Join(oldParam.Enum, ", "), New: strings.Join(newParam.Enum, ", "),
+			})
+		}
+	}
+
+	for _, oldParam := range older.Params {
+		if _, exists := newParams[oldParam.Name]; !exists {
+			changes = append(changes, Change{
+				Kind: ParamRemoved, Function: function, Param: oldParam.Name, Old: requiredness(oldParam),
+			})
+		}
+	}
+
+	return changes
+}
+
+func requiredness(param Parameter) string {
+	if param.Required {
+		return "required"
+	}
+	return "optional"
+}
+
+// allEndpoints returns every endpoint in category order.  An endpoint listed under several categories is only
+// returned the first time.
+func (s Spec) allEndpoints() []Endpoint {
+	var endpoints []Endpoint
+	seen := map[string]bool{}
+
+	for _, category := range s.Categories {
+		for _, endpoint := range category.Endpoints {
+			if !seen[endpoint.Function] {
+				seen[endpoint.Function] = true
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+	}
+
+	return endpoints
+}
+
+func (s Spec) endpointsByFunction() map[string]Endpoint {
+	endpoints := map[string]Endpoint{}
+	for _, endpoint := range s.allEndpoints() {
+		endpoints[endpoint.Function] = endpoint
+	}
+	return endpoints
+}
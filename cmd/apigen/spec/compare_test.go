@@ -0,0 +1,114 @@
+package spec
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompare(t *testing.T) {
+	quote := Endpoint{Function: "GLOBAL_QUOTE", Name: "Quote Endpoint", Description: "A quote.", Params: []Parameter{
+		{Name: "symbol", Required: true, Description: "The symbol."},
+		{Name: "datatype", Description: "json or csv.", Enum: []string{"json", "csv"}},
+	}}
+	overview := Endpoint{Function: "OVERVIEW", Name: "Company Overview", Params: []Parameter{
+		{Name: "symbol", Required: true, Description: "The symbol."},
+	}}
+	older := Spec{
+		Scraped: time.Date(2023, 5, 22, 8, 0, 0, 0, time.UTC),
+		Categories: []Category{
+			{LinkName: "#time-series-data", Endpoints: []Endpoint{quote}},
+			{LinkName: "#fundamentals", Endpoints: []Endpoint{overview}},
+		},
+	}
+
+	with := func(endpoints ...Endpoint) Spec {
+		return Spec{
+			Scraped:    time.Date(2023, 5, 23, 8, 0, 0, 0, time.UTC),
+			Categories: []Category{{LinkName: "#time-series-data", Endpoints: endpoints}},
+		}
+	}
+	changed := func(change func(endpoint *Endpoint)) Endpoint {
+		endpoint := quote
+		endpoint.Params = append([]Parameter(nil), quote.Params...)
+		change(&endpoint)
+		return endpoint
+	}
+
+	tests := []struct {
+		name  string
+		newer Spec
+		want  []Change
+	}{
+		{"unchanged", older, []Change{}},
+		{"endpoint moved to another category", with(quote, overview), []Change{}},
+		{"endpoint added", with(quote, overview, Endpoint{Function: "LISTING_STATUS", Name: "Listing & Delisting"}),
+			[]Change{{Kind: EndpointAdded, Function: "LISTING_STATUS", New: "Listing & Delisting"}}},
+		{"endpoint removed", with(quote), []Change{{Kind: EndpointRemoved, Function: "OVERVIEW",
+			Old: "Company Overview"}}},
+		{"endpoint premium", with(changed(func(e *Endpoint) { e.Premium = true }), overview),
+			[]Change{{Kind: BecamePremium, Function: "GLOBAL_QUOTE"}}},
+		{"endpoint description", with(changed(func(e *Endpoint) { e.Description = "A realtime quote." }), overview),
+			[]Change{{Kind: DescriptionChanged, Function: "GLOBAL_QUOTE", Old: "A quote.", New: "A realtime quote."}}},
+		{"param now required", with(changed(func(e *Endpoint) { e.Params[1].Required = true }), overview),
+			[]Change{{Kind: ParamNowRequired, Function: "GLOBAL_QUOTE", Param: "datatype"}}},
+		{"param now optional", with(changed(func(e *Endpoint) { e.Params[0].Required = false }), overview),
+			[]Change{{Kind: ParamNowOptional, Function: "GLOBAL_QUOTE", Param: "symbol"}}},
+		{"param added", with(changed(func(e *Endpoint) {
+			e.Params = append(e.Params, Parameter{Name: "entitlement"})
+		}), overview), []Change{{Kind: ParamAdded, Function: "GLOBAL_QUOTE", Param: "entitlement", New: "optional"}}},
+		{"param removed", with(changed(func(e *Endpoint) { e.Params = e.Params[1:] }), overview),
+			[]Change{{Kind: ParamRemoved, Function: "GLOBAL_QUOTE", Param: "symbol", Old: "required"}}},
+		{"param enum", with(changed(func(e *Endpoint) {
+			e.Params[1] = Parameter{Name: "datatype", Description: "json or csv.", Enum: []string{"json", "csv", "xml"}}
+		}), overview), []Change{{Kind: ParamValuesChanged, Function: "GLOBAL_QUOTE", Param: "datatype",
+			Old: "json, csv", New: "json, csv, xml"}}},
+		{"several changes", with(changed(func(e *Endpoint) {
+			e.Params[0] = Parameter{Name: "symbol", Required: true, Description: "The ticker."}
+			e.Params[1] = Parameter{Name: "datatype", Required: true, Description: "json or csv.",
+				Enum: []string{"json"}}
+		})), []Change{
+			{Kind: ParamDescriptionChanged, Function: "GLOBAL_QUOTE", Param: "symbol", Old: "The symbol.",
+				New: "The ticker."},
+			{Kind: ParamNowRequired, Function: "GLOBAL_QUOTE", Param: "datatype"},
+			{Kind: ParamValuesChanged, Function: "GLOBAL_QUOTE", Param: "datatype", Old: "json, csv", New: "json"},
+			{Kind: EndpointRemoved, Function: "OVERVIEW", Old: "Company Overview"},
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report := Compare(older, test.newer)
+			if !reflect.DeepEqual(report.Changes, test.want) {
+				t.Errorf("changes = %+v, want %+v", report.Changes, test.want)
+			}
+			if report.Old != "2023-05-22 08:00:00" {
+				t.Errorf("Old = %q", report.Old)
+			}
+		})
+	}
+}
+
+func TestReportString(t *testing.T) {
+	report := Report{Old: "2023-05-22 08:00:00", New: "2023-05-23 08:00:00", Changes: []Change{
+		{Kind: EndpointAdded, Function: "LISTING_STATUS", New: "Listing & Delisting"},
+		{Kind: ParamNowRequired, Function: "GLOBAL_QUOTE", Param: "datatype"},
+		{Kind: ParamValuesChanged, Function: "GLOBAL_QUOTE", Param: "datatype", Old: "json, csv", New: "json"},
+	}}
+	want := `API changes from 2023-05-22 08:00:00 to 2023-05-23 08:00:00:
++ LISTING_STATUS: new endpoint "Listing & Delisting"
+! GLOBAL_QUOTE: parameter datatype is now required
+! GLOBAL_QUOTE: accepted values of datatype changed
+    old: json, csv
+    new: json
+`
+	if got := report.String(); got != want {
+		t.Errorf("String() =\n%v\nwant\n%v", got, want)
+	}
+
+	report.Changes = nil
+	if got := report.String(); !strings.HasSuffix(got, "No changes\n") {
+		t.Errorf("String() of no changes = %q", got)
+	}
+}
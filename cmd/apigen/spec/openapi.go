@@ -0,0 +1,187 @@
+package spec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The types below cover the subset of OpenAPI 3.0 needed to describe the /query endpoint.
+
+type openApi struct {
+	OpenApi    string                     `json:"openapi"`
+	Info       openApiInfo                `json:"info"`
+	Servers    []openApiServer            `json:"servers"`
+	Tags       []openApiTag               `json:"tags"`
+	Paths      map[string]openApiPathItem `json:"paths"`
+	Components openApiComponents          `json:"components"`
+	Security   []map[string][]string      `json:"security"`
+}
+
+type openApiInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+type openApiServer struct {
+	Url string `json:"url"`
+}
+
+type openApiTag struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type openApiPathItem struct {
+	Get openApiOperation `json:"get"`
+}
+
+type openApiOperation struct {
+	OperationId string                     `json:"operationId"`
+	Summary     string                     `json:"summary"`
+	Description string                     `json:"description"`
+	Tags        []string                   `json:"tags"`
+	Parameters  []openApiParameter         `json:"parameters"`
+	Responses   map[string]openApiResponse `json:"responses"`
+	Premium     bool                       `json:"x-premium"`
+	DocsUrl     string                     `json:"x-documentation"`
+}
+
+type openApiParameter struct {
+	Name        string        `json:"name"`
+	In          string        `json:"in"`
+	Required    bool          `json:"required"`
+	Description string        `json:"description"`
+	Schema      openApiSchema `json:"schema"`
+}
+
+type openApiSchema struct {
+	Type    string   `json:"type"`
+	Format  string   `json:"format,omitempty"`
+	Pattern string   `json:"pattern,omitempty"`
+	Enum    []string `json:"enum,omitempty"`
+	Default string   `json:"default,omitempty"`
+}
+
+type openApiResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]openApiMediaType `json:"content"`
+}
+
+type openApiMediaType struct {
+	Schema openApiSchema `json:"schema"`
+}
+
+type openApiComponents struct {
+	SecuritySchemes map[string]openApiSecurityScheme `json:"securitySchemes"`
+}
+
+type openApiSecurityScheme struct {
+	Type string `json:"type"`
+	In   string `json:"in"`
+	Name string `json:"name"`
+}
+
+// WriteOpenApi saves an OpenAPI 3 document describing every endpoint in the spec.
+//
+// Every Alpha Vantage endpoint is the same /query path, distinguished only by its function parameter, which OpenAPI
+// cannot express.  Each endpoint is therefore given its own "/query?function=NAME" path, a convention most OpenAPI
+// tooling accepts.
+func (s Spec) WriteOpenApi(path string) error {
+	document := openApi{
+		OpenApi: "3.0.3",
+		Info: openApiInfo{
+			Title:       "Alpha Vantage",
+			Description: "Generated by apigen from " + s.Source,
+			Version:     fmt.Sprintf("%d.%v", s.SpecVersion, s.Scraped.Format("20060102")),
+		},
+		Servers: []openApiServer{{Url: "https://www.alphavantage.co"}},
+		Paths:   map[string]openApiPathItem{},
+		Components: openApiComponents{
+			SecuritySchemes: map[string]openApiSecurityScheme{
+				"apikey": {Type: "apiKey", In: "query", Name: "apikey"},
+			},
+		},
+		Security: []map[string][]string{{"apikey": {}}},
+	}
+
+	for _, category := range s.Categories {
+		document.Tags = append(document.Tags, openApiTag{Name: category.Name, Description: category.Description})
+
+		for _, endpoint := range category.Endpoints {
+			operation := openApiOperation{
+				OperationId: endpoint.Function,
+				Summary:     endpoint.Name,
+				Description: endpoint.Description,
+				Tags:        []string{category.Name},
+				Premium:     endpoint.Premium,
+				DocsUrl:     s.Source + endpoint.LinkName,
+				Responses: map[string]openApiResponse{
+					"200": {
+						Description: "The requested data, or a JSON object with an \"Error Message\", " +
+							"\"Information\" or \"Note\" key if the request was rejected.",
+						Content: map[string]openApiMediaType{
+							"application/json": {Schema: openApiSchema{Type: "object"}},
+						},
+					},
+				},
+			}
+
+			// The parser does not keep the function parameter, since its value is the endpoint's Function.
+			operation.Parameters = append(operation.Parameters, openApiParameter{
+				Name:        "function",
+				In:          "query",
+				Required:    true,
+				Description: "The API function, which selects this endpoint.",
+				Schema:      openApiSchema{Type: "string", Enum: []string{endpoint.Function}},
+			})
+
+			for _, param := range endpoint.Params {
+				switch param.Name {
+				case "function", "apikey":
+					continue // The function is added above and the API key is covered by the security scheme.
+				case "datatype":
+					operation.Responses["200"].Content["text/csv"] = openApiMediaType{
+						Schema: openApiSchema{Type: "string"},
+					}
+					fallthrough
+				default:
+					operation.Parameters = append(operation.Parameters, openApiParameter{
+						Name:        param.Name,
+						In:          "query",
+						Required:    param.Required,
+						Description: strings.TrimSpace(param.Description),
+						Schema:      parameterSchema(param),
+					})
+				}
+			}
+
+			document.Paths["/query?function="+endpoint.Function] = openApiPathItem{Get: operation}
+		}
+	}
+
+	return writeJson(path, document)
+}
+
+// parameterSchema describes a parameter's values from the fields the parser inferred.  Every query value is sent as
+// text, so the schema only gets a numeric type when the documentation says the parameter is a number.  Defaults
+// are kept as the documented strings.
+func parameterSchema(param Parameter) openApiSchema {
+	schema := openApiSchema{Type: "string", Default: param.Default}
+	switch {
+	case param.List:
+		// A comma-separated list of enumerated values doesn't fit a string enum; leave the values to the
+		// description.
+	case param.Type == "integer":
+		schema.Type = "integer"
+	case param.Type == "number":
+		schema.Type = "number"
+	case param.Type == "date":
+		schema.Format = "date"
+	case param.Type == "datetime":
+		schema.Pattern = `^\d{8}T\d{4}$`
+	case len(param.Enum) > 0:
+		schema.Enum = param.Enum
+	}
+	return schema
+}
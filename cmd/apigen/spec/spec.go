@@ -0,0 +1,228 @@
+package spec
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"os"
+	"time"
+)
+
+// Version is the version of the spec file format.  Bump it whenever a change to the format would stop an older
+// apigen from reading the file correctly.
+//
+// Version 2 dropped the checksum of the documentation page; see Checksum.
+const Version = 2
+
+const documentationUrl = "https://www.alphavantage.co/documentation/"
+
+// Spec is the machine-readable form of the scraped API surface.  Unlike api.Endpoints, its categories are in a
+// fixed order so that the file diffs cleanly between scrapes.
+type Spec struct {
+	SpecVersion int        `json:"specVersion"`
+	Source      string     `json:"source"`
+	Scraped     time.Time  `json:"scraped"`
+	Categories  []Category `json:"categories"`
+}
+
+type Category struct {
+	LinkName    string     `json:"linkName"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Endpoints   []Endpoint `json:"endpoints"`
+}
+
+type Endpoint struct {
+	LinkName    string      `json:"linkName"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Function    string      `json:"function"`
+	Premium     bool        `json:"premium"`
+	Params      []Parameter `json:"params"`
+}
+
+type Parameter struct {
+	Name        string   `json:"name"`
+	Required    bool     `json:"required"`
+	Description string   `json:"description"`
+	Type        string   `json:"type,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+	List        bool     `json:"list,omitempty"`
+	Positive    bool     `json:"positive,omitempty"`
+	Default     string   `json:"default,omitempty"`
+	Examples    []string `json:"examples,omitempty"`
+	Format      string   `json:"format,omitempty"`
+}
+
+// FromEndpoints builds a spec from the parser's output.  The access record's checksum is ignored; it is derived from
+// the spec by Checksum.
+func FromEndpoints(endpoints api.Endpoints, accessRecord api.AccessRecord) Spec {
+	spec := Spec{
+		SpecVersion: Version,
+		Source:      documentationUrl,
+		Scraped:     accessRecord.Date.UTC().Truncate(time.Second),
+	}
+
+	categories := maps.Keys(endpoints)
+	slices.SortFunc(categories, func(cat1, cat2 api.Category) bool {
+		return cat1.LinkName < cat2.LinkName
+	})
+
+	for _, category := range categories {
+		specCategory := Category{
+			LinkName:    category.LinkName,
+			Name:        category.ReadableName,
+			Description: category.Desc,
+		}
+
+		for _, endpoint := range endpoints[category] {
+			specEndpoint := Endpoint{
+				LinkName:    endpoint.LinkName,
+				Name:        endpoint.ReadableName,
+				Description: endpoint.Desc,
+				Function:    endpoint.Function,
+				Premium:     endpoint.Premium,
+				Params:      make([]Parameter, 0, len(endpoint.Params)),
+			}
+			for _, param := range endpoint.Params {
+				specEndpoint.Params = append(specEndpoint.Params, Parameter{
+					Name:        param.Name,
+					Required:    param.Required,
+					Description: param.Desc,
+					Type:        param.Type,
+					Enum:        param.Enum,
+					List:        param.List,
+					Positive:    param.Positive,
+					Default:     param.Default,
+					Examples:    param.Examples,
+					Format:      param.Format,
+				})
+			}
+			specCategory.Endpoints = append(specCategory.Endpoints, specEndpoint)
+		}
+
+		spec.Categories = append(spec.Categories, specCategory)
+	}
+
+	return spec
+}
+
+// Checksum is the SHA-256 of the spec's content: its format version and categories, but not when or where it was
+// scraped from.  Two scrapes of the documentation get the same checksum unless something the generator uses has
+// changed, however the page's markup has moved around.
+func (s Spec) Checksum() [32]byte {
+	content := struct {
+		SpecVersion int        `json:"specVersion"`
+		Categories  []Category `json:"categories"`
+	}{s.SpecVersion, s.Categories}
+
+	// The spec has no maps, so its encoding is canonical.  Encoding plain strings, bools and slices cannot fail.
+	canonical, err := json.Marshal(content)
+	if err != nil {
+		panic(fmt.Errorf("could not encode spec for its checksum: %w", err))
+	}
+	return sha256.Sum256(canonical)
+}
+
+// Endpoints converts the spec back into the model the generator works from.
+func (s Spec) Endpoints() (api.Endpoints, api.AccessRecord, error) {
+	accessRecord := api.AccessRecord{
+		Date:     s.Scraped,
+		Checksum: s.Checksum(),
+	}
+
+	endpoints := api.Endpoints{}
+	functions := map[string]bool{}
+	for _, specCategory := range s.Categories {
+		category := api.Category{
+			LinkName:     specCategory.LinkName,
+			ReadableName: specCategory.Name,
+			Desc:         specCategory.Description,
+		}
+
+		categoryEndpoints := make([]api.Endpoint, 0, len(specCategory.Endpoints))
+		for _, specEndpoint := range specCategory.Endpoints {
+			// Each function becomes a method, so a function listed twice would not compile.  The parser merges
+			// the listings of a scrape; a hand-edited spec has to do the same.
+			if functions[specEndpoint.Function] {
+				return nil, accessRecord, fmt.Errorf("spec lists function %v more than once", specEndpoint.Function)
+			}
+			functions[specEndpoint.Function] = true
+
+			endpoint := api.Endpoint{
+				LinkName:     specEndpoint.LinkName,
+				ReadableName: specEndpoint.Name,
+				Desc:         specEndpoint.Description,
+				Function:     specEndpoint.Function,
+				Premium:      specEndpoint.Premium,
+			}
+			for _, specParam := range specEndpoint.Params {
+				endpoint.Params = append(endpoint.Params, api.Parameter{
+					Required: specParam.Required,
+					Name:     specParam.Name,
+					Desc:     specParam.Description,
+					Type:     specParam.Type,
+					Enum:     specParam.Enum,
+					List:     specParam.List,
+					Positive: specParam.Positive,
+					Default:  specParam.Default,
+					Examples: specParam.Examples,
+					Format:   specParam.Format,
+				})
+			}
+			categoryEndpoints = append(categoryEndpoints, endpoint)
+		}
+		endpoints[category] = categoryEndpoints
+	}
+
+	return endpoints, accessRecord, nil
+}
+
+// Read loads a spec file, refusing files written in a newer format than this apigen understands.
+func Read(path string) (Spec, error) {
+	var spec Spec
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return spec, fmt.Errorf("could not read spec file: %w", err)
+	}
+
+	err = json.Unmarshal(contents, &spec)
+	if err != nil {
+		return spec, fmt.Errorf("could not parse spec file %v: %w", path, err)
+	}
+	if spec.SpecVersion > Version {
+		return spec, fmt.Errorf("spec file %v has version %d but this apigen only understands up to version %d",
+			path, spec.SpecVersion, Version)
+	}
+
+	return spec, nil
+}
+
+// Write saves the spec as indented JSON.
+func (s Spec) Write(path string) error {
+	return writeJson(path, s)
+}
+
+func writeJson(path string, value any) error {
+	// Descriptions are full of HTML, which would be unreadable (and undiffable) with the default escaping.
+	var contents bytes.Buffer
+	encoder := json.NewEncoder(&contents)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(value)
+	if err != nil {
+		return fmt.Errorf("could not encode %v: %w", path, err)
+	}
+
+	err = os.WriteFile(path, contents.Bytes(), 0666)
+	if err != nil {
+		return fmt.Errorf("could not write %v: %w", path, err)
+	}
+	return nil
+}
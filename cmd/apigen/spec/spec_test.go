@@ -0,0 +1,160 @@
+package spec
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jay9909/alphavantage/cmd/apigen/api"
+)
+
+func TestChecksum(t *testing.T) {
+	category := api.Category{LinkName: "#fundamentals", ReadableName: "Fundamental Data"}
+	endpoints := func(desc string) api.Endpoints {
+		return api.Endpoints{category: {{Function: "OVERVIEW", Params: []api.Parameter{
+			{Name: "symbol", Required: true, Desc: desc},
+		}}}}
+	}
+
+	monday := FromEndpoints(endpoints("The symbol."), api.AccessRecord{Date: time.Date(2023, 5, 22, 0, 0, 0, 0, time.UTC)})
+	tuesday := FromEndpoints(endpoints("The symbol."), api.AccessRecord{Date: time.Date(2023, 5, 23, 0, 0, 0, 0, time.UTC)})
+	changed := FromEndpoints(endpoints("The ticker."), api.AccessRecord{Date: time.Date(2023, 5, 23, 0, 0, 0, 0, time.UTC)})
+
+	if monday.Checksum() != tuesday.Checksum() {
+		t.Error("checksum depends on the scrape date")
+	}
+	if monday.Checksum() == changed.Checksum() {
+		t.Error("checksum did not change with a parameter description")
+	}
+
+	_, accessRecord, _ := monday.Endpoints()
+	if accessRecord.Checksum != monday.Checksum() {
+		t.Error("Endpoints did not carry the spec checksum")
+	}
+}
+
+var update = flag.Bool("update", false, "rewrite the golden files from the current output")
+
+// testEndpoints are two categories covering the kinds of parameters the OpenAPI document describes differently.
+func testEndpoints() (api.Endpoints, api.AccessRecord) {
+	endpoints := api.Endpoints{
+		{LinkName: "#time-series-data", ReadableName: "Core Stock APIs", Desc: "Stock data."}: {
+			{
+				LinkName:     "#time-series-daily",
+				ReadableName: "TIME_SERIES_DAILY",
+				Desc:         "Daily <b>OHLCV</b> series.",
+				Function:     "TIME_SERIES_DAILY",
+				Params: []api.Parameter{
+					{Required: true, Name: "function", Desc: "The time series of your choice."},
+					{Required: true, Name: "symbol", Desc: "The name of the equity.", Type: "string",
+						Examples: []string{"IBM"}},
+					{Name: "outputsize", Desc: "Strings compact and full are accepted. ", Type: "enum",
+						Enum: []string{"compact", "full"}, Default: "compact"},
+					{Name: "datatype", Desc: "Strings json and csv are accepted.", Type: "enum",
+						Enum: []string{"json", "csv"}, Default: "json"},
+					{Required: true, Name: "apikey", Desc: "Your API key."},
+				},
+			},
+		},
+		{LinkName: "#technical-indicators", ReadableName: "Technical Indicators", Desc: "Indicators."}: {
+			{
+				LinkName:     "#sma",
+				ReadableName: "SMA",
+				Desc:         "Simple moving average.",
+				Function:     "SMA",
+				Premium:      true,
+				Params: []api.Parameter{
+					{Required: true, Name: "time_period", Desc: "Number of data points.", Type: "integer",
+						Positive: true},
+					{Name: "month", Desc: "A month, in YYYY-MM format.", Type: "date", Format: "YYYY-MM"},
+					{Name: "time_from", Desc: "Start time, in YYYYMMDDTHHMM format.", Type: "datetime",
+						Format: "YYYYMMDDTHHMM"},
+					{Name: "tickers", Desc: "Comma-separated symbols.", Type: "string", List: true},
+				},
+			},
+		},
+	}
+	return endpoints, api.AccessRecord{Date: time.Date(2023, 5, 20, 13, 9, 36, 0, time.UTC)}
+}
+
+func TestWriteRead(t *testing.T) {
+	endpoints, accessRecord := testEndpoints()
+	spec := FromEndpoints(endpoints, accessRecord)
+
+	path := filepath.Join(t.TempDir(), "spec.json")
+	if err := spec.Write(path); err != nil {
+		t.Fatal(err)
+	}
+	read, err := Read(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(read, spec) {
+		t.Errorf("read spec = %+v, want the one written, %+v", read, spec)
+	}
+
+	readEndpoints, readRecord, err := read.Endpoints()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(readEndpoints, endpoints) {
+		t.Errorf("endpoints of the read spec = %v, want the ones it was built from, %v", readEndpoints, endpoints)
+	}
+	if !readRecord.Date.Equal(accessRecord.Date) || readRecord.Checksum != spec.Checksum() {
+		t.Errorf("access record of the read spec = %+v, want the scrape date and the spec checksum", readRecord)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	dir := t.TempDir()
+	newer := filepath.Join(dir, "newer.json")
+	if err := os.WriteFile(newer, []byte(`{"specVersion": 99, "categories": []}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Read(newer); err == nil || !strings.Contains(err.Error(), "version 99") {
+		t.Errorf("Read of a newer spec = %v, want a version error", err)
+	}
+
+	duplicated := Spec{SpecVersion: Version, Categories: []Category{
+		{LinkName: "#a", Endpoints: []Endpoint{{Function: "OVERVIEW"}}},
+		{LinkName: "#b", Endpoints: []Endpoint{{Function: "OVERVIEW"}}},
+	}}
+	if _, _, err := duplicated.Endpoints(); err == nil || !strings.Contains(err.Error(), "OVERVIEW") {
+		t.Errorf("Endpoints of a spec listing a function twice = %v, want an error naming it", err)
+	}
+}
+
+func TestWriteOpenApiGolden(t *testing.T) {
+	spec := FromEndpoints(testEndpoints())
+
+	path := filepath.Join(t.TempDir(), "openapi.json")
+	if err := spec.WriteOpenApi(path); err != nil {
+		t.Fatal(err)
+	}
+	written, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	goldenFile := filepath.Join("testdata", "openapi.golden")
+	if *update {
+		err = os.WriteFile(goldenFile, written, 0666)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	golden, err := os.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(written, golden) {
+		t.Errorf("OpenAPI document does not match %v; if the change is intended, rerun with -update and review the "+
+			"golden file diff\n=====\n%s\n=====", goldenFile, written)
+	}
+}
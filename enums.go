@@ -0,0 +1,61 @@
+package alphavantage
+
+// Interval is the time between two consecutive data points in a time series.  Intraday endpoints accept the
+// minute intervals, most others the daily and longer ones; check the endpoint documentation.
+type Interval string
+
+const (
+	Interval1Min       Interval = "1min"
+	Interval5Min       Interval = "5min"
+	Interval15Min      Interval = "15min"
+	Interval30Min      Interval = "30min"
+	Interval60Min      Interval = "60min"
+	IntervalDaily      Interval = "daily"
+	IntervalWeekly     Interval = "weekly"
+	IntervalMonthly    Interval = "monthly"
+	IntervalQuarterly  Interval = "quarterly"
+	IntervalSemiannual Interval = "semiannual"
+	IntervalAnnual     Interval = "annual"
+)
+
+// SeriesType is the price a technical indicator is calculated from.
+type SeriesType string
+
+const (
+	SeriesClose SeriesType = "close"
+	SeriesOpen  SeriesType = "open"
+	SeriesHigh  SeriesType = "high"
+	SeriesLow   SeriesType = "low"
+)
+
+// MAType selects the moving average used inside an indicator.  The zero value, MATypeSMA, is also the
+// service's default, so leaving an MAType field unset and setting it to MATypeSMA are equivalent.
+type MAType int
+
+const (
+	MATypeSMA MAType = iota
+	MATypeEMA
+	MATypeWMA
+	MATypeDEMA
+	MATypeTEMA
+	MATypeTRIMA
+	MATypeT3
+	MATypeKAMA
+	MATypeMAMA
+)
+
+// OutputSize selects between the latest 100 data points and the full-length time series.
+type OutputSize string
+
+const (
+	OutputCompact OutputSize = "compact"
+	OutputFull    OutputSize = "full"
+)
+
+// DataType selects the response format.
+type DataType string
+
+const (
+	DataJSON DataType = "json"
+	DataCSV  DataType = "csv"
+)
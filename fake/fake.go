@@ -0,0 +1,72 @@
+// Package fake provides Client, a stand-in for the Alpha Vantage client whose responses are programmed by the test
+// using it.
+//
+//	client := &fake.Client{}
+//	client.Respond("GetOverview", api.Response{Response: recordedResponse})
+//	client.GetTimeSeriesDailyFunc = func(symbol, opt_outputsize, opt_datatype string) api.Response { ... }
+//
+//	service := NewService(client) // takes an alphavantage.API
+package fake
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jay9909/alphavantage/api"
+)
+
+// ErrNotProgrammed is the error of the response returned by a method with neither a Func nor a Respond response.
+var ErrNotProgrammed = errors.New("no response programmed")
+
+// Call is a recorded method call.
+type Call struct {
+	Method string
+	Args   []any // The arguments, in order; a params struct for Query methods
+}
+
+// Respond sets the response returned by the named method, e.g. "GetOverview", when its Func field is nil.
+func (c *Client) Respond(method string, response api.Response) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.responses == nil {
+		c.responses = map[string]api.Response{}
+	}
+	c.responses[method] = response
+}
+
+// Calls returns every call made so far, in order.
+func (c *Client) Calls() []Call {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]Call(nil), c.calls...)
+}
+
+// CallsTo returns the calls made so far to the named method.
+func (c *Client) CallsTo(method string) []Call {
+	var calls []Call
+	for _, call := range c.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+func (c *Client) record(method string, args ...any) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.calls = append(c.calls, Call{Method: method, Args: args})
+}
+
+func (c *Client) response(method string) api.Response {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if response, ok := c.responses[method]; ok {
+		return response
+	}
+	return api.Response{Error: fmt.Errorf("fake %v: %w", method, ErrNotProgrammed)}
+}
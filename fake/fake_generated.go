@@ -0,0 +1,1876 @@
+// Code generated by go generate; DO NOT EDIT.
+// This file was generated on 2023-05-20 13:09:36
+// using data from https://www.alphavantage.co/documentation
+
+package fake
+
+import (
+	"github.com/jay9909/alphavantage"
+	"github.com/jay9909/alphavantage/api"
+	"sync"
+)
+
+// Client is a programmable implementation of alphavantage.API.  Each method returns the result of its Func field
+// when that is set, and otherwise the response given to Respond for the method's name.  Every call is recorded.
+type Client struct {
+	GetWtiFunc                          func(opt_interval, opt_datatype string) api.Response
+	QueryWtiFunc                        func(p alphavantage.WtiParams) api.Response
+	GetBrentFunc                        func(opt_interval, opt_datatype string) api.Response
+	QueryBrentFunc                      func(p alphavantage.BrentParams) api.Response
+	GetNaturalGasFunc                   func(opt_interval, opt_datatype string) api.Response
+	QueryNaturalGasFunc                 func(p alphavantage.NaturalGasParams) api.Response
+	GetCopperFunc                       func(opt_interval, opt_datatype string) api.Response
+	QueryCopperFunc                     func(p alphavantage.CopperParams) api.Response
+	GetAluminumFunc                     func(opt_interval, opt_datatype string) api.Response
+	QueryAluminumFunc                   func(p alphavantage.AluminumParams) api.Response
+	GetWheatFunc                        func(opt_interval, opt_datatype string) api.Response
+	QueryWheatFunc                      func(p alphavantage.WheatParams) api.Response
+	GetCornFunc                         func(opt_interval, opt_datatype string) api.Response
+	QueryCornFunc                       func(p alphavantage.CornParams) api.Response
+	GetCottonFunc                       func(opt_interval, opt_datatype string) api.Response
+	QueryCottonFunc                     func(p alphavantage.CottonParams) api.Response
+	GetSugarFunc                        func(opt_interval, opt_datatype string) api.Response
+	QuerySugarFunc                      func(p alphavantage.SugarParams) api.Response
+	GetCoffeeFunc                       func(opt_interval, opt_datatype string) api.Response
+	QueryCoffeeFunc                     func(p alphavantage.CoffeeParams) api.Response
+	GetAllCommoditiesFunc               func(opt_interval, opt_datatype string) api.Response
+	QueryAllCommoditiesFunc             func(p alphavantage.AllCommoditiesParams) api.Response
+	GetCryptoIntradayFunc               func(symbol, market, interval, opt_outputsize, opt_datatype string) api.Response
+	QueryCryptoIntradayFunc             func(p alphavantage.CryptoIntradayParams) api.Response
+	GetDigitalCurrencyDailyFunc         func(symbol, market string) api.Response
+	QueryDigitalCurrencyDailyFunc       func(p alphavantage.DigitalCurrencyDailyParams) api.Response
+	GetDigitalCurrencyWeeklyFunc        func(symbol, market string) api.Response
+	QueryDigitalCurrencyWeeklyFunc      func(p alphavantage.DigitalCurrencyWeeklyParams) api.Response
+	GetDigitalCurrencyMonthlyFunc       func(symbol, market string) api.Response
+	QueryDigitalCurrencyMonthlyFunc     func(p alphavantage.DigitalCurrencyMonthlyParams) api.Response
+	GetRealGdpFunc                      func(opt_interval, opt_datatype string) api.Response
+	QueryRealGdpFunc                    func(p alphavantage.RealGdpParams) api.Response
+	GetRealGdpPerCapitaFunc             func(opt_datatype string) api.Response
+	QueryRealGdpPerCapitaFunc           func(p alphavantage.RealGdpPerCapitaParams) api.Response
+	GetTreasuryYieldFunc                func(opt_interval, opt_maturity, opt_datatype string) api.Response
+	QueryTreasuryYieldFunc              func(p alphavantage.TreasuryYieldParams) api.Response
+	GetFederalFundsRateFunc             func(opt_interval, opt_datatype string) api.Response
+	QueryFederalFundsRateFunc           func(p alphavantage.FederalFundsRateParams) api.Response
+	GetCpiFunc                          func(opt_interval, opt_datatype string) api.Response
+	QueryCpiFunc                        func(p alphavantage.CpiParams) api.Response
+	GetInflationFunc                    func(opt_datatype string) api.Response
+	QueryInflationFunc                  func(p alphavantage.InflationParams) api.Response
+	GetRetailSalesFunc                  func(opt_datatype string) api.Response
+	QueryRetailSalesFunc                func(p alphavantage.RetailSalesParams) api.Response
+	GetDurablesFunc                     func(opt_datatype string) api.Response
+	QueryDurablesFunc                   func(p alphavantage.DurablesParams) api.Response
+	GetUnemploymentFunc                 func(opt_datatype string) api.Response
+	QueryUnemploymentFunc               func(p alphavantage.UnemploymentParams) api.Response
+	GetNonfarmPayrollFunc               func(opt_datatype string) api.Response
+	QueryNonfarmPayrollFunc             func(p alphavantage.NonfarmPayrollParams) api.Response
+	GetOverviewFunc                     func(symbol string) api.Response
+	QueryOverviewFunc                   func(p alphavantage.OverviewParams) api.Response
+	GetIncomeStatementFunc              func(symbol string) api.Response
+	QueryIncomeStatementFunc            func(p alphavantage.IncomeStatementParams) api.Response
+	GetBalanceSheetFunc                 func(symbol string) api.Response
+	QueryBalanceSheetFunc               func(p alphavantage.BalanceSheetParams) api.Response
+	GetCashFlowFunc                     func(symbol string) api.Response
+	QueryCashFlowFunc                   func(p alphavantage.CashFlowParams) api.Response
+	GetEarningsFunc                     func(symbol string) api.Response
+	QueryEarningsFunc                   func(p alphavantage.EarningsParams) api.Response
+	GetListingStatusFunc                func(opt_date, opt_state string) api.Response
+	QueryListingStatusFunc              func(p alphavantage.ListingStatusParams) api.Response
+	GetEarningsCalendarFunc             func(opt_symbol, opt_horizon string) api.Response
+	QueryEarningsCalendarFunc           func(p alphavantage.EarningsCalendarParams) api.Response
+	GetIpoCalendarFunc                  func() api.Response
+	QueryIpoCalendarFunc                func(p alphavantage.IpoCalendarParams) api.Response
+	GetCurrencyExchangeRateFunc         func(from_currency, to_currency string) api.Response
+	QueryCurrencyExchangeRateFunc       func(p alphavantage.CurrencyExchangeRateParams) api.Response
+	GetFxIntradayFunc                   func(from_symbol, to_symbol, interval, opt_outputsize, opt_datatype string) api.Response
+	QueryFxIntradayFunc                 func(p alphavantage.FxIntradayParams) api.Response
+	GetFxDailyFunc                      func(from_symbol, to_symbol, opt_outputsize, opt_datatype string) api.Response
+	QueryFxDailyFunc                    func(p alphavantage.FxDailyParams) api.Response
+	GetFxWeeklyFunc                     func(from_symbol, to_symbol, opt_datatype string) api.Response
+	QueryFxWeeklyFunc                   func(p alphavantage.FxWeeklyParams) api.Response
+	GetFxMonthlyFunc                    func(from_symbol, to_symbol, opt_datatype string) api.Response
+	QueryFxMonthlyFunc                  func(p alphavantage.FxMonthlyParams) api.Response
+	GetNewsSentimentFunc                func(opt_tickers, opt_topics, opt_time_from, opt_time_to, opt_sort, opt_limit string) api.Response
+	QueryNewsSentimentFunc              func(p alphavantage.NewsSentimentParams) api.Response
+	GetSmaFunc                          func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QuerySmaFunc                        func(p alphavantage.SmaParams) api.Response
+	GetEmaFunc                          func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryEmaFunc                        func(p alphavantage.EmaParams) api.Response
+	GetWmaFunc                          func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryWmaFunc                        func(p alphavantage.WmaParams) api.Response
+	GetDemaFunc                         func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryDemaFunc                       func(p alphavantage.DemaParams) api.Response
+	GetTemaFunc                         func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryTemaFunc                       func(p alphavantage.TemaParams) api.Response
+	GetTrimaFunc                        func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryTrimaFunc                      func(p alphavantage.TrimaParams) api.Response
+	GetKamaFunc                         func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryKamaFunc                       func(p alphavantage.KamaParams) api.Response
+	GetMamaFunc                         func(symbol, interval, series_type, opt_fastlimit, opt_slowlimit, opt_datatype string) api.Response
+	QueryMamaFunc                       func(p alphavantage.MamaParams) api.Response
+	GetVwapFunc                         func(symbol, interval, opt_datatype string) api.Response
+	QueryVwapFunc                       func(p alphavantage.VwapParams) api.Response
+	GetT3Func                           func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryT3Func                         func(p alphavantage.T3Params) api.Response
+	GetMacdFunc                         func(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_datatype string) api.Response
+	QueryMacdFunc                       func(p alphavantage.MacdParams) api.Response
+	GetMacdextFunc                      func(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_fastmatype, opt_slowmatype, opt_signalmatype, opt_datatype string) api.Response
+	QueryMacdextFunc                    func(p alphavantage.MacdextParams) api.Response
+	GetStochFunc                        func(symbol, interval, opt_fastkperiod, opt_slowkperiod, opt_slowdperiod, opt_slowkmatype, opt_slowdmatype, opt_datatype string) api.Response
+	QueryStochFunc                      func(p alphavantage.StochParams) api.Response
+	GetStochfFunc                       func(symbol, interval, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype string) api.Response
+	QueryStochfFunc                     func(p alphavantage.StochfParams) api.Response
+	GetRsiFunc                          func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryRsiFunc                        func(p alphavantage.RsiParams) api.Response
+	GetStochrsiFunc                     func(symbol, interval, time_period, series_type, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype string) api.Response
+	QueryStochrsiFunc                   func(p alphavantage.StochrsiParams) api.Response
+	GetWillrFunc                        func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryWillrFunc                      func(p alphavantage.WillrParams) api.Response
+	GetAdxFunc                          func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryAdxFunc                        func(p alphavantage.AdxParams) api.Response
+	GetAdxrFunc                         func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryAdxrFunc                       func(p alphavantage.AdxrParams) api.Response
+	GetApoFunc                          func(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype string) api.Response
+	QueryApoFunc                        func(p alphavantage.ApoParams) api.Response
+	GetPpoFunc                          func(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype string) api.Response
+	QueryPpoFunc                        func(p alphavantage.PpoParams) api.Response
+	GetMomFunc                          func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryMomFunc                        func(p alphavantage.MomParams) api.Response
+	GetBopFunc                          func(symbol, interval, opt_datatype string) api.Response
+	QueryBopFunc                        func(p alphavantage.BopParams) api.Response
+	GetCciFunc                          func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryCciFunc                        func(p alphavantage.CciParams) api.Response
+	GetCmoFunc                          func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryCmoFunc                        func(p alphavantage.CmoParams) api.Response
+	GetRocFunc                          func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryRocFunc                        func(p alphavantage.RocParams) api.Response
+	GetRocrFunc                         func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryRocrFunc                       func(p alphavantage.RocrParams) api.Response
+	GetAroonFunc                        func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryAroonFunc                      func(p alphavantage.AroonParams) api.Response
+	GetAroonoscFunc                     func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryAroonoscFunc                   func(p alphavantage.AroonoscParams) api.Response
+	GetMfiFunc                          func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryMfiFunc                        func(p alphavantage.MfiParams) api.Response
+	GetTrixFunc                         func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryTrixFunc                       func(p alphavantage.TrixParams) api.Response
+	GetUltoscFunc                       func(symbol, interval, opt_timeperiod1, opt_timeperiod2, opt_timeperiod3, opt_datatype string) api.Response
+	QueryUltoscFunc                     func(p alphavantage.UltoscParams) api.Response
+	GetDxFunc                           func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryDxFunc                         func(p alphavantage.DxParams) api.Response
+	GetMinusDiFunc                      func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryMinusDiFunc                    func(p alphavantage.MinusDiParams) api.Response
+	GetPlusDiFunc                       func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryPlusDiFunc                     func(p alphavantage.PlusDiParams) api.Response
+	GetMinusDmFunc                      func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryMinusDmFunc                    func(p alphavantage.MinusDmParams) api.Response
+	GetPlusDmFunc                       func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryPlusDmFunc                     func(p alphavantage.PlusDmParams) api.Response
+	GetBbandsFunc                       func(symbol, interval, time_period, series_type, opt_nbdevup, opt_nbdevdn, opt_matype, opt_datatype string) api.Response
+	QueryBbandsFunc                     func(p alphavantage.BbandsParams) api.Response
+	GetMidpointFunc                     func(symbol, interval, time_period, series_type, opt_datatype string) api.Response
+	QueryMidpointFunc                   func(p alphavantage.MidpointParams) api.Response
+	GetMidpriceFunc                     func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryMidpriceFunc                   func(p alphavantage.MidpriceParams) api.Response
+	GetSarFunc                          func(symbol, interval, opt_acceleration, opt_maximum, opt_datatype string) api.Response
+	QuerySarFunc                        func(p alphavantage.SarParams) api.Response
+	GetTrangeFunc                       func(symbol, interval, opt_datatype string) api.Response
+	QueryTrangeFunc                     func(p alphavantage.TrangeParams) api.Response
+	GetAtrFunc                          func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryAtrFunc                        func(p alphavantage.AtrParams) api.Response
+	GetNatrFunc                         func(symbol, interval, time_period, opt_datatype string) api.Response
+	QueryNatrFunc                       func(p alphavantage.NatrParams) api.Response
+	GetAdFunc                           func(symbol, interval, opt_datatype string) api.Response
+	QueryAdFunc                         func(p alphavantage.AdParams) api.Response
+	GetAdoscFunc                        func(symbol, interval, opt_fastperiod, opt_slowperiod, opt_datatype string) api.Response
+	QueryAdoscFunc                      func(p alphavantage.AdoscParams) api.Response
+	GetObvFunc                          func(symbol, interval, opt_datatype string) api.Response
+	QueryObvFunc                        func(p alphavantage.ObvParams) api.Response
+	GetHtTrendlineFunc                  func(symbol, interval, series_type, opt_datatype string) api.Response
+	QueryHtTrendlineFunc                func(p alphavantage.HtTrendlineParams) api.Response
+	GetHtSineFunc                       func(symbol, interval, series_type, opt_datatype string) api.Response
+	QueryHtSineFunc                     func(p alphavantage.HtSineParams) api.Response
+	GetHtTrendmodeFunc                  func(symbol, interval, series_type, opt_datatype string) api.Response
+	QueryHtTrendmodeFunc                func(p alphavantage.HtTrendmodeParams) api.Response
+	GetHtDcperiodFunc                   func(symbol, interval, series_type, opt_datatype string) api.Response
+	QueryHtDcperiodFunc                 func(p alphavantage.HtDcperiodParams) api.Response
+	GetHtDcphaseFunc                    func(symbol, interval, series_type, opt_datatype string) api.Response
+	QueryHtDcphaseFunc                  func(p alphavantage.HtDcphaseParams) api.Response
+	GetHtPhasorFunc                     func(symbol, interval, series_type, opt_datatype string) api.Response
+	QueryHtPhasorFunc                   func(p alphavantage.HtPhasorParams) api.Response
+	GetTimeSeriesIntradayFunc           func(symbol, interval, opt_adjusted, opt_outputsize, opt_datatype string) api.Response
+	QueryTimeSeriesIntradayFunc         func(p alphavantage.TimeSeriesIntradayParams) api.Response
+	GetTimeSeriesIntradayExtendedFunc   func(symbol, interval, slice, opt_adjusted string) api.Response
+	QueryTimeSeriesIntradayExtendedFunc func(p alphavantage.TimeSeriesIntradayExtendedParams) api.Response
+	GetTimeSeriesDailyFunc              func(symbol, opt_outputsize, opt_datatype string) api.Response
+	QueryTimeSeriesDailyFunc            func(p alphavantage.TimeSeriesDailyParams) api.Response
+	GetTimeSeriesDailyAdjustedFunc      func(symbol, opt_outputsize, opt_datatype string) api.Response
+	QueryTimeSeriesDailyAdjustedFunc    func(p alphavantage.TimeSeriesDailyAdjustedParams) api.Response
+	GetTimeSeriesWeeklyFunc             func(symbol, opt_datatype string) api.Response
+	QueryTimeSeriesWeeklyFunc           func(p alphavantage.TimeSeriesWeeklyParams) api.Response
+	GetTimeSeriesWeeklyAdjustedFunc     func(symbol, opt_datatype string) api.Response
+	QueryTimeSeriesWeeklyAdjustedFunc   func(p alphavantage.TimeSeriesWeeklyAdjustedParams) api.Response
+	GetTimeSeriesMonthlyFunc            func(symbol, opt_datatype string) api.Response
+	QueryTimeSeriesMonthlyFunc          func(p alphavantage.TimeSeriesMonthlyParams) api.Response
+	GetTimeSeriesMonthlyAdjustedFunc    func(symbol, opt_datatype string) api.Response
+	QueryTimeSeriesMonthlyAdjustedFunc  func(p alphavantage.TimeSeriesMonthlyAdjustedParams) api.Response
+	GetGlobalQuoteFunc                  func(symbol, opt_datatype string) api.Response
+	QueryGlobalQuoteFunc                func(p alphavantage.GlobalQuoteParams) api.Response
+	GetSymbolSearchFunc                 func(keywords, opt_datatype string) api.Response
+	QuerySymbolSearchFunc               func(p alphavantage.SymbolSearchParams) api.Response
+	GetMarketStatusFunc                 func() api.Response
+	QueryMarketStatusFunc               func(p alphavantage.MarketStatusParams) api.Response
+
+	calls     []Call
+	responses map[string]api.Response
+	mutex     sync.Mutex
+}
+
+var _ alphavantage.API = (*Client)(nil)
+
+func (c *Client) GetWti(opt_interval, opt_datatype string) api.Response {
+	c.record("GetWti", opt_interval, opt_datatype)
+	if c.GetWtiFunc != nil {
+		return c.GetWtiFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetWti")
+}
+
+func (c *Client) QueryWti(p alphavantage.WtiParams) api.Response {
+	c.record("QueryWti", p)
+	if c.QueryWtiFunc != nil {
+		return c.QueryWtiFunc(p)
+	}
+	return c.response("QueryWti")
+}
+
+func (c *Client) GetBrent(opt_interval, opt_datatype string) api.Response {
+	c.record("GetBrent", opt_interval, opt_datatype)
+	if c.GetBrentFunc != nil {
+		return c.GetBrentFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetBrent")
+}
+
+func (c *Client) QueryBrent(p alphavantage.BrentParams) api.Response {
+	c.record("QueryBrent", p)
+	if c.QueryBrentFunc != nil {
+		return c.QueryBrentFunc(p)
+	}
+	return c.response("QueryBrent")
+}
+
+func (c *Client) GetNaturalGas(opt_interval, opt_datatype string) api.Response {
+	c.record("GetNaturalGas", opt_interval, opt_datatype)
+	if c.GetNaturalGasFunc != nil {
+		return c.GetNaturalGasFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetNaturalGas")
+}
+
+func (c *Client) QueryNaturalGas(p alphavantage.NaturalGasParams) api.Response {
+	c.record("QueryNaturalGas", p)
+	if c.QueryNaturalGasFunc != nil {
+		return c.QueryNaturalGasFunc(p)
+	}
+	return c.response("QueryNaturalGas")
+}
+
+func (c *Client) GetCopper(opt_interval, opt_datatype string) api.Response {
+	c.record("GetCopper", opt_interval, opt_datatype)
+	if c.GetCopperFunc != nil {
+		return c.GetCopperFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetCopper")
+}
+
+func (c *Client) QueryCopper(p alphavantage.CopperParams) api.Response {
+	c.record("QueryCopper", p)
+	if c.QueryCopperFunc != nil {
+		return c.QueryCopperFunc(p)
+	}
+	return c.response("QueryCopper")
+}
+
+func (c *Client) GetAluminum(opt_interval, opt_datatype string) api.Response {
+	c.record("GetAluminum", opt_interval, opt_datatype)
+	if c.GetAluminumFunc != nil {
+		return c.GetAluminumFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetAluminum")
+}
+
+func (c *Client) QueryAluminum(p alphavantage.AluminumParams) api.Response {
+	c.record("QueryAluminum", p)
+	if c.QueryAluminumFunc != nil {
+		return c.QueryAluminumFunc(p)
+	}
+	return c.response("QueryAluminum")
+}
+
+func (c *Client) GetWheat(opt_interval, opt_datatype string) api.Response {
+	c.record("GetWheat", opt_interval, opt_datatype)
+	if c.GetWheatFunc != nil {
+		return c.GetWheatFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetWheat")
+}
+
+func (c *Client) QueryWheat(p alphavantage.WheatParams) api.Response {
+	c.record("QueryWheat", p)
+	if c.QueryWheatFunc != nil {
+		return c.QueryWheatFunc(p)
+	}
+	return c.response("QueryWheat")
+}
+
+func (c *Client) GetCorn(opt_interval, opt_datatype string) api.Response {
+	c.record("GetCorn", opt_interval, opt_datatype)
+	if c.GetCornFunc != nil {
+		return c.GetCornFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetCorn")
+}
+
+func (c *Client) QueryCorn(p alphavantage.CornParams) api.Response {
+	c.record("QueryCorn", p)
+	if c.QueryCornFunc != nil {
+		return c.QueryCornFunc(p)
+	}
+	return c.response("QueryCorn")
+}
+
+func (c *Client) GetCotton(opt_interval, opt_datatype string) api.Response {
+	c.record("GetCotton", opt_interval, opt_datatype)
+	if c.GetCottonFunc != nil {
+		return c.GetCottonFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetCotton")
+}
+
+func (c *Client) QueryCotton(p alphavantage.CottonParams) api.Response {
+	c.record("QueryCotton", p)
+	if c.QueryCottonFunc != nil {
+		return c.QueryCottonFunc(p)
+	}
+	return c.response("QueryCotton")
+}
+
+func (c *Client) GetSugar(opt_interval, opt_datatype string) api.Response {
+	c.record("GetSugar", opt_interval, opt_datatype)
+	if c.GetSugarFunc != nil {
+		return c.GetSugarFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetSugar")
+}
+
+func (c *Client) QuerySugar(p alphavantage.SugarParams) api.Response {
+	c.record("QuerySugar", p)
+	if c.QuerySugarFunc != nil {
+		return c.QuerySugarFunc(p)
+	}
+	return c.response("QuerySugar")
+}
+
+func (c *Client) GetCoffee(opt_interval, opt_datatype string) api.Response {
+	c.record("GetCoffee", opt_interval, opt_datatype)
+	if c.GetCoffeeFunc != nil {
+		return c.GetCoffeeFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetCoffee")
+}
+
+func (c *Client) QueryCoffee(p alphavantage.CoffeeParams) api.Response {
+	c.record("QueryCoffee", p)
+	if c.QueryCoffeeFunc != nil {
+		return c.QueryCoffeeFunc(p)
+	}
+	return c.response("QueryCoffee")
+}
+
+func (c *Client) GetAllCommodities(opt_interval, opt_datatype string) api.Response {
+	c.record("GetAllCommodities", opt_interval, opt_datatype)
+	if c.GetAllCommoditiesFunc != nil {
+		return c.GetAllCommoditiesFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetAllCommodities")
+}
+
+func (c *Client) QueryAllCommodities(p alphavantage.AllCommoditiesParams) api.Response {
+	c.record("QueryAllCommodities", p)
+	if c.QueryAllCommoditiesFunc != nil {
+		return c.QueryAllCommoditiesFunc(p)
+	}
+	return c.response("QueryAllCommodities")
+}
+
+func (c *Client) GetCryptoIntraday(symbol, market, interval, opt_outputsize, opt_datatype string) api.Response {
+	c.record("GetCryptoIntraday", symbol, market, interval, opt_outputsize, opt_datatype)
+	if c.GetCryptoIntradayFunc != nil {
+		return c.GetCryptoIntradayFunc(symbol, market, interval, opt_outputsize, opt_datatype)
+	}
+	return c.response("GetCryptoIntraday")
+}
+
+func (c *Client) QueryCryptoIntraday(p alphavantage.CryptoIntradayParams) api.Response {
+	c.record("QueryCryptoIntraday", p)
+	if c.QueryCryptoIntradayFunc != nil {
+		return c.QueryCryptoIntradayFunc(p)
+	}
+	return c.response("QueryCryptoIntraday")
+}
+
+func (c *Client) GetDigitalCurrencyDaily(symbol, market string) api.Response {
+	c.record("GetDigitalCurrencyDaily", symbol, market)
+	if c.GetDigitalCurrencyDailyFunc != nil {
+		return c.GetDigitalCurrencyDailyFunc(symbol, market)
+	}
+	return c.response("GetDigitalCurrencyDaily")
+}
+
+func (c *Client) QueryDigitalCurrencyDaily(p alphavantage.DigitalCurrencyDailyParams) api.Response {
+	c.record("QueryDigitalCurrencyDaily", p)
+	if c.QueryDigitalCurrencyDailyFunc != nil {
+		return c.QueryDigitalCurrencyDailyFunc(p)
+	}
+	return c.response("QueryDigitalCurrencyDaily")
+}
+
+func (c *Client) GetDigitalCurrencyWeekly(symbol, market string) api.Response {
+	c.record("GetDigitalCurrencyWeekly", symbol, market)
+	if c.GetDigitalCurrencyWeeklyFunc != nil {
+		return c.GetDigitalCurrencyWeeklyFunc(symbol, market)
+	}
+	return c.response("GetDigitalCurrencyWeekly")
+}
+
+func (c *Client) QueryDigitalCurrencyWeekly(p alphavantage.DigitalCurrencyWeeklyParams) api.Response {
+	c.record("QueryDigitalCurrencyWeekly", p)
+	if c.QueryDigitalCurrencyWeeklyFunc != nil {
+		return c.QueryDigitalCurrencyWeeklyFunc(p)
+	}
+	return c.response("QueryDigitalCurrencyWeekly")
+}
+
+func (c *Client) GetDigitalCurrencyMonthly(symbol, market string) api.Response {
+	c.record("GetDigitalCurrencyMonthly", symbol, market)
+	if c.GetDigitalCurrencyMonthlyFunc != nil {
+		return c.GetDigitalCurrencyMonthlyFunc(symbol, market)
+	}
+	return c.response("GetDigitalCurrencyMonthly")
+}
+
+func (c *Client) QueryDigitalCurrencyMonthly(p alphavantage.DigitalCurrencyMonthlyParams) api.Response {
+	c.record("QueryDigitalCurrencyMonthly", p)
+	if c.QueryDigitalCurrencyMonthlyFunc != nil {
+		return c.QueryDigitalCurrencyMonthlyFunc(p)
+	}
+	return c.response("QueryDigitalCurrencyMonthly")
+}
+
+func (c *Client) GetRealGdp(opt_interval, opt_datatype string) api.Response {
+	c.record("GetRealGdp", opt_interval, opt_datatype)
+	if c.GetRealGdpFunc != nil {
+		return c.GetRealGdpFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetRealGdp")
+}
+
+func (c *Client) QueryRealGdp(p alphavantage.RealGdpParams) api.Response {
+	c.record("QueryRealGdp", p)
+	if c.QueryRealGdpFunc != nil {
+		return c.QueryRealGdpFunc(p)
+	}
+	return c.response("QueryRealGdp")
+}
+
+func (c *Client) GetRealGdpPerCapita(opt_datatype string) api.Response {
+	c.record("GetRealGdpPerCapita", opt_datatype)
+	if c.GetRealGdpPerCapitaFunc != nil {
+		return c.GetRealGdpPerCapitaFunc(opt_datatype)
+	}
+	return c.response("GetRealGdpPerCapita")
+}
+
+func (c *Client) QueryRealGdpPerCapita(p alphavantage.RealGdpPerCapitaParams) api.Response {
+	c.record("QueryRealGdpPerCapita", p)
+	if c.QueryRealGdpPerCapitaFunc != nil {
+		return c.QueryRealGdpPerCapitaFunc(p)
+	}
+	return c.response("QueryRealGdpPerCapita")
+}
+
+func (c *Client) GetTreasuryYield(opt_interval, opt_maturity, opt_datatype string) api.Response {
+	c.record("GetTreasuryYield", opt_interval, opt_maturity, opt_datatype)
+	if c.GetTreasuryYieldFunc != nil {
+		return c.GetTreasuryYieldFunc(opt_interval, opt_maturity, opt_datatype)
+	}
+	return c.response("GetTreasuryYield")
+}
+
+func (c *Client) QueryTreasuryYield(p alphavantage.TreasuryYieldParams) api.Response {
+	c.record("QueryTreasuryYield", p)
+	if c.QueryTreasuryYieldFunc != nil {
+		return c.QueryTreasuryYieldFunc(p)
+	}
+	return c.response("QueryTreasuryYield")
+}
+
+func (c *Client) GetFederalFundsRate(opt_interval, opt_datatype string) api.Response {
+	c.record("GetFederalFundsRate", opt_interval, opt_datatype)
+	if c.GetFederalFundsRateFunc != nil {
+		return c.GetFederalFundsRateFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetFederalFundsRate")
+}
+
+func (c *Client) QueryFederalFundsRate(p alphavantage.FederalFundsRateParams) api.Response {
+	c.record("QueryFederalFundsRate", p)
+	if c.QueryFederalFundsRateFunc != nil {
+		return c.QueryFederalFundsRateFunc(p)
+	}
+	return c.response("QueryFederalFundsRate")
+}
+
+func (c *Client) GetCpi(opt_interval, opt_datatype string) api.Response {
+	c.record("GetCpi", opt_interval, opt_datatype)
+	if c.GetCpiFunc != nil {
+		return c.GetCpiFunc(opt_interval, opt_datatype)
+	}
+	return c.response("GetCpi")
+}
+
+func (c *Client) QueryCpi(p alphavantage.CpiParams) api.Response {
+	c.record("QueryCpi", p)
+	if c.QueryCpiFunc != nil {
+		return c.QueryCpiFunc(p)
+	}
+	return c.response("QueryCpi")
+}
+
+func (c *Client) GetInflation(opt_datatype string) api.Response {
+	c.record("GetInflation", opt_datatype)
+	if c.GetInflationFunc != nil {
+		return c.GetInflationFunc(opt_datatype)
+	}
+	return c.response("GetInflation")
+}
+
+func (c *Client) QueryInflation(p alphavantage.InflationParams) api.Response {
+	c.record("QueryInflation", p)
+	if c.QueryInflationFunc != nil {
+		return c.QueryInflationFunc(p)
+	}
+	return c.response("QueryInflation")
+}
+
+func (c *Client) GetRetailSales(opt_datatype string) api.Response {
+	c.record("GetRetailSales", opt_datatype)
+	if c.GetRetailSalesFunc != nil {
+		return c.GetRetailSalesFunc(opt_datatype)
+	}
+	return c.response("GetRetailSales")
+}
+
+func (c *Client) QueryRetailSales(p alphavantage.RetailSalesParams) api.Response {
+	c.record("QueryRetailSales", p)
+	if c.QueryRetailSalesFunc != nil {
+		return c.QueryRetailSalesFunc(p)
+	}
+	return c.response("QueryRetailSales")
+}
+
+func (c *Client) GetDurables(opt_datatype string) api.Response {
+	c.record("GetDurables", opt_datatype)
+	if c.GetDurablesFunc != nil {
+		return c.GetDurablesFunc(opt_datatype)
+	}
+	return c.response("GetDurables")
+}
+
+func (c *Client) QueryDurables(p alphavantage.DurablesParams) api.Response {
+	c.record("QueryDurables", p)
+	if c.QueryDurablesFunc != nil {
+		return c.QueryDurablesFunc(p)
+	}
+	return c.response("QueryDurables")
+}
+
+func (c *Client) GetUnemployment(opt_datatype string) api.Response {
+	c.record("GetUnemployment", opt_datatype)
+	if c.GetUnemploymentFunc != nil {
+		return c.GetUnemploymentFunc(opt_datatype)
+	}
+	return c.response("GetUnemployment")
+}
+
+func (c *Client) QueryUnemployment(p alphavantage.UnemploymentParams) api.Response {
+	c.record("QueryUnemployment", p)
+	if c.QueryUnemploymentFunc != nil {
+		return c.QueryUnemploymentFunc(p)
+	}
+	return c.response("QueryUnemployment")
+}
+
+func (c *Client) GetNonfarmPayroll(opt_datatype string) api.Response {
+	c.record("GetNonfarmPayroll", opt_datatype)
+	if c.GetNonfarmPayrollFunc != nil {
+		return c.GetNonfarmPayrollFunc(opt_datatype)
+	}
+	return c.response("GetNonfarmPayroll")
+}
+
+func (c *Client) QueryNonfarmPayroll(p alphavantage.NonfarmPayrollParams) api.Response {
+	c.record("QueryNonfarmPayroll", p)
+	if c.QueryNonfarmPayrollFunc != nil {
+		return c.QueryNonfarmPayrollFunc(p)
+	}
+	return c.response("QueryNonfarmPayroll")
+}
+
+func (c *Client) GetOverview(symbol string) api.Response {
+	c.record("GetOverview", symbol)
+	if c.GetOverviewFunc != nil {
+		return c.GetOverviewFunc(symbol)
+	}
+	return c.response("GetOverview")
+}
+
+func (c *Client) QueryOverview(p alphavantage.OverviewParams) api.Response {
+	c.record("QueryOverview", p)
+	if c.QueryOverviewFunc != nil {
+		return c.QueryOverviewFunc(p)
+	}
+	return c.response("QueryOverview")
+}
+
+func (c *Client) GetIncomeStatement(symbol string) api.Response {
+	c.record("GetIncomeStatement", symbol)
+	if c.GetIncomeStatementFunc != nil {
+		return c.GetIncomeStatementFunc(symbol)
+	}
+	return c.response("GetIncomeStatement")
+}
+
+func (c *Client) QueryIncomeStatement(p alphavantage.IncomeStatementParams) api.Response {
+	c.record("QueryIncomeStatement", p)
+	if c.QueryIncomeStatementFunc != nil {
+		return c.QueryIncomeStatementFunc(p)
+	}
+	return c.response("QueryIncomeStatement")
+}
+
+func (c *Client) GetBalanceSheet(symbol string) api.Response {
+	c.record("GetBalanceSheet", symbol)
+	if c.GetBalanceSheetFunc != nil {
+		return c.GetBalanceSheetFunc(symbol)
+	}
+	return c.response("GetBalanceSheet")
+}
+
+func (c *Client) QueryBalanceSheet(p alphavantage.BalanceSheetParams) api.Response {
+	c.record("QueryBalanceSheet", p)
+	if c.QueryBalanceSheetFunc != nil {
+		return c.QueryBalanceSheetFunc(p)
+	}
+	return c.response("QueryBalanceSheet")
+}
+
+func (c *Client) GetCashFlow(symbol string) api.Response {
+	c.record("GetCashFlow", symbol)
+	if c.GetCashFlowFunc != nil {
+		return c.GetCashFlowFunc(symbol)
+	}
+	return c.response("GetCashFlow")
+}
+
+func (c *Client) QueryCashFlow(p alphavantage.CashFlowParams) api.Response {
+	c.record("QueryCashFlow", p)
+	if c.QueryCashFlowFunc != nil {
+		return c.QueryCashFlowFunc(p)
+	}
+	return c.response("QueryCashFlow")
+}
+
+func (c *Client) GetEarnings(symbol string) api.Response {
+	c.record("GetEarnings", symbol)
+	if c.GetEarningsFunc != nil {
+		return c.GetEarningsFunc(symbol)
+	}
+	return c.response("GetEarnings")
+}
+
+func (c *Client) QueryEarnings(p alphavantage.EarningsParams) api.Response {
+	c.record("QueryEarnings", p)
+	if c.QueryEarningsFunc != nil {
+		return c.QueryEarningsFunc(p)
+	}
+	return c.response("QueryEarnings")
+}
+
+func (c *Client) GetListingStatus(opt_date, opt_state string) api.Response {
+	c.record("GetListingStatus", opt_date, opt_state)
+	if c.GetListingStatusFunc != nil {
+		return c.GetListingStatusFunc(opt_date, opt_state)
+	}
+	return c.response("GetListingStatus")
+}
+
+func (c *Client) QueryListingStatus(p alphavantage.ListingStatusParams) api.Response {
+	c.record("QueryListingStatus", p)
+	if c.QueryListingStatusFunc != nil {
+		return c.QueryListingStatusFunc(p)
+	}
+	return c.response("QueryListingStatus")
+}
+
+func (c *Client) GetEarningsCalendar(opt_symbol, opt_horizon string) api.Response {
+	c.record("GetEarningsCalendar", opt_symbol, opt_horizon)
+	if c.GetEarningsCalendarFunc != nil {
+		return c.GetEarningsCalendarFunc(opt_symbol, opt_horizon)
+	}
+	return c.response("GetEarningsCalendar")
+}
+
+func (c *Client) QueryEarningsCalendar(p alphavantage.EarningsCalendarParams) api.Response {
+	c.record("QueryEarningsCalendar", p)
+	if c.QueryEarningsCalendarFunc != nil {
+		return c.QueryEarningsCalendarFunc(p)
+	}
+	return c.response("QueryEarningsCalendar")
+}
+
+func (c *Client) GetIpoCalendar() api.Response {
+	c.record("GetIpoCalendar")
+	if c.GetIpoCalendarFunc != nil {
+		return c.GetIpoCalendarFunc()
+	}
+	return c.response("GetIpoCalendar")
+}
+
+func (c *Client) QueryIpoCalendar(p alphavantage.IpoCalendarParams) api.Response {
+	c.record("QueryIpoCalendar", p)
+	if c.QueryIpoCalendarFunc != nil {
+		return c.QueryIpoCalendarFunc(p)
+	}
+	return c.response("QueryIpoCalendar")
+}
+
+func (c *Client) GetCurrencyExchangeRate(from_currency, to_currency string) api.Response {
+	c.record("GetCurrencyExchangeRate", from_currency, to_currency)
+	if c.GetCurrencyExchangeRateFunc != nil {
+		return c.GetCurrencyExchangeRateFunc(from_currency, to_currency)
+	}
+	return c.response("GetCurrencyExchangeRate")
+}
+
+func (c *Client) QueryCurrencyExchangeRate(p alphavantage.CurrencyExchangeRateParams) api.Response {
+	c.record("QueryCurrencyExchangeRate", p)
+	if c.QueryCurrencyExchangeRateFunc != nil {
+		return c.QueryCurrencyExchangeRateFunc(p)
+	}
+	return c.response("QueryCurrencyExchangeRate")
+}
+
+func (c *Client) GetFxIntraday(from_symbol, to_symbol, interval, opt_outputsize, opt_datatype string) api.Response {
+	c.record("GetFxIntraday", from_symbol, to_symbol, interval, opt_outputsize, opt_datatype)
+	if c.GetFxIntradayFunc != nil {
+		return c.GetFxIntradayFunc(from_symbol, to_symbol, interval, opt_outputsize, opt_datatype)
+	}
+	return c.response("GetFxIntraday")
+}
+
+func (c *Client) QueryFxIntraday(p alphavantage.FxIntradayParams) api.Response {
+	c.record("QueryFxIntraday", p)
+	if c.QueryFxIntradayFunc != nil {
+		return c.QueryFxIntradayFunc(p)
+	}
+	return c.response("QueryFxIntraday")
+}
+
+func (c *Client) GetFxDaily(from_symbol, to_symbol, opt_outputsize, opt_datatype string) api.Response {
+	c.record("GetFxDaily", from_symbol, to_symbol, opt_outputsize, opt_datatype)
+	if c.GetFxDailyFunc != nil {
+		return c.GetFxDailyFunc(from_symbol, to_symbol, opt_outputsize, opt_datatype)
+	}
+	return c.response("GetFxDaily")
+}
+
+func (c *Client) QueryFxDaily(p alphavantage.FxDailyParams) api.Response {
+	c.record("QueryFxDaily", p)
+	if c.QueryFxDailyFunc != nil {
+		return c.QueryFxDailyFunc(p)
+	}
+	return c.response("QueryFxDaily")
+}
+
+func (c *Client) GetFxWeekly(from_symbol, to_symbol, opt_datatype string) api.Response {
+	c.record("GetFxWeekly", from_symbol, to_symbol, opt_datatype)
+	if c.GetFxWeeklyFunc != nil {
+		return c.GetFxWeeklyFunc(from_symbol, to_symbol, opt_datatype)
+	}
+	return c.response("GetFxWeekly")
+}
+
+func (c *Client) QueryFxWeekly(p alphavantage.FxWeeklyParams) api.Response {
+	c.record("QueryFxWeekly", p)
+	if c.QueryFxWeeklyFunc != nil {
+		return c.QueryFxWeeklyFunc(p)
+	}
+	return c.response("QueryFxWeekly")
+}
+
+func (c *Client) GetFxMonthly(from_symbol, to_symbol, opt_datatype string) api.Response {
+	c.record("GetFxMonthly", from_symbol, to_symbol, opt_datatype)
+	if c.GetFxMonthlyFunc != nil {
+		return c.GetFxMonthlyFunc(from_symbol, to_symbol, opt_datatype)
+	}
+	return c.response("GetFxMonthly")
+}
+
+func (c *Client) QueryFxMonthly(p alphavantage.FxMonthlyParams) api.Response {
+	c.record("QueryFxMonthly", p)
+	if c.QueryFxMonthlyFunc != nil {
+		return c.QueryFxMonthlyFunc(p)
+	}
+	return c.response("QueryFxMonthly")
+}
+
+func (c *Client) GetNewsSentiment(opt_tickers, opt_topics, opt_time_from, opt_time_to, opt_sort, opt_limit string) api.Response {
+	c.record("GetNewsSentiment", opt_tickers, opt_topics, opt_time_from, opt_time_to, opt_sort, opt_limit)
+	if c.GetNewsSentimentFunc != nil {
+		return c.GetNewsSentimentFunc(opt_tickers, opt_topics, opt_time_from, opt_time_to, opt_sort, opt_limit)
+	}
+	return c.response("GetNewsSentiment")
+}
+
+func (c *Client) QueryNewsSentiment(p alphavantage.NewsSentimentParams) api.Response {
+	c.record("QueryNewsSentiment", p)
+	if c.QueryNewsSentimentFunc != nil {
+		return c.QueryNewsSentimentFunc(p)
+	}
+	return c.response("QueryNewsSentiment")
+}
+
+func (c *Client) GetSma(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetSma", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetSmaFunc != nil {
+		return c.GetSmaFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetSma")
+}
+
+func (c *Client) QuerySma(p alphavantage.SmaParams) api.Response {
+	c.record("QuerySma", p)
+	if c.QuerySmaFunc != nil {
+		return c.QuerySmaFunc(p)
+	}
+	return c.response("QuerySma")
+}
+
+func (c *Client) GetEma(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetEma", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetEmaFunc != nil {
+		return c.GetEmaFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetEma")
+}
+
+func (c *Client) QueryEma(p alphavantage.EmaParams) api.Response {
+	c.record("QueryEma", p)
+	if c.QueryEmaFunc != nil {
+		return c.QueryEmaFunc(p)
+	}
+	return c.response("QueryEma")
+}
+
+func (c *Client) GetWma(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetWma", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetWmaFunc != nil {
+		return c.GetWmaFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetWma")
+}
+
+func (c *Client) QueryWma(p alphavantage.WmaParams) api.Response {
+	c.record("QueryWma", p)
+	if c.QueryWmaFunc != nil {
+		return c.QueryWmaFunc(p)
+	}
+	return c.response("QueryWma")
+}
+
+func (c *Client) GetDema(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetDema", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetDemaFunc != nil {
+		return c.GetDemaFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetDema")
+}
+
+func (c *Client) QueryDema(p alphavantage.DemaParams) api.Response {
+	c.record("QueryDema", p)
+	if c.QueryDemaFunc != nil {
+		return c.QueryDemaFunc(p)
+	}
+	return c.response("QueryDema")
+}
+
+func (c *Client) GetTema(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetTema", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetTemaFunc != nil {
+		return c.GetTemaFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetTema")
+}
+
+func (c *Client) QueryTema(p alphavantage.TemaParams) api.Response {
+	c.record("QueryTema", p)
+	if c.QueryTemaFunc != nil {
+		return c.QueryTemaFunc(p)
+	}
+	return c.response("QueryTema")
+}
+
+func (c *Client) GetTrima(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetTrima", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetTrimaFunc != nil {
+		return c.GetTrimaFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetTrima")
+}
+
+func (c *Client) QueryTrima(p alphavantage.TrimaParams) api.Response {
+	c.record("QueryTrima", p)
+	if c.QueryTrimaFunc != nil {
+		return c.QueryTrimaFunc(p)
+	}
+	return c.response("QueryTrima")
+}
+
+func (c *Client) GetKama(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetKama", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetKamaFunc != nil {
+		return c.GetKamaFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetKama")
+}
+
+func (c *Client) QueryKama(p alphavantage.KamaParams) api.Response {
+	c.record("QueryKama", p)
+	if c.QueryKamaFunc != nil {
+		return c.QueryKamaFunc(p)
+	}
+	return c.response("QueryKama")
+}
+
+func (c *Client) GetMama(symbol, interval, series_type, opt_fastlimit, opt_slowlimit, opt_datatype string) api.Response {
+	c.record("GetMama", symbol, interval, series_type, opt_fastlimit, opt_slowlimit, opt_datatype)
+	if c.GetMamaFunc != nil {
+		return c.GetMamaFunc(symbol, interval, series_type, opt_fastlimit, opt_slowlimit, opt_datatype)
+	}
+	return c.response("GetMama")
+}
+
+func (c *Client) QueryMama(p alphavantage.MamaParams) api.Response {
+	c.record("QueryMama", p)
+	if c.QueryMamaFunc != nil {
+		return c.QueryMamaFunc(p)
+	}
+	return c.response("QueryMama")
+}
+
+func (c *Client) GetVwap(symbol, interval, opt_datatype string) api.Response {
+	c.record("GetVwap", symbol, interval, opt_datatype)
+	if c.GetVwapFunc != nil {
+		return c.GetVwapFunc(symbol, interval, opt_datatype)
+	}
+	return c.response("GetVwap")
+}
+
+func (c *Client) QueryVwap(p alphavantage.VwapParams) api.Response {
+	c.record("QueryVwap", p)
+	if c.QueryVwapFunc != nil {
+		return c.QueryVwapFunc(p)
+	}
+	return c.response("QueryVwap")
+}
+
+func (c *Client) GetT3(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetT3", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetT3Func != nil {
+		return c.GetT3Func(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetT3")
+}
+
+func (c *Client) QueryT3(p alphavantage.T3Params) api.Response {
+	c.record("QueryT3", p)
+	if c.QueryT3Func != nil {
+		return c.QueryT3Func(p)
+	}
+	return c.response("QueryT3")
+}
+
+func (c *Client) GetMacd(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_datatype string) api.Response {
+	c.record("GetMacd", symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_datatype)
+	if c.GetMacdFunc != nil {
+		return c.GetMacdFunc(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_datatype)
+	}
+	return c.response("GetMacd")
+}
+
+func (c *Client) QueryMacd(p alphavantage.MacdParams) api.Response {
+	c.record("QueryMacd", p)
+	if c.QueryMacdFunc != nil {
+		return c.QueryMacdFunc(p)
+	}
+	return c.response("QueryMacd")
+}
+
+func (c *Client) GetMacdext(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_fastmatype, opt_slowmatype, opt_signalmatype, opt_datatype string) api.Response {
+	c.record("GetMacdext", symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_fastmatype, opt_slowmatype, opt_signalmatype, opt_datatype)
+	if c.GetMacdextFunc != nil {
+		return c.GetMacdextFunc(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_signalperiod, opt_fastmatype, opt_slowmatype, opt_signalmatype, opt_datatype)
+	}
+	return c.response("GetMacdext")
+}
+
+func (c *Client) QueryMacdext(p alphavantage.MacdextParams) api.Response {
+	c.record("QueryMacdext", p)
+	if c.QueryMacdextFunc != nil {
+		return c.QueryMacdextFunc(p)
+	}
+	return c.response("QueryMacdext")
+}
+
+func (c *Client) GetStoch(symbol, interval, opt_fastkperiod, opt_slowkperiod, opt_slowdperiod, opt_slowkmatype, opt_slowdmatype, opt_datatype string) api.Response {
+	c.record("GetStoch", symbol, interval, opt_fastkperiod, opt_slowkperiod, opt_slowdperiod, opt_slowkmatype, opt_slowdmatype, opt_datatype)
+	if c.GetStochFunc != nil {
+		return c.GetStochFunc(symbol, interval, opt_fastkperiod, opt_slowkperiod, opt_slowdperiod, opt_slowkmatype, opt_slowdmatype, opt_datatype)
+	}
+	return c.response("GetStoch")
+}
+
+func (c *Client) QueryStoch(p alphavantage.StochParams) api.Response {
+	c.record("QueryStoch", p)
+	if c.QueryStochFunc != nil {
+		return c.QueryStochFunc(p)
+	}
+	return c.response("QueryStoch")
+}
+
+func (c *Client) GetStochf(symbol, interval, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype string) api.Response {
+	c.record("GetStochf", symbol, interval, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype)
+	if c.GetStochfFunc != nil {
+		return c.GetStochfFunc(symbol, interval, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype)
+	}
+	return c.response("GetStochf")
+}
+
+func (c *Client) QueryStochf(p alphavantage.StochfParams) api.Response {
+	c.record("QueryStochf", p)
+	if c.QueryStochfFunc != nil {
+		return c.QueryStochfFunc(p)
+	}
+	return c.response("QueryStochf")
+}
+
+func (c *Client) GetRsi(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetRsi", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetRsiFunc != nil {
+		return c.GetRsiFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetRsi")
+}
+
+func (c *Client) QueryRsi(p alphavantage.RsiParams) api.Response {
+	c.record("QueryRsi", p)
+	if c.QueryRsiFunc != nil {
+		return c.QueryRsiFunc(p)
+	}
+	return c.response("QueryRsi")
+}
+
+func (c *Client) GetStochrsi(symbol, interval, time_period, series_type, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype string) api.Response {
+	c.record("GetStochrsi", symbol, interval, time_period, series_type, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype)
+	if c.GetStochrsiFunc != nil {
+		return c.GetStochrsiFunc(symbol, interval, time_period, series_type, opt_fastkperiod, opt_fastdperiod, opt_fastdmatype, opt_datatype)
+	}
+	return c.response("GetStochrsi")
+}
+
+func (c *Client) QueryStochrsi(p alphavantage.StochrsiParams) api.Response {
+	c.record("QueryStochrsi", p)
+	if c.QueryStochrsiFunc != nil {
+		return c.QueryStochrsiFunc(p)
+	}
+	return c.response("QueryStochrsi")
+}
+
+func (c *Client) GetWillr(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetWillr", symbol, interval, time_period, opt_datatype)
+	if c.GetWillrFunc != nil {
+		return c.GetWillrFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetWillr")
+}
+
+func (c *Client) QueryWillr(p alphavantage.WillrParams) api.Response {
+	c.record("QueryWillr", p)
+	if c.QueryWillrFunc != nil {
+		return c.QueryWillrFunc(p)
+	}
+	return c.response("QueryWillr")
+}
+
+func (c *Client) GetAdx(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetAdx", symbol, interval, time_period, opt_datatype)
+	if c.GetAdxFunc != nil {
+		return c.GetAdxFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetAdx")
+}
+
+func (c *Client) QueryAdx(p alphavantage.AdxParams) api.Response {
+	c.record("QueryAdx", p)
+	if c.QueryAdxFunc != nil {
+		return c.QueryAdxFunc(p)
+	}
+	return c.response("QueryAdx")
+}
+
+func (c *Client) GetAdxr(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetAdxr", symbol, interval, time_period, opt_datatype)
+	if c.GetAdxrFunc != nil {
+		return c.GetAdxrFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetAdxr")
+}
+
+func (c *Client) QueryAdxr(p alphavantage.AdxrParams) api.Response {
+	c.record("QueryAdxr", p)
+	if c.QueryAdxrFunc != nil {
+		return c.QueryAdxrFunc(p)
+	}
+	return c.response("QueryAdxr")
+}
+
+func (c *Client) GetApo(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype string) api.Response {
+	c.record("GetApo", symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype)
+	if c.GetApoFunc != nil {
+		return c.GetApoFunc(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype)
+	}
+	return c.response("GetApo")
+}
+
+func (c *Client) QueryApo(p alphavantage.ApoParams) api.Response {
+	c.record("QueryApo", p)
+	if c.QueryApoFunc != nil {
+		return c.QueryApoFunc(p)
+	}
+	return c.response("QueryApo")
+}
+
+func (c *Client) GetPpo(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype string) api.Response {
+	c.record("GetPpo", symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype)
+	if c.GetPpoFunc != nil {
+		return c.GetPpoFunc(symbol, interval, series_type, opt_fastperiod, opt_slowperiod, opt_matype, opt_datatype)
+	}
+	return c.response("GetPpo")
+}
+
+func (c *Client) QueryPpo(p alphavantage.PpoParams) api.Response {
+	c.record("QueryPpo", p)
+	if c.QueryPpoFunc != nil {
+		return c.QueryPpoFunc(p)
+	}
+	return c.response("QueryPpo")
+}
+
+func (c *Client) GetMom(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetMom", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetMomFunc != nil {
+		return c.GetMomFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetMom")
+}
+
+func (c *Client) QueryMom(p alphavantage.MomParams) api.Response {
+	c.record("QueryMom", p)
+	if c.QueryMomFunc != nil {
+		return c.QueryMomFunc(p)
+	}
+	return c.response("QueryMom")
+}
+
+func (c *Client) GetBop(symbol, interval, opt_datatype string) api.Response {
+	c.record("GetBop", symbol, interval, opt_datatype)
+	if c.GetBopFunc != nil {
+		return c.GetBopFunc(symbol, interval, opt_datatype)
+	}
+	return c.response("GetBop")
+}
+
+func (c *Client) QueryBop(p alphavantage.BopParams) api.Response {
+	c.record("QueryBop", p)
+	if c.QueryBopFunc != nil {
+		return c.QueryBopFunc(p)
+	}
+	return c.response("QueryBop")
+}
+
+func (c *Client) GetCci(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetCci", symbol, interval, time_period, opt_datatype)
+	if c.GetCciFunc != nil {
+		return c.GetCciFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetCci")
+}
+
+func (c *Client) QueryCci(p alphavantage.CciParams) api.Response {
+	c.record("QueryCci", p)
+	if c.QueryCciFunc != nil {
+		return c.QueryCciFunc(p)
+	}
+	return c.response("QueryCci")
+}
+
+func (c *Client) GetCmo(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetCmo", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetCmoFunc != nil {
+		return c.GetCmoFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetCmo")
+}
+
+func (c *Client) QueryCmo(p alphavantage.CmoParams) api.Response {
+	c.record("QueryCmo", p)
+	if c.QueryCmoFunc != nil {
+		return c.QueryCmoFunc(p)
+	}
+	return c.response("QueryCmo")
+}
+
+func (c *Client) GetRoc(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetRoc", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetRocFunc != nil {
+		return c.GetRocFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetRoc")
+}
+
+func (c *Client) QueryRoc(p alphavantage.RocParams) api.Response {
+	c.record("QueryRoc", p)
+	if c.QueryRocFunc != nil {
+		return c.QueryRocFunc(p)
+	}
+	return c.response("QueryRoc")
+}
+
+func (c *Client) GetRocr(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetRocr", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetRocrFunc != nil {
+		return c.GetRocrFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetRocr")
+}
+
+func (c *Client) QueryRocr(p alphavantage.RocrParams) api.Response {
+	c.record("QueryRocr", p)
+	if c.QueryRocrFunc != nil {
+		return c.QueryRocrFunc(p)
+	}
+	return c.response("QueryRocr")
+}
+
+func (c *Client) GetAroon(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetAroon", symbol, interval, time_period, opt_datatype)
+	if c.GetAroonFunc != nil {
+		return c.GetAroonFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetAroon")
+}
+
+func (c *Client) QueryAroon(p alphavantage.AroonParams) api.Response {
+	c.record("QueryAroon", p)
+	if c.QueryAroonFunc != nil {
+		return c.QueryAroonFunc(p)
+	}
+	return c.response("QueryAroon")
+}
+
+func (c *Client) GetAroonosc(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetAroonosc", symbol, interval, time_period, opt_datatype)
+	if c.GetAroonoscFunc != nil {
+		return c.GetAroonoscFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetAroonosc")
+}
+
+func (c *Client) QueryAroonosc(p alphavantage.AroonoscParams) api.Response {
+	c.record("QueryAroonosc", p)
+	if c.QueryAroonoscFunc != nil {
+		return c.QueryAroonoscFunc(p)
+	}
+	return c.response("QueryAroonosc")
+}
+
+func (c *Client) GetMfi(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetMfi", symbol, interval, time_period, opt_datatype)
+	if c.GetMfiFunc != nil {
+		return c.GetMfiFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetMfi")
+}
+
+func (c *Client) QueryMfi(p alphavantage.MfiParams) api.Response {
+	c.record("QueryMfi", p)
+	if c.QueryMfiFunc != nil {
+		return c.QueryMfiFunc(p)
+	}
+	return c.response("QueryMfi")
+}
+
+func (c *Client) GetTrix(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetTrix", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetTrixFunc != nil {
+		return c.GetTrixFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetTrix")
+}
+
+func (c *Client) QueryTrix(p alphavantage.TrixParams) api.Response {
+	c.record("QueryTrix", p)
+	if c.QueryTrixFunc != nil {
+		return c.QueryTrixFunc(p)
+	}
+	return c.response("QueryTrix")
+}
+
+func (c *Client) GetUltosc(symbol, interval, opt_timeperiod1, opt_timeperiod2, opt_timeperiod3, opt_datatype string) api.Response {
+	c.record("GetUltosc", symbol, interval, opt_timeperiod1, opt_timeperiod2, opt_timeperiod3, opt_datatype)
+	if c.GetUltoscFunc != nil {
+		return c.GetUltoscFunc(symbol, interval, opt_timeperiod1, opt_timeperiod2, opt_timeperiod3, opt_datatype)
+	}
+	return c.response("GetUltosc")
+}
+
+func (c *Client) QueryUltosc(p alphavantage.UltoscParams) api.Response {
+	c.record("QueryUltosc", p)
+	if c.QueryUltoscFunc != nil {
+		return c.QueryUltoscFunc(p)
+	}
+	return c.response("QueryUltosc")
+}
+
+func (c *Client) GetDx(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetDx", symbol, interval, time_period, opt_datatype)
+	if c.GetDxFunc != nil {
+		return c.GetDxFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetDx")
+}
+
+func (c *Client) QueryDx(p alphavantage.DxParams) api.Response {
+	c.record("QueryDx", p)
+	if c.QueryDxFunc != nil {
+		return c.QueryDxFunc(p)
+	}
+	return c.response("QueryDx")
+}
+
+func (c *Client) GetMinusDi(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetMinusDi", symbol, interval, time_period, opt_datatype)
+	if c.GetMinusDiFunc != nil {
+		return c.GetMinusDiFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetMinusDi")
+}
+
+func (c *Client) QueryMinusDi(p alphavantage.MinusDiParams) api.Response {
+	c.record("QueryMinusDi", p)
+	if c.QueryMinusDiFunc != nil {
+		return c.QueryMinusDiFunc(p)
+	}
+	return c.response("QueryMinusDi")
+}
+
+func (c *Client) GetPlusDi(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetPlusDi", symbol, interval, time_period, opt_datatype)
+	if c.GetPlusDiFunc != nil {
+		return c.GetPlusDiFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetPlusDi")
+}
+
+func (c *Client) QueryPlusDi(p alphavantage.PlusDiParams) api.Response {
+	c.record("QueryPlusDi", p)
+	if c.QueryPlusDiFunc != nil {
+		return c.QueryPlusDiFunc(p)
+	}
+	return c.response("QueryPlusDi")
+}
+
+func (c *Client) GetMinusDm(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetMinusDm", symbol, interval, time_period, opt_datatype)
+	if c.GetMinusDmFunc != nil {
+		return c.GetMinusDmFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetMinusDm")
+}
+
+func (c *Client) QueryMinusDm(p alphavantage.MinusDmParams) api.Response {
+	c.record("QueryMinusDm", p)
+	if c.QueryMinusDmFunc != nil {
+		return c.QueryMinusDmFunc(p)
+	}
+	return c.response("QueryMinusDm")
+}
+
+func (c *Client) GetPlusDm(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetPlusDm", symbol, interval, time_period, opt_datatype)
+	if c.GetPlusDmFunc != nil {
+		return c.GetPlusDmFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetPlusDm")
+}
+
+func (c *Client) QueryPlusDm(p alphavantage.PlusDmParams) api.Response {
+	c.record("QueryPlusDm", p)
+	if c.QueryPlusDmFunc != nil {
+		return c.QueryPlusDmFunc(p)
+	}
+	return c.response("QueryPlusDm")
+}
+
+func (c *Client) GetBbands(symbol, interval, time_period, series_type, opt_nbdevup, opt_nbdevdn, opt_matype, opt_datatype string) api.Response {
+	c.record("GetBbands", symbol, interval, time_period, series_type, opt_nbdevup, opt_nbdevdn, opt_matype, opt_datatype)
+	if c.GetBbandsFunc != nil {
+		return c.GetBbandsFunc(symbol, interval, time_period, series_type, opt_nbdevup, opt_nbdevdn, opt_matype, opt_datatype)
+	}
+	return c.response("GetBbands")
+}
+
+func (c *Client) QueryBbands(p alphavantage.BbandsParams) api.Response {
+	c.record("QueryBbands", p)
+	if c.QueryBbandsFunc != nil {
+		return c.QueryBbandsFunc(p)
+	}
+	return c.response("QueryBbands")
+}
+
+func (c *Client) GetMidpoint(symbol, interval, time_period, series_type, opt_datatype string) api.Response {
+	c.record("GetMidpoint", symbol, interval, time_period, series_type, opt_datatype)
+	if c.GetMidpointFunc != nil {
+		return c.GetMidpointFunc(symbol, interval, time_period, series_type, opt_datatype)
+	}
+	return c.response("GetMidpoint")
+}
+
+func (c *Client) QueryMidpoint(p alphavantage.MidpointParams) api.Response {
+	c.record("QueryMidpoint", p)
+	if c.QueryMidpointFunc != nil {
+		return c.QueryMidpointFunc(p)
+	}
+	return c.response("QueryMidpoint")
+}
+
+func (c *Client) GetMidprice(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetMidprice", symbol, interval, time_period, opt_datatype)
+	if c.GetMidpriceFunc != nil {
+		return c.GetMidpriceFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetMidprice")
+}
+
+func (c *Client) QueryMidprice(p alphavantage.MidpriceParams) api.Response {
+	c.record("QueryMidprice", p)
+	if c.QueryMidpriceFunc != nil {
+		return c.QueryMidpriceFunc(p)
+	}
+	return c.response("QueryMidprice")
+}
+
+func (c *Client) GetSar(symbol, interval, opt_acceleration, opt_maximum, opt_datatype string) api.Response {
+	c.record("GetSar", symbol, interval, opt_acceleration, opt_maximum, opt_datatype)
+	if c.GetSarFunc != nil {
+		return c.GetSarFunc(symbol, interval, opt_acceleration, opt_maximum, opt_datatype)
+	}
+	return c.response("GetSar")
+}
+
+func (c *Client) QuerySar(p alphavantage.SarParams) api.Response {
+	c.record("QuerySar", p)
+	if c.QuerySarFunc != nil {
+		return c.QuerySarFunc(p)
+	}
+	return c.response("QuerySar")
+}
+
+func (c *Client) GetTrange(symbol, interval, opt_datatype string) api.Response {
+	c.record("GetTrange", symbol, interval, opt_datatype)
+	if c.GetTrangeFunc != nil {
+		return c.GetTrangeFunc(symbol, interval, opt_datatype)
+	}
+	return c.response("GetTrange")
+}
+
+func (c *Client) QueryTrange(p alphavantage.TrangeParams) api.Response {
+	c.record("QueryTrange", p)
+	if c.QueryTrangeFunc != nil {
+		return c.QueryTrangeFunc(p)
+	}
+	return c.response("QueryTrange")
+}
+
+func (c *Client) GetAtr(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetAtr", symbol, interval, time_period, opt_datatype)
+	if c.GetAtrFunc != nil {
+		return c.GetAtrFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetAtr")
+}
+
+func (c *Client) QueryAtr(p alphavantage.AtrParams) api.Response {
+	c.record("QueryAtr", p)
+	if c.QueryAtrFunc != nil {
+		return c.QueryAtrFunc(p)
+	}
+	return c.response("QueryAtr")
+}
+
+func (c *Client) GetNatr(symbol, interval, time_period, opt_datatype string) api.Response {
+	c.record("GetNatr", symbol, interval, time_period, opt_datatype)
+	if c.GetNatrFunc != nil {
+		return c.GetNatrFunc(symbol, interval, time_period, opt_datatype)
+	}
+	return c.response("GetNatr")
+}
+
+func (c *Client) QueryNatr(p alphavantage.NatrParams) api.Response {
+	c.record("QueryNatr", p)
+	if c.QueryNatrFunc != nil {
+		return c.QueryNatrFunc(p)
+	}
+	return c.response("QueryNatr")
+}
+
+func (c *Client) GetAd(symbol, interval, opt_datatype string) api.Response {
+	c.record("GetAd", symbol, interval, opt_datatype)
+	if c.GetAdFunc != nil {
+		return c.GetAdFunc(symbol, interval, opt_datatype)
+	}
+	return c.response("GetAd")
+}
+
+func (c *Client) QueryAd(p alphavantage.AdParams) api.Response {
+	c.record("QueryAd", p)
+	if c.QueryAdFunc != nil {
+		return c.QueryAdFunc(p)
+	}
+	return c.response("QueryAd")
+}
+
+func (c *Client) GetAdosc(symbol, interval, opt_fastperiod, opt_slowperiod, opt_datatype string) api.Response {
+	c.record("GetAdosc", symbol, interval, opt_fastperiod, opt_slowperiod, opt_datatype)
+	if c.GetAdoscFunc != nil {
+		return c.GetAdoscFunc(symbol, interval, opt_fastperiod, opt_slowperiod, opt_datatype)
+	}
+	return c.response("GetAdosc")
+}
+
+func (c *Client) QueryAdosc(p alphavantage.AdoscParams) api.Response {
+	c.record("QueryAdosc", p)
+	if c.QueryAdoscFunc != nil {
+		return c.QueryAdoscFunc(p)
+	}
+	return c.response("QueryAdosc")
+}
+
+func (c *Client) GetObv(symbol, interval, opt_datatype string) api.Response {
+	c.record("GetObv", symbol, interval, opt_datatype)
+	if c.GetObvFunc != nil {
+		return c.GetObvFunc(symbol, interval, opt_datatype)
+	}
+	return c.response("GetObv")
+}
+
+func (c *Client) QueryObv(p alphavantage.ObvParams) api.Response {
+	c.record("QueryObv", p)
+	if c.QueryObvFunc != nil {
+		return c.QueryObvFunc(p)
+	}
+	return c.response("QueryObv")
+}
+
+func (c *Client) GetHtTrendline(symbol, interval, series_type, opt_datatype string) api.Response {
+	c.record("GetHtTrendline", symbol, interval, series_type, opt_datatype)
+	if c.GetHtTrendlineFunc != nil {
+		return c.GetHtTrendlineFunc(symbol, interval, series_type, opt_datatype)
+	}
+	return c.response("GetHtTrendline")
+}
+
+func (c *Client) QueryHtTrendline(p alphavantage.HtTrendlineParams) api.Response {
+	c.record("QueryHtTrendline", p)
+	if c.QueryHtTrendlineFunc != nil {
+		return c.QueryHtTrendlineFunc(p)
+	}
+	return c.response("QueryHtTrendline")
+}
+
+func (c *Client) GetHtSine(symbol, interval, series_type, opt_datatype string) api.Response {
+	c.record("GetHtSine", symbol, interval, series_type, opt_datatype)
+	if c.GetHtSineFunc != nil {
+		return c.GetHtSineFunc(symbol, interval, series_type, opt_datatype)
+	}
+	return c.response("GetHtSine")
+}
+
+func (c *Client) QueryHtSine(p alphavantage.HtSineParams) api.Response {
+	c.record("QueryHtSine", p)
+	if c.QueryHtSineFunc != nil {
+		return c.QueryHtSineFunc(p)
+	}
+	return c.response("QueryHtSine")
+}
+
+func (c *Client) GetHtTrendmode(symbol, interval, series_type, opt_datatype string) api.Response {
+	c.record("GetHtTrendmode", symbol, interval, series_type, opt_datatype)
+	if c.GetHtTrendmodeFunc != nil {
+		return c.GetHtTrendmodeFunc(symbol, interval, series_type, opt_datatype)
+	}
+	return c.response("GetHtTrendmode")
+}
+
+func (c *Client) QueryHtTrendmode(p alphavantage.HtTrendmodeParams) api.Response {
+	c.record("QueryHtTrendmode", p)
+	if c.QueryHtTrendmodeFunc != nil {
+		return c.QueryHtTrendmodeFunc(p)
+	}
+	return c.response("QueryHtTrendmode")
+}
+
+func (c *Client) GetHtDcperiod(symbol, interval, series_type, opt_datatype string) api.Response {
+	c.record("GetHtDcperiod", symbol, interval, series_type, opt_datatype)
+	if c.GetHtDcperiodFunc != nil {
+		return c.GetHtDcperiodFunc(symbol, interval, series_type, opt_datatype)
+	}
+	return c.response("GetHtDcperiod")
+}
+
+func (c *Client) QueryHtDcperiod(p alphavantage.HtDcperiodParams) api.Response {
+	c.record("QueryHtDcperiod", p)
+	if c.QueryHtDcperiodFunc != nil {
+		return c.QueryHtDcperiodFunc(p)
+	}
+	return c.response("QueryHtDcperiod")
+}
+
+func (c *Client) GetHtDcphase(symbol, interval, series_type, opt_datatype string) api.Response {
+	c.record("GetHtDcphase", symbol, interval, series_type, opt_datatype)
+	if c.GetHtDcphaseFunc != nil {
+		return c.GetHtDcphaseFunc(symbol, interval, series_type, opt_datatype)
+	}
+	return c.response("GetHtDcphase")
+}
+
+func (c *Client) QueryHtDcphase(p alphavantage.HtDcphaseParams) api.Response {
+	c.record("QueryHtDcphase", p)
+	if c.QueryHtDcphaseFunc != nil {
+		return c.QueryHtDcphaseFunc(p)
+	}
+	return c.response("QueryHtDcphase")
+}
+
+func (c *Client) GetHtPhasor(symbol, interval, series_type, opt_datatype string) api.Response {
+	c.record("GetHtPhasor", symbol, interval, series_type, opt_datatype)
+	if c.GetHtPhasorFunc != nil {
+		return c.GetHtPhasorFunc(symbol, interval, series_type, opt_datatype)
+	}
+	return c.response("GetHtPhasor")
+}
+
+func (c *Client) QueryHtPhasor(p alphavantage.HtPhasorParams) api.Response {
+	c.record("QueryHtPhasor", p)
+	if c.QueryHtPhasorFunc != nil {
+		return c.QueryHtPhasorFunc(p)
+	}
+	return c.response("QueryHtPhasor")
+}
+
+func (c *Client) GetTimeSeriesIntraday(symbol, interval, opt_adjusted, opt_outputsize, opt_datatype string) api.Response {
+	c.record("GetTimeSeriesIntraday", symbol, interval, opt_adjusted, opt_outputsize, opt_datatype)
+	if c.GetTimeSeriesIntradayFunc != nil {
+		return c.GetTimeSeriesIntradayFunc(symbol, interval, opt_adjusted, opt_outputsize, opt_datatype)
+	}
+	return c.response("GetTimeSeriesIntraday")
+}
+
+func (c *Client) QueryTimeSeriesIntraday(p alphavantage.TimeSeriesIntradayParams) api.Response {
+	c.record("QueryTimeSeriesIntraday", p)
+	if c.QueryTimeSeriesIntradayFunc != nil {
+		return c.QueryTimeSeriesIntradayFunc(p)
+	}
+	return c.response("QueryTimeSeriesIntraday")
+}
+
+func (c *Client) GetTimeSeriesIntradayExtended(symbol, interval, slice, opt_adjusted string) api.Response {
+	c.record("GetTimeSeriesIntradayExtended", symbol, interval, slice, opt_adjusted)
+	if c.GetTimeSeriesIntradayExtendedFunc != nil {
+		return c.GetTimeSeriesIntradayExtendedFunc(symbol, interval, slice, opt_adjusted)
+	}
+	return c.response("GetTimeSeriesIntradayExtended")
+}
+
+func (c *Client) QueryTimeSeriesIntradayExtended(p alphavantage.TimeSeriesIntradayExtendedParams) api.Response {
+	c.record("QueryTimeSeriesIntradayExtended", p)
+	if c.QueryTimeSeriesIntradayExtendedFunc != nil {
+		return c.QueryTimeSeriesIntradayExtendedFunc(p)
+	}
+	return c.response("QueryTimeSeriesIntradayExtended")
+}
+
+func (c *Client) GetTimeSeriesDaily(symbol, opt_outputsize, opt_datatype string) api.Response {
+	c.record("GetTimeSeriesDaily", symbol, opt_outputsize, opt_datatype)
+	if c.GetTimeSeriesDailyFunc != nil {
+		return c.GetTimeSeriesDailyFunc(symbol, opt_outputsize, opt_datatype)
+	}
+	return c.response("GetTimeSeriesDaily")
+}
+
+func (c *Client) QueryTimeSeriesDaily(p alphavantage.TimeSeriesDailyParams) api.Response {
+	c.record("QueryTimeSeriesDaily", p)
+	if c.QueryTimeSeriesDailyFunc != nil {
+		return c.QueryTimeSeriesDailyFunc(p)
+	}
+	return c.response("QueryTimeSeriesDaily")
+}
+
+func (c *Client) GetTimeSeriesDailyAdjusted(symbol, opt_outputsize, opt_datatype string) api.Response {
+	c.record("GetTimeSeriesDailyAdjusted", symbol, opt_outputsize, opt_datatype)
+	if c.GetTimeSeriesDailyAdjustedFunc != nil {
+		return c.GetTimeSeriesDailyAdjustedFunc(symbol, opt_outputsize, opt_datatype)
+	}
+	return c.response("GetTimeSeriesDailyAdjusted")
+}
+
+func (c *Client) QueryTimeSeriesDailyAdjusted(p alphavantage.TimeSeriesDailyAdjustedParams) api.Response {
+	c.record("QueryTimeSeriesDailyAdjusted", p)
+	if c.QueryTimeSeriesDailyAdjustedFunc != nil {
+		return c.QueryTimeSeriesDailyAdjustedFunc(p)
+	}
+	return c.response("QueryTimeSeriesDailyAdjusted")
+}
+
+func (c *Client) GetTimeSeriesWeekly(symbol, opt_datatype string) api.Response {
+	c.record("GetTimeSeriesWeekly", symbol, opt_datatype)
+	if c.GetTimeSeriesWeeklyFunc != nil {
+		return c.GetTimeSeriesWeeklyFunc(symbol, opt_datatype)
+	}
+	return c.response("GetTimeSeriesWeekly")
+}
+
+func (c *Client) QueryTimeSeriesWeekly(p alphavantage.TimeSeriesWeeklyParams) api.Response {
+	c.record("QueryTimeSeriesWeekly", p)
+	if c.QueryTimeSeriesWeeklyFunc != nil {
+		return c.QueryTimeSeriesWeeklyFunc(p)
+	}
+	return c.response("QueryTimeSeriesWeekly")
+}
+
+func (c *Client) GetTimeSeriesWeeklyAdjusted(symbol, opt_datatype string) api.Response {
+	c.record("GetTimeSeriesWeeklyAdjusted", symbol, opt_datatype)
+	if c.GetTimeSeriesWeeklyAdjustedFunc != nil {
+		return c.GetTimeSeriesWeeklyAdjustedFunc(symbol, opt_datatype)
+	}
+	return c.response("GetTimeSeriesWeeklyAdjusted")
+}
+
+func (c *Client) QueryTimeSeriesWeeklyAdjusted(p alphavantage.TimeSeriesWeeklyAdjustedParams) api.Response {
+	c.record("QueryTimeSeriesWeeklyAdjusted", p)
+	if c.QueryTimeSeriesWeeklyAdjustedFunc != nil {
+		return c.QueryTimeSeriesWeeklyAdjustedFunc(p)
+	}
+	return c.response("QueryTimeSeriesWeeklyAdjusted")
+}
+
+func (c *Client) GetTimeSeriesMonthly(symbol, opt_datatype string) api.Response {
+	c.record("GetTimeSeriesMonthly", symbol, opt_datatype)
+	if c.GetTimeSeriesMonthlyFunc != nil {
+		return c.GetTimeSeriesMonthlyFunc(symbol, opt_datatype)
+	}
+	return c.response("GetTimeSeriesMonthly")
+}
+
+func (c *Client) QueryTimeSeriesMonthly(p alphavantage.TimeSeriesMonthlyParams) api.Response {
+	c.record("QueryTimeSeriesMonthly", p)
+	if c.QueryTimeSeriesMonthlyFunc != nil {
+		return c.QueryTimeSeriesMonthlyFunc(p)
+	}
+	return c.response("QueryTimeSeriesMonthly")
+}
+
+func (c *Client) GetTimeSeriesMonthlyAdjusted(symbol, opt_datatype string) api.Response {
+	c.record("GetTimeSeriesMonthlyAdjusted", symbol, opt_datatype)
+	if c.GetTimeSeriesMonthlyAdjustedFunc != nil {
+		return c.GetTimeSeriesMonthlyAdjustedFunc(symbol, opt_datatype)
+	}
+	return c.response("GetTimeSeriesMonthlyAdjusted")
+}
+
+func (c *Client) QueryTimeSeriesMonthlyAdjusted(p alphavantage.TimeSeriesMonthlyAdjustedParams) api.Response {
+	c.record("QueryTimeSeriesMonthlyAdjusted", p)
+	if c.QueryTimeSeriesMonthlyAdjustedFunc != nil {
+		return c.QueryTimeSeriesMonthlyAdjustedFunc(p)
+	}
+	return c.response("QueryTimeSeriesMonthlyAdjusted")
+}
+
+func (c *Client) GetGlobalQuote(symbol, opt_datatype string) api.Response {
+	c.record("GetGlobalQuote", symbol, opt_datatype)
+	if c.GetGlobalQuoteFunc != nil {
+		return c.GetGlobalQuoteFunc(symbol, opt_datatype)
+	}
+	return c.response("GetGlobalQuote")
+}
+
+func (c *Client) QueryGlobalQuote(p alphavantage.GlobalQuoteParams) api.Response {
+	c.record("QueryGlobalQuote", p)
+	if c.QueryGlobalQuoteFunc != nil {
+		return c.QueryGlobalQuoteFunc(p)
+	}
+	return c.response("QueryGlobalQuote")
+}
+
+func (c *Client) GetSymbolSearch(keywords, opt_datatype string) api.Response {
+	c.record("GetSymbolSearch", keywords, opt_datatype)
+	if c.GetSymbolSearchFunc != nil {
+		return c.GetSymbolSearchFunc(keywords, opt_datatype)
+	}
+	return c.response("GetSymbolSearch")
+}
+
+func (c *Client) QuerySymbolSearch(p alphavantage.SymbolSearchParams) api.Response {
+	c.record("QuerySymbolSearch", p)
+	if c.QuerySymbolSearchFunc != nil {
+		return c.QuerySymbolSearchFunc(p)
+	}
+	return c.response("QuerySymbolSearch")
+}
+
+func (c *Client) GetMarketStatus() api.Response {
+	c.record("GetMarketStatus")
+	if c.GetMarketStatusFunc != nil {
+		return c.GetMarketStatusFunc()
+	}
+	return c.response("GetMarketStatus")
+}
+
+func (c *Client) QueryMarketStatus(p alphavantage.MarketStatusParams) api.Response {
+	c.record("QueryMarketStatus", p)
+	if c.QueryMarketStatusFunc != nil {
+		return c.QueryMarketStatusFunc(p)
+	}
+	return c.response("QueryMarketStatus")
+}
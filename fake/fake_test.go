@@ -0,0 +1,41 @@
+package fake
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jay9909/alphavantage"
+	"github.com/jay9909/alphavantage/api"
+)
+
+func TestClient(t *testing.T) {
+	client := &Client{}
+	var quotes alphavantage.FundamentalsAPI = client
+
+	if response := quotes.GetOverview("IBM"); !errors.Is(response.Error, ErrNotProgrammed) {
+		t.Errorf("unprogrammed GetOverview error = %v, want ErrNotProgrammed", response.Error)
+	}
+
+	programmed := errors.New("programmed")
+	client.Respond("GetOverview", api.Response{Error: programmed})
+	if response := quotes.GetOverview("MSFT"); response.Error != programmed {
+		t.Errorf("GetOverview error = %v, want the response given to Respond", response.Error)
+	}
+
+	client.GetOverviewFunc = func(symbol string) api.Response {
+		return api.Response{Error: errors.New(symbol)}
+	}
+	if response := quotes.GetOverview("AAPL"); response.Error.Error() != "AAPL" {
+		t.Errorf("GetOverview error = %v, want the result of GetOverviewFunc", response.Error)
+	}
+
+	quotes.QueryOverview(alphavantage.OverviewParams{Symbol: "IBM"})
+
+	calls := client.CallsTo("GetOverview")
+	if len(calls) != 3 || calls[2].Args[0] != "AAPL" {
+		t.Errorf("CallsTo(GetOverview) = %v, want three calls ending with AAPL", calls)
+	}
+	if len(client.Calls()) != 4 {
+		t.Errorf("Calls() = %v, want four calls", client.Calls())
+	}
+}
@@ -5,9 +5,7 @@ go 1.23.0
 require (
 	github.com/PuerkitoBio/goquery v1.8.1
 	golang.org/x/exp v0.0.0-20230519143937-03e91628a987
+	golang.org/x/net v0.38.0
 )
 
-require (
-	github.com/andybalholm/cascadia v1.3.1 // indirect
-	golang.org/x/net v0.38.0 // indirect
-)
+require github.com/andybalholm/cascadia v1.3.1 // indirect
@@ -0,0 +1,169 @@
+package net
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/json"
+	"github.com/jay9909/alphavantage/api"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Cache stores API responses by request key.  Entries are returned whether or not they have expired; the client
+// decides whether an entry is fresh enough to use.  Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) (CacheEntry, bool)
+	Put(key string, entry CacheEntry)
+}
+
+// CacheEntry is a cached API response.
+type CacheEntry struct {
+	Body        []byte
+	ContentType string
+	Datatype    string    // "json" or "csv", as sniffed from the body
+	FetchedAt   time.Time // Whether the entry is still fresh is decided from this by a TTLPolicy
+}
+
+// size is the number of bytes the entry counts for against a cache's size limit.
+func (e CacheEntry) size() int64 {
+	return int64(len(e.Body) + len(e.ContentType))
+}
+
+// response rebuilds the HTTP response the entry was made from, as far as the api decoders are concerned.  Like a
+// response from an HTTP cache, it has an Age header, and a Warning header if it is stale.
+func (e CacheEntry) response(now time.Time, stale bool) *http.Response {
+	header := http.Header{}
+	if e.ContentType != "" {
+		header.Set("Content-Type", e.ContentType)
+	}
+	age := now.Sub(e.FetchedAt)
+	if age < 0 {
+		age = 0
+	}
+	header.Set("Age", strconv.FormatInt(int64(age/time.Second), 10))
+	if stale {
+		header.Set("Warning", api.StaleWarning)
+	}
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+	}
+}
+
+// datatypeOf tells JSON bodies from CSV ones.  Content types are no help: Alpha Vantage sends CSV as
+// application/x-download.
+func datatypeOf(body []byte) string {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') {
+		return "json"
+	}
+	return "csv"
+}
+
+// serviceMessages are the keys of the JSON bodies Alpha Vantage answers with instead of data, with a 200 status,
+// when a request is throttled, premium or invalid.  Those bodies must not be cached.
+var serviceMessages = []string{"Note", "Information", "Error Message"}
+
+// isCacheable reports whether a response with the given status and body holds data worth caching.
+func isCacheable(statusCode int, body []byte) bool {
+	if statusCode != http.StatusOK || len(body) == 0 {
+		return false
+	}
+
+	if datatypeOf(body) == "csv" {
+		return true
+	}
+
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(body, &fields) != nil {
+		return false
+	}
+	for _, message := range serviceMessages {
+		if _, found := fields[message]; found {
+			return false
+		}
+	}
+	return true
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used entries once it holds more than its limits.
+type MemoryCache struct {
+	maxEntries int
+	maxBytes   int64
+
+	mux     sync.Mutex
+	bytes   int64
+	lru     *list.List // Of *memoryEntry, most recently used first
+	entries map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key   string
+	entry CacheEntry
+}
+
+// NewMemoryCache returns a MemoryCache holding at most maxEntries responses of at most maxBytes bytes in total.  A
+// limit of 0 or less means no limit.
+func NewMemoryCache(maxEntries int, maxBytes int64) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+		lru:        list.New(),
+		entries:    map[string]*list.Element{},
+	}
+}
+
+func (c *MemoryCache) Get(key string) (CacheEntry, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	element, found := c.entries[key]
+	if !found {
+		return CacheEntry{}, false
+	}
+	c.lru.MoveToFront(element)
+	return element.Value.(*memoryEntry).entry, true
+}
+
+func (c *MemoryCache) Put(key string, entry CacheEntry) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.maxBytes > 0 && entry.size() > c.maxBytes {
+		return // Would evict everything else and still not fit
+	}
+
+	if element, found := c.entries[key]; found {
+		c.bytes -= element.Value.(*memoryEntry).entry.size()
+		element.Value.(*memoryEntry).entry = entry
+		c.lru.MoveToFront(element)
+	} else {
+		c.entries[key] = c.lru.PushFront(&memoryEntry{key: key, entry: entry})
+	}
+	c.bytes += entry.size()
+
+	for (c.maxEntries > 0 && c.lru.Len() > c.maxEntries) || (c.maxBytes > 0 && c.bytes > c.maxBytes) {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryEntry).key)
+		c.bytes -= oldest.Value.(*memoryEntry).entry.size()
+	}
+}
+
+// Len returns the number of cached responses.
+func (c *MemoryCache) Len() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.lru.Len()
+}
@@ -0,0 +1,255 @@
+package net
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoryCacheEviction(t *testing.T) {
+	cache := NewMemoryCache(2, 0)
+	cache.Put("a", CacheEntry{Body: []byte("a")})
+	cache.Put("b", CacheEntry{Body: []byte("b")})
+	cache.Get("a") // b is now the least recently used
+	cache.Put("c", CacheEntry{Body: []byte("c")})
+
+	if _, found := cache.Get("b"); found {
+		t.Error("least recently used entry b was not evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, found := cache.Get(key); !found {
+			t.Errorf("entry %v was evicted", key)
+		}
+	}
+
+	sized := NewMemoryCache(0, 10)
+	sized.Put("a", CacheEntry{Body: []byte("123456")})
+	sized.Put("b", CacheEntry{Body: []byte("123456")})
+	sized.Put("huge", CacheEntry{Body: []byte("12345678901")})
+	if _, found := sized.Get("a"); found || sized.Len() != 1 {
+		t.Errorf("cache over its byte limit holds %d entries, want only b", sized.Len())
+	}
+}
+
+func TestIsCacheable(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		want       bool
+	}{
+		{"data", 200, `{"Meta Data": {}}`, true},
+		{"csv", 200, "symbol,name\nIBM,International Business Machines\n", true},
+		{"throttled", 200, `{"Note": "Thank you for using Alpha Vantage!"}`, false},
+		{"premium", 200, `{"Information": "This is a premium endpoint."}`, false},
+		{"error", 200, `{"Error Message": "Invalid API call."}`, false},
+		{"server error", 503, `{"Meta Data": {}}`, false},
+		{"empty", 200, "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := isCacheable(test.statusCode, []byte(test.body)); got != test.want {
+				t.Errorf("isCacheable(%d, %q) = %v, want %v", test.statusCode, test.body, got, test.want)
+			}
+		})
+	}
+}
+
+func TestDefaultTTL(t *testing.T) {
+	newYorkTime := func(day, hour, minute int) time.Time {
+		return time.Date(2023, 5, day, hour, minute, 0, 0, newYork)
+	}
+	thursdayMorning := newYorkTime(18, 10, 0)
+
+	tests := []struct {
+		name      string
+		function  string
+		params    map[string]string
+		fetchedAt time.Time
+		want      time.Time
+	}{
+		{"quote", "GLOBAL_QUOTE", nil, thursdayMorning, thursdayMorning.Add(time.Minute)},
+		{"intraday", "TIME_SERIES_INTRADAY", nil, thursdayMorning, thursdayMorning.Add(time.Minute)},
+		{"daily", "TIME_SERIES_DAILY", nil, thursdayMorning, newYorkTime(18, 16, 30)},
+		{"daily after close", "TIME_SERIES_DAILY", nil, newYorkTime(18, 17, 0), newYorkTime(19, 16, 30)},
+		{"daily on friday night", "FX_DAILY", nil, newYorkTime(19, 20, 0), newYorkTime(22, 16, 30)},
+		{"fundamentals", "OVERVIEW", nil, thursdayMorning, thursdayMorning.Add(24 * time.Hour)},
+		{"macro", "CPI", nil, thursdayMorning, thursdayMorning.Add(7 * 24 * time.Hour)},
+		{"intraday indicator", "SMA", map[string]string{"interval": "15min"}, thursdayMorning,
+			thursdayMorning.Add(time.Minute)},
+		{"daily indicator", "SMA", map[string]string{"interval": "daily"}, thursdayMorning,
+			newYorkTime(18, 16, 30)},
+		{"unknown", "SOMETHING_NEW", nil, thursdayMorning, thursdayMorning.Add(UnknownTTL)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := DefaultTTL(test.function, test.params, test.fetchedAt)
+			if !got.Equal(test.want) {
+				t.Errorf("DefaultTTL(%v, %v, %v) = %v, want %v", test.function, test.params, test.fetchedAt, got,
+					test.want)
+			}
+		})
+	}
+}
+
+func TestClientAnswersFromCache(t *testing.T) {
+	now := time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC)
+	cache := NewMemoryCache(0, 0)
+	cache.Put("OVERVIEW?symbol=IBM", CacheEntry{
+		Body:        []byte(`{"Symbol": "IBM"}`),
+		ContentType: "application/json",
+		FetchedAt:   now.Add(-time.Hour),
+	})
+
+	client := NewClient("demo", 5, 500, WithCache(cache), WithClock(newFakeClock(now)))
+
+	// The entry is an hour old, well within the day fundamentals stay fresh, so no request is sent.
+	response := client.Query("OVERVIEW", map[string]string{"symbol": "IBM", "datatype": ""})
+	if response.Error != nil {
+		t.Fatalf("cached response error = %v", response.Error)
+	}
+	body, _ := io.ReadAll(response.Response.Body)
+	if string(body) != `{"Symbol": "IBM"}` {
+		t.Errorf("cached body = %q", body)
+	}
+	if contentType := response.Response.Header.Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("cached content type = %q", contentType)
+	}
+}
+
+func TestOfflineClient(t *testing.T) {
+	cache := NewMemoryCache(0, 0)
+	cache.Put("GLOBAL_QUOTE?symbol=IBM", CacheEntry{
+		Body:      []byte(`{"Global Quote": {}}`),
+		FetchedAt: time.Date(2020, 1, 2, 10, 0, 0, 0, time.UTC),
+	})
+	client := NewClient("demo", 5, 500, WithCache(cache), Offline())
+
+	// Years past its minute of freshness, but still served.
+	response := client.Query("GLOBAL_QUOTE", map[string]string{"symbol": "IBM"})
+	if response.Error != nil {
+		t.Fatalf("expired cached response error = %v", response.Error)
+	}
+
+	if !response.IsStale() || response.Age() < 24*time.Hour {
+		t.Errorf("expired cached response has age %v and stale %v, want it marked stale", response.Age(),
+			response.IsStale())
+	}
+
+	response = client.Query("GLOBAL_QUOTE", map[string]string{"symbol": "MSFT"})
+	if !errors.Is(response.Error, ErrNotCached) {
+		t.Errorf("uncached response error = %v, want ErrNotCached", response.Error)
+	}
+
+	uncached := NewClient("demo", 5, 500, Offline())
+	response = uncached.Query("GLOBAL_QUOTE", map[string]string{"symbol": "IBM"})
+	if !errors.Is(response.Error, ErrNotCached) {
+		t.Errorf("response error without a cache = %v, want ErrNotCached", response.Error)
+	}
+}
+
+func TestStaleWhileRevalidate(t *testing.T) {
+	now := time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC)
+	cache := NewMemoryCache(0, 0)
+	cache.Put("GLOBAL_QUOTE?symbol=IBM", CacheEntry{Body: []byte(`{"old": true}`), FetchedAt: now.Add(-5 * time.Minute)})
+
+	client := NewClient("demo", 5, 500, WithCache(cache), StaleWhileRevalidate(), WithClock(newFakeClock(now)))
+
+	var gets atomic.Int32
+	release := make(chan struct{})
+	client.reqPool.send = func(*http.Request) (*http.Response, error) {
+		gets.Add(1)
+		<-release
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"new": true}`)),
+		}, nil
+	}
+
+	// Several readers of the stale quote are answered at once, while a single refetch waits on the network.
+	for i := 0; i < 3; i++ {
+		response := client.Query("GLOBAL_QUOTE", map[string]string{"symbol": "IBM"})
+		if !response.IsStale() || response.Age() != 5*time.Minute {
+			t.Errorf("stale response has age %v and stale %v, want a 5 minute old stale response", response.Age(),
+				response.IsStale())
+		}
+	}
+	close(release)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		entry, _ := cache.Get("GLOBAL_QUOTE?symbol=IBM")
+		if string(entry.Body) == `{"new": true}` {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the stale entry was not refreshed")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if gets.Load() != 1 {
+		t.Errorf("refetched %d times, want once", gets.Load())
+	}
+
+	response := client.Query("GLOBAL_QUOTE", map[string]string{"symbol": "IBM"})
+	if response.IsStale() {
+		t.Error("refreshed response is still marked stale")
+	}
+}
+
+func TestStaleWhileRevalidateZeroTTL(t *testing.T) {
+	now := time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC)
+	cache := NewMemoryCache(0, 0)
+	cache.Put("GLOBAL_QUOTE?symbol=IBM", CacheEntry{Body: []byte(`{"old": true}`), FetchedAt: now.Add(-5 * time.Minute)})
+
+	client := NewClient("demo", 5, 500, WithCache(cache), StaleWhileRevalidate(), WithClock(newFakeClock(now)))
+	client.reqPool.send = func(*http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"new": true}`)),
+		}, nil
+	}
+
+	// A TTL of 0 forces the request, rather than answering the stale quote and refetching it in the background.
+	response := client.QueryWithTTL("GLOBAL_QUOTE", map[string]string{"symbol": "IBM"}, 0)
+	if response.Error != nil {
+		t.Fatal(response.Error)
+	}
+	if body, _ := io.ReadAll(response.Response.Body); string(body) != `{"new": true}` {
+		t.Errorf("body = %s, want the response sent in the foreground", body)
+	}
+	if response.IsStale() {
+		t.Error("forced response is marked stale")
+	}
+	if entry, _ := cache.Get("GLOBAL_QUOTE?symbol=IBM"); string(entry.Body) != `{"new": true}` {
+		t.Errorf("cached body = %s, want the forced response", entry.Body)
+	}
+}
+
+func TestOfflineClientTTL(t *testing.T) {
+	now := time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC)
+	cache := NewMemoryCache(0, 0)
+	cache.Put("OVERVIEW?symbol=IBM", CacheEntry{Body: []byte(`{"Symbol": "IBM"}`), FetchedAt: now.Add(-time.Hour)})
+	client := NewClient("demo", 5, 500, WithCache(cache), Offline(), WithClock(newFakeClock(now)))
+
+	// Fresh for the day fundamentals are kept, but not for a per-call TTL of a minute, and the other way around.
+	params := map[string]string{"symbol": "IBM"}
+	if response := client.Query("OVERVIEW", params); response.Error != nil || response.IsStale() {
+		t.Errorf("response with the client's TTL has error %v and stale %v, want fresh", response.Error,
+			response.IsStale())
+	}
+	if response := client.QueryWithTTL("OVERVIEW", params, time.Minute); response.Error != nil || !response.IsStale() {
+		t.Errorf("response with a minute TTL has error %v and stale %v, want stale", response.Error, response.IsStale())
+	}
+	if response := client.QueryWithTTL("OVERVIEW", params, 2*time.Hour); response.Error != nil || response.IsStale() {
+		t.Errorf("response with a 2 hour TTL has error %v and stale %v, want fresh", response.Error, response.IsStale())
+	}
+}
@@ -1,48 +1,241 @@
 package net
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"github.com/jay9909/alphavantage/api"
-	"net/url"
+	"io"
+	"net/http"
 	"strings"
+	"sync"
+	"time"
 )
 
 const baseUrl = "https://www.alphavantage.co/query?"
 
 type Client struct {
 	apiKey    string
+	baseUrl   string
 	rateLimit int  // Currently 5, 75, 150, 300, 600, or 1200 requests per minute
 	dayCap    int  // The free API tier is capped at 500 requests/day.  Paid tiers are not capped.
 	reqPool   pool // Pool of requesters.
+
+	cache   Cache     // Responses are not cached if nil
+	ttl     TTLPolicy // How long cached responses stay fresh
+	offline bool      // Only answer from the cache
+	clock   Clock
+
+	staleWhileRevalidate bool
+	refreshMux           sync.Mutex
+	refreshing           map[string]bool // Keys with a background refetch pending
+}
+
+// ErrNotCached answers requests an Offline client has no cached response for.
+var ErrNotCached = errors.New("offline and the response is not cached")
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithCache makes the client answer requests from cache while the cached response is fresh, and store new
+// responses in it.  Throttle notes, error messages and other non-data responses are never cached.
+func WithCache(cache Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithTTLPolicy replaces DefaultTTL as the policy deciding how long cached responses stay fresh.
+func WithTTLPolicy(policy TTLPolicy) Option {
+	return func(c *Client) {
+		c.ttl = policy
+	}
+}
+
+// Offline makes the client answer only from its cache, without ever sending a request.  Cached responses are used
+// however old they are, and requests that are not cached fail with ErrNotCached.  Use it with WithCache and a cache
+// filled by earlier runs, such as a DiskCache.
+func Offline() Option {
+	return func(c *Client) {
+		c.offline = true
+	}
+}
+
+// StaleWhileRevalidate makes the client answer with a cached response even after it expires, rather than wait for
+// the rate limiter.  The response is marked stale (see api.Response.IsStale) and the request is refetched in the
+// background, behind every request that is waited for, to refresh the cache for later readers.
+func StaleWhileRevalidate() Option {
+	return func(c *Client) {
+		c.staleWhileRevalidate = true
+	}
 }
 
-func NewClient(apiKey string, rateLimit, dayCap int) *Client {
-	return &Client{
-		apiKey:    apiKey,
-		rateLimit: rateLimit,
-		dayCap:    dayCap,
-		reqPool:   newPool(rateLimit),
+// WithTransport sends requests through transport instead of http.DefaultTransport, e.g. a Recorder in tests.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.reqPool.send = (&http.Client{Transport: transport}).Do
 	}
 }
 
-// Query sends the given request to the Alphavantage service.  Note: params should NOT include the function or apiKey
-// parameter key/value pairs.
+// WithClock replaces the system clock, which spaces requests and dates cached responses and their use in a DiskCache,
+// e.g. with a fake one in tests.
+func WithClock(clock Clock) Option {
+	return func(c *Client) {
+		c.clock = clock
+	}
+}
+
+// WithBaseURL sends requests to url, e.g. the query endpoint of an avtest.Server, instead of the Alpha Vantage
+// service.
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		c.baseUrl = strings.TrimSuffix(url, "?") + "?"
+	}
+}
+
+func NewClient(apiKey string, rateLimit, dayCap int, options ...Option) *Client {
+	client := &Client{
+		apiKey:     apiKey,
+		baseUrl:    baseUrl,
+		rateLimit:  rateLimit,
+		dayCap:     dayCap,
+		reqPool:    newPool(rateLimit, dayCap),
+		ttl:        DefaultTTL,
+		clock:      systemClock{},
+		refreshing: map[string]bool{},
+	}
+	for _, option := range options {
+		option(client)
+	}
+	client.reqPool.limiter.clock = client.clock
+	if cache, ok := client.cache.(interface{ useClock(Clock) }); ok {
+		cache.useClock(client.clock)
+	}
+	return client
+}
+
+// Query sends the given request to the Alphavantage service, or answers it from the cache if the client has one
+// holding a fresh response.  Note: params should NOT include the function or apiKey parameter key/value pairs.
 func (c *Client) Query(function string, params map[string]string) api.Response {
-	var urlBuilder strings.Builder
-	urlBuilder.WriteString(baseUrl)
-	urlBuilder.WriteString(fmt.Sprintf("function=%v", function))
-	urlBuilder.WriteString(fmt.Sprintf("&apikey=%v", c.apiKey))
-
-	for key, value := range params {
-		if value != "" && key != "function" && key != "apikey" {
-			urlBuilder.WriteString(
-				fmt.Sprintf("&%v=%v", url.QueryEscape(key), url.QueryEscape(value)))
+	return c.query(context.Background(), NewRequest(function, params), c.ttl, c.staleWhileRevalidate)
+}
+
+// QueryWithTTL is Query with the freshness of cached responses decided by ttl rather than by the client's
+// TTLPolicy: a cached response is only used if it was fetched less than ttl ago.  A ttl of 0 or less always sends the
+// request, although the response is still cached, even if the client serves stale responses.
+func (c *Client) QueryWithTTL(function string, params map[string]string, ttl time.Duration) api.Response {
+	return c.query(context.Background(), NewRequest(function, params), FixedTTL(ttl), c.staleWhileRevalidate && ttl > 0)
+}
+
+// Do is Query for a request already in canonical form.
+func (c *Client) Do(request Request) api.Response {
+	return c.query(context.Background(), request, c.ttl, c.staleWhileRevalidate)
+}
+
+// DoContext is Do giving up once ctx is done, whether the request is waiting on the rate limit or being sent.
+func (c *Client) DoContext(ctx context.Context, request Request) api.Response {
+	return c.query(ctx, request, c.ttl, c.staleWhileRevalidate)
+}
+
+// query answers request from the cache while ttl keeps it fresh.  A stale response is answered as well, and refetched
+// in the background, if staleWhileRevalidate.
+func (c *Client) query(ctx context.Context, request Request, ttl TTLPolicy, staleWhileRevalidate bool) api.Response {
+	if c.offline {
+		return c.cached(request, ttl)
+	}
+	if c.cache == nil {
+		return c.send(ctx, request)
+	}
+
+	key := request.Key()
+	entry, found := c.cache.Get(key)
+	if found {
+		now := c.clock.Now()
+		if now.Before(ttl(request.Function(), request.Params(), entry.FetchedAt)) {
+			return api.Response{Response: entry.response(now, false)}
+		}
+		if staleWhileRevalidate {
+			c.revalidate(request)
+			return api.Response{Response: entry.response(now, true)}
 		}
 	}
 
-	return c.reqPool.sendRequest(urlBuilder.String())
+	return c.fetch(c.send(ctx, request), key)
+}
+
+func (c *Client) send(ctx context.Context, request Request) api.Response {
+	return c.reqPool.sendRequest(ctx, request, request.URL(c.baseUrl, c.apiKey))
+}
+
+// fetch caches the response to the request with the given key, if it holds data.  The body is read for that, and
+// replaced with a reader over the copy.
+func (c *Client) fetch(response api.Response, key string) api.Response {
+	if response.Error != nil {
+		return response
+	}
+	fetchedAt := c.clock.Now()
+
+	body, err := io.ReadAll(response.Response.Body)
+	_ = response.Response.Body.Close()
+	if err != nil {
+		return api.Response{Error: fmt.Errorf("could not read response body: %w", err)}
+	}
+	response.Response.Body = io.NopCloser(bytes.NewReader(body))
+
+	if isCacheable(response.Response.StatusCode, body) {
+		c.cache.Put(key, CacheEntry{
+			Body:        body,
+			ContentType: response.Response.Header.Get("Content-Type"),
+			Datatype:    datatypeOf(body),
+			FetchedAt:   fetchedAt,
+		})
+	}
+
+	return response
+}
+
+// revalidate refetches request in the background, at low priority, to replace a stale cache entry.  Only one refetch
+// of a request is ever pending, however many readers find it stale.
+func (c *Client) revalidate(request Request) {
+	key := request.Key()
+
+	c.refreshMux.Lock()
+	defer c.refreshMux.Unlock()
+
+	if c.refreshing[key] {
+		return
+	}
+	c.refreshing[key] = true
+
+	go func() {
+		defer func() {
+			c.refreshMux.Lock()
+			delete(c.refreshing, key)
+			c.refreshMux.Unlock()
+		}()
+
+		response, sent := c.reqPool.sendRefresh(request, request.URL(c.baseUrl, c.apiKey))
+		if sent {
+			c.fetch(response, key)
+		}
+	}()
+}
+
+// cached answers a request from the cache alone, for Offline clients, marking it stale once ttl says so.
+func (c *Client) cached(request Request, ttl TTLPolicy) api.Response {
+	key := request.Key()
+	if c.cache != nil {
+		if entry, found := c.cache.Get(key); found {
+			now := c.clock.Now()
+			stale := !now.Before(ttl(request.Function(), request.Params(), entry.FetchedAt))
+			return api.Response{Response: entry.response(now, stale)}
+		}
+	}
+	return api.Response{Error: fmt.Errorf("%v: %w", key, ErrNotCached)}
 }
 
+// Close stops the client once the requests being sent are answered.  Requests made later fail with ErrClosed.
 func (c *Client) Close() {
 	c.reqPool.close()
 }
@@ -0,0 +1,292 @@
+package net
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DiskCache is a Cache keeping responses in files under a directory, so that they outlive the process.  Several
+// processes may share the directory: files are replaced atomically, so a reader sees either the old or the new
+// response, and a file evicted by another process is just a miss.
+//
+// Each response is one file, DIR/FUNCTION/HASH.entry, where HASH is the SHA-256 of the request key.  The file holds
+// a line of JSON metadata followed by the body exactly as received.  Once the files add up to more than the size
+// limit, the least recently used ones are removed.
+type DiskCache struct {
+	// ErrorHandler, if set, is called with the errors Get and Put otherwise ignore, such as unreadable or corrupt
+	// files and failed writes.  The cache goes on either way: a file it cannot use is a miss.  Set it before use.
+	ErrorHandler func(error)
+
+	dir      string
+	maxBytes int64
+
+	mux   sync.Mutex
+	clock Clock // The client's, for the last use times of files
+	bytes int64 // Estimate of the size of the directory; only files written by this process are counted
+}
+
+// diskMetadata is the first line of a cache file.
+type diskMetadata struct {
+	Key         string    `json:"key"`
+	FetchedAt   time.Time `json:"fetchedAt"`
+	ContentType string    `json:"contentType,omitempty"`
+	Datatype    string    `json:"datatype"`
+}
+
+const (
+	diskEntrySuffix = ".entry"
+	diskTempPrefix  = ".tmp-"
+
+	// Temporary files older than this were left by a process that died while writing, and are removed by eviction.
+	abandonedTempAge = time.Hour
+)
+
+// NewDiskCache returns a DiskCache in dir, creating the directory if needed.  A maxBytes of 0 or less means no size
+// limit.
+func NewDiskCache(dir string, maxBytes int64) (*DiskCache, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("could not create cache directory: %w", err)
+	}
+
+	cache := &DiskCache{dir: dir, maxBytes: maxBytes, clock: systemClock{}}
+	cache.bytes, err = cache.scan(nil, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("could not read cache directory: %w", err)
+	}
+	return cache, nil
+}
+
+// path returns the file a response to key is kept in.
+func (c *DiskCache) path(key string) string {
+	function, _, _ := strings.Cut(key, "?")
+	function = strings.Map(func(r rune) rune {
+		if (r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '-' {
+			return r
+		}
+		return '_'
+	}, function)
+	if function == "" {
+		function = "_"
+	}
+
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, function, hex.EncodeToString(hash[:])+diskEntrySuffix)
+}
+
+func (c *DiskCache) Get(key string) (CacheEntry, bool) {
+	path := c.path(key)
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			c.handleError(fmt.Errorf("could not read cache file %v: %w", path, err))
+		}
+		return CacheEntry{}, false
+	}
+
+	header, body, found := bytes.Cut(contents, []byte("\n"))
+	var metadata diskMetadata
+	if !found || json.Unmarshal(header, &metadata) != nil {
+		c.handleError(fmt.Errorf("ignoring corrupt cache file %v", path))
+		return CacheEntry{}, false
+	}
+	if metadata.Key != key {
+		return CacheEntry{}, false // Hash collision
+	}
+
+	// The modification time doubles as the last use, for eviction.
+	now := c.now()
+	_ = os.Chtimes(path, now, now)
+
+	return CacheEntry{
+		Body:        body,
+		ContentType: metadata.ContentType,
+		Datatype:    metadata.Datatype,
+		FetchedAt:   metadata.FetchedAt,
+	}, true
+}
+
+func (c *DiskCache) Put(key string, entry CacheEntry) {
+	path := c.path(key)
+	var replaced int64 // Size of the entry being overwritten, which no longer counts
+	if info, err := os.Stat(path); err == nil {
+		replaced = info.Size()
+	}
+
+	size, err := writeDiskEntry(path, diskMetadata{
+		Key:         key,
+		FetchedAt:   entry.FetchedAt,
+		ContentType: entry.ContentType,
+		Datatype:    entry.Datatype,
+	}, entry.Body)
+	if err != nil {
+		c.handleError(fmt.Errorf("could not cache %v: %w", key, err))
+		return
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.bytes += size - replaced
+	if c.maxBytes > 0 && c.bytes > c.maxBytes {
+		c.evict(path)
+	}
+}
+
+// writeDiskEntry writes the metadata and body to a temporary file next to path, then renames it over path so that
+// no reader ever sees a partly written entry.  It returns the size of the file.
+func writeDiskEntry(path string, metadata diskMetadata, body []byte) (size int64, err error) {
+	header, err := json.Marshal(metadata)
+	if err != nil {
+		return 0, err
+	}
+
+	dir := filepath.Dir(path)
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return 0, err
+	}
+
+	temp, err := os.CreateTemp(dir, diskTempPrefix+"*")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = temp.Close()
+			_ = os.Remove(temp.Name())
+		}
+	}()
+
+	writer := bufio.NewWriter(temp)
+	_, _ = writer.Write(header)
+	_ = writer.WriteByte('\n')
+	_, _ = writer.Write(body)
+	err = writer.Flush()
+	if err != nil {
+		return 0, err
+	}
+	err = temp.Sync()
+	if err != nil {
+		return 0, err
+	}
+	err = temp.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	err = os.Rename(temp.Name(), path)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(header) + 1 + len(body)), nil
+}
+
+// diskFile is a cache file found by scan.
+type diskFile struct {
+	path    string
+	size    int64
+	lastUse time.Time
+}
+
+// scan returns the total size of the cache files, and appends them to files if it is not nil.  Temporary files
+// abandoned before now are removed along the way.
+func (c *DiskCache) scan(files *[]diskFile, now time.Time) (int64, error) {
+	var total int64
+	err := filepath.WalkDir(c.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil // Removed by another process
+			}
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil // Removed by another process
+		}
+
+		if strings.HasPrefix(entry.Name(), diskTempPrefix) {
+			if now.Sub(info.ModTime()) > abandonedTempAge {
+				_ = os.Remove(path)
+			}
+			return nil
+		}
+		if !strings.HasSuffix(entry.Name(), diskEntrySuffix) {
+			return nil
+		}
+
+		total += info.Size()
+		if files != nil {
+			*files = append(*files, diskFile{path: path, size: info.Size(), lastUse: info.ModTime()})
+		}
+		return nil
+	})
+	return total, err
+}
+
+// evict removes the least recently used files until the cache fits in its size limit again.  The directory is
+// rescanned first, so files written or removed by other processes are accounted for.  The file at keep, which was
+// just written, is only removed if it does not fit on its own.  c.mux must be held.
+func (c *DiskCache) evict(keep string) {
+	var files []diskFile
+	total, err := c.scan(&files, c.clock.Now())
+	if err != nil {
+		c.handleError(fmt.Errorf("could not scan cache directory %v: %w", c.dir, err))
+		return
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		if (files[i].path == keep) != (files[j].path == keep) {
+			return files[j].path == keep // keep goes last
+		}
+		return files[i].lastUse.Before(files[j].lastUse)
+	})
+
+	for _, file := range files {
+		if total <= c.maxBytes {
+			break
+		}
+		err = os.Remove(file.path)
+		if err == nil || errors.Is(err, fs.ErrNotExist) {
+			total -= file.size
+		}
+	}
+	c.bytes = total
+}
+
+// useClock makes the cache date the last use of files with the client's clock.
+func (c *DiskCache) useClock(clock Clock) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.clock = clock
+}
+
+func (c *DiskCache) now() time.Time {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.clock.Now()
+}
+
+func (c *DiskCache) handleError(err error) {
+	if c.ErrorHandler != nil {
+		c.ErrorHandler(err)
+	}
+}
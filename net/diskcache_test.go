@@ -0,0 +1,246 @@
+package net
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDiskCacheRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	fetchedAt := time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC)
+
+	cache, err := NewDiskCache(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.Put("LISTING_STATUS?state=delisted", CacheEntry{
+		Body:        []byte("symbol,name\nAAA,A\n"),
+		ContentType: "application/x-download",
+		Datatype:    "csv",
+		FetchedAt:   fetchedAt,
+	})
+
+	// A new instance, as in the next run of a script, finds the entry.
+	reopened, err := NewDiskCache(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, found := reopened.Get("LISTING_STATUS?state=delisted")
+	if !found {
+		t.Fatal("entry not found after reopening the cache")
+	}
+	if string(entry.Body) != "symbol,name\nAAA,A\n" || entry.ContentType != "application/x-download" ||
+		entry.Datatype != "csv" || !entry.FetchedAt.Equal(fetchedAt) {
+		t.Errorf("entry = %+v, want the one put", entry)
+	}
+
+	if _, found := reopened.Get("LISTING_STATUS?state=active"); found {
+		t.Error("found an entry that was never put")
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "LISTING_STATUS", "*"))
+	if len(files) != 1 || !strings.HasSuffix(files[0], diskEntrySuffix) {
+		t.Errorf("files in the function's directory = %v, want a single entry", files)
+	}
+}
+
+func TestDiskCacheEviction(t *testing.T) {
+	dir := t.TempDir()
+	body := []byte(strings.Repeat("x", 1000))
+
+	cache, err := NewDiskCache(dir, 2500)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache.Put("OVERVIEW?symbol=A", CacheEntry{Body: body})
+	cache.Put("OVERVIEW?symbol=B", CacheEntry{Body: body})
+	// Make A the least recently used, whatever the resolution of the file system's timestamps.
+	old := time.Now().Add(-time.Hour)
+	_ = os.Chtimes(cache.path("OVERVIEW?symbol=A"), old, old)
+
+	cache.Put("OVERVIEW?symbol=C", CacheEntry{Body: body})
+
+	if _, found := cache.Get("OVERVIEW?symbol=A"); found {
+		t.Error("least recently used entry A was not evicted")
+	}
+	for _, key := range []string{"OVERVIEW?symbol=B", "OVERVIEW?symbol=C"} {
+		if _, found := cache.Get(key); !found {
+			t.Errorf("entry %v was evicted", key)
+		}
+	}
+}
+
+func TestDiskCacheOverwrite(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir(), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Refreshing one entry replaces its file, so the size estimate stays that of a single file rather than growing
+	// towards the limit, where every Put would rescan the directory.
+	for i := 0; i < 5; i++ {
+		cache.Put("OVERVIEW?symbol=A", CacheEntry{Body: []byte(strings.Repeat("x", 1000))})
+	}
+	info, err := os.Stat(cache.path("OVERVIEW?symbol=A"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cache.bytes != info.Size() {
+		t.Errorf("size estimate = %d bytes, want the %d of the entry's file", cache.bytes, info.Size())
+	}
+}
+
+// diskCacheWriterEnv makes the test binary run diskCacheWriter instead of the tests, to act as another process
+// sharing the cache directory named by the variable.
+const diskCacheWriterEnv = "DISKCACHE_TEST_WRITER_DIR"
+
+func TestMain(m *testing.M) {
+	if dir := os.Getenv(diskCacheWriterEnv); dir != "" {
+		os.Exit(diskCacheWriter(dir))
+	}
+	os.Exit(m.Run())
+}
+
+// diskCacheWriter repeatedly replaces and reads back a few entries of the cache in dir.  It returns the exit status
+// of the process: 1 if it read a partly written entry or met any other error.
+func diskCacheWriter(dir string) int {
+	status := 0
+	cache, err := NewDiskCache(dir, 0)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	cache.ErrorHandler = func(err error) {
+		fmt.Fprintln(os.Stderr, err)
+		status = 1
+	}
+
+	for j := 0; j < 200; j++ {
+		key := fmt.Sprintf("GLOBAL_QUOTE?symbol=S%d", j%5)
+		body := []byte(fmt.Sprintf(`{"writer": %d, "j": %d}`, os.Getpid(), j))
+		cache.Put(key, CacheEntry{Body: body, Datatype: "json"})
+		if entry, found := cache.Get(key); found && !strings.HasSuffix(string(entry.Body), "}") {
+			fmt.Fprintf(os.Stderr, "read a partly written entry: %q\n", entry.Body)
+			status = 1
+		}
+	}
+	return status
+}
+
+func TestDiskCacheConcurrentProcesses(t *testing.T) {
+	dir := t.TempDir()
+
+	var writers []*exec.Cmd
+	var outputs []*bytes.Buffer
+	for i := 0; i < 4; i++ {
+		writer := exec.Command(os.Args[0], "-test.run=^$")
+		writer.Env = append(os.Environ(), diskCacheWriterEnv+"="+dir)
+		output := &bytes.Buffer{}
+		writer.Stdout, writer.Stderr = output, output
+		if err := writer.Start(); err != nil {
+			t.Fatal(err)
+		}
+		writers = append(writers, writer)
+		outputs = append(outputs, output)
+	}
+	for i, writer := range writers {
+		if err := writer.Wait(); err != nil {
+			t.Errorf("writer %d failed: %v\n%s", i, err, outputs[i])
+		}
+	}
+
+	var leftovers []string
+	_ = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err == nil && strings.HasPrefix(info.Name(), diskTempPrefix) {
+			leftovers = append(leftovers, path)
+		}
+		return nil
+	})
+	if len(leftovers) > 0 {
+		t.Errorf("temporary files left behind: %v", leftovers)
+	}
+
+	cache, err := NewDiskCache(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for j := 0; j < 5; j++ {
+		if _, found := cache.Get(fmt.Sprintf("GLOBAL_QUOTE?symbol=S%d", j)); !found {
+			t.Errorf("entry S%d missing after the writers finished", j)
+		}
+	}
+}
+
+func TestDiskCacheErrors(t *testing.T) {
+	cache, err := NewDiskCache(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var errs []error
+	cache.ErrorHandler = func(err error) {
+		errs = append(errs, err)
+	}
+
+	// A missing file is a plain miss.
+	if _, found := cache.Get("OVERVIEW?symbol=IBM"); found || len(errs) != 0 {
+		t.Errorf("missing entry found %v, errors %v", found, errs)
+	}
+
+	path := cache.path("OVERVIEW?symbol=IBM")
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(path, []byte("not metadata"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, found := cache.Get("OVERVIEW?symbol=IBM"); found || len(errs) != 1 ||
+		!strings.Contains(errs[0].Error(), "corrupt cache file") {
+		t.Errorf("corrupt entry found %v, errors %v", found, errs)
+	}
+
+	// A directory where the function's directory should be makes writes fail.
+	if err = os.WriteFile(filepath.Join(cache.dir, "EARNINGS"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	cache.Put("EARNINGS?symbol=IBM", CacheEntry{Body: []byte("{}")})
+	if len(errs) != 2 || !strings.Contains(errs[1].Error(), "could not cache EARNINGS?symbol=IBM") {
+		t.Errorf("errors after a failed write = %v", errs)
+	}
+
+	// Without a handler, errors are only misses.
+	cache.ErrorHandler = nil
+	if _, found := cache.Get("OVERVIEW?symbol=IBM"); found {
+		t.Error("corrupt entry found")
+	}
+}
+
+func TestDiskCacheClock(t *testing.T) {
+	clock := newFakeClock(time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC))
+	cache, err := NewDiskCache(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient("demo", 0, 0, WithCache(cache), WithClock(clock))
+	defer client.Close()
+
+	cache.Put("OVERVIEW?symbol=IBM", CacheEntry{Body: []byte("{}")})
+	clock.Advance(time.Hour)
+	if _, found := cache.Get("OVERVIEW?symbol=IBM"); !found {
+		t.Fatal("entry not found")
+	}
+
+	info, err := os.Stat(cache.path("OVERVIEW?symbol=IBM"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := clock.Now(); !info.ModTime().Equal(want) {
+		t.Errorf("last use = %v, want the client's time %v", info.ModTime(), want)
+	}
+}
@@ -0,0 +1,144 @@
+package net
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// rateWindow is the period the per-minute rate limit is applied over.  The extra seconds make up for the difference
+// between our clock and the service's.
+const rateWindow = 1*time.Minute + 3*time.Second
+
+var (
+	// ErrDailyCapReached answers the requests over the client's daily cap, until midnight in New York.
+	ErrDailyCapReached = errors.New("daily request cap reached")
+	// ErrClosed answers the requests made to a closed client.
+	ErrClosed = errors.New("client closed")
+)
+
+// Clock tells the time and waits for it.  It is the system clock unless replaced with WithClock, e.g. by a fake one in
+// tests.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// limiter spaces requests to stay within the service's limits: rateLimit requests in any rateWindow, and dayCap
+// requests a day.  A limit of 0 or less is no limit.
+type limiter struct {
+	clock     Clock
+	rateLimit int
+	dayCap    int
+
+	mux        sync.Mutex
+	sent       []time.Time // Times of the requests of the last rateWindow, oldest first
+	day        string      // New York date dayCount is for
+	dayCount   int
+	foreground int           // Foreground waits in progress, which background ones give way to
+	idle       chan struct{} // Closed, and replaced, whenever foreground drops to 0
+}
+
+func newLimiter(rateLimit, dayCap int) *limiter {
+	return &limiter{
+		clock:     systemClock{},
+		rateLimit: rateLimit,
+		dayCap:    dayCap,
+		idle:      make(chan struct{}),
+	}
+}
+
+// wait blocks until a request may be sent, and counts it as sent.  It fails at once if the daily cap is reached, and
+// gives up if ctx is done or the pool is closed first.  A background wait, for a refresh, only takes a slot that no
+// foreground wait is after.
+func (l *limiter) wait(ctx context.Context, closed <-chan struct{}, background bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !background {
+		l.startForeground()
+		defer l.endForeground()
+	}
+
+	for {
+		delay, idle, err := l.reserve(background)
+		if err != nil || (delay <= 0 && idle == nil) {
+			return err
+		}
+
+		var timer <-chan time.Time
+		if idle == nil {
+			timer = l.clock.After(delay)
+		}
+		select {
+		case <-timer:
+		case <-idle:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-closed:
+			return ErrClosed
+		}
+	}
+}
+
+func (l *limiter) startForeground() {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	l.foreground++
+}
+
+func (l *limiter) endForeground() {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	l.foreground--
+	if l.foreground == 0 {
+		close(l.idle)
+		l.idle = make(chan struct{})
+	}
+}
+
+// reserve counts a request as sent if the limits allow it now, and otherwise returns how long until they might.  A
+// background request is not counted while a foreground wait is in progress; reserve returns a channel closed once
+// there is none instead.
+func (l *limiter) reserve(background bool) (time.Duration, <-chan struct{}, error) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	now := l.clock.Now()
+	if day := now.In(newYork).Format("2006-01-02"); day != l.day {
+		l.day = day
+		l.dayCount = 0
+	}
+	if l.dayCap > 0 && l.dayCount >= l.dayCap {
+		return 0, nil, ErrDailyCapReached
+	}
+	if background && l.foreground > 0 {
+		return 0, l.idle, nil
+	}
+
+	expired := 0
+	for expired < len(l.sent) && !now.Before(l.sent[expired].Add(rateWindow)) {
+		expired++
+	}
+	l.sent = l.sent[expired:]
+	if l.rateLimit > 0 && len(l.sent) >= l.rateLimit {
+		return l.sent[0].Add(rateWindow).Sub(now), nil, nil
+	}
+
+	l.sent = append(l.sent, now)
+	l.dayCount++
+	return 0, nil, nil
+}
@@ -0,0 +1,41 @@
+package net
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiterBackgroundGivesWay(t *testing.T) {
+	clock := newFakeClock(time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC))
+	l := newLimiter(2, 0)
+	l.clock = clock
+
+	// While a foreground wait is in progress, a background request is not counted even though a slot is free.
+	l.startForeground()
+	delay, idle, err := l.reserve(true)
+	if err != nil || delay != 0 || idle == nil {
+		t.Fatalf("background reserve = %v, %v, %v, want to wait for the foreground", delay, idle, err)
+	}
+	if delay, idle, err = l.reserve(false); err != nil || delay != 0 || idle != nil {
+		t.Fatalf("foreground reserve = %v, %v, %v, want the free slot", delay, idle, err)
+	}
+
+	background := make(chan error)
+	go func() {
+		background <- l.wait(context.Background(), nil, true)
+	}()
+	select {
+	case err = <-background:
+		t.Fatalf("background wait returned %v during a foreground wait", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	l.endForeground()
+	if err = <-background; err != nil {
+		t.Fatalf("background wait error = %v", err)
+	}
+	if len(l.sent) != 2 {
+		t.Errorf("%d requests counted, want 2", len(l.sent))
+	}
+}
@@ -1,74 +1,185 @@
 package net
 
 import (
+	"context"
 	"fmt"
 	"github.com/jay9909/alphavantage/api"
 	"net/http"
 	"sync"
-	"time"
 )
 
 type pool struct {
 	requests       chan query
+	refreshes      chan query    // Low priority requests, only taken when no request is waiting
+	closed         chan struct{} // Closed by close
+	closeOnce      sync.Once
+	workers        sync.WaitGroup
 	workerCount    int
 	maxWorkers     int
 	workerCountMux sync.RWMutex
+
+	limiter *limiter
+	send    func(*http.Request) (*http.Response, error)
 }
 
 type query struct {
-	query  string
-	answer chan api.Response
+	ctx     context.Context
+	request Request
+	url     string
+	answer  chan api.Response // Buffered, so that workers never wait on the sender
+	refresh bool
 }
 
-func newPool(rateLimit int) pool {
-	reqChan := make(chan query)
-	workerCount := 0
+func newPool(rateLimit, dayCap int) pool {
+	// Requests wait on the limiter rather than on workers, but there is no use in more of them than can be sent at once.
 	maxWorkers := rateLimit
+	if maxWorkers < 1 {
+		maxWorkers = 1
+	}
 
 	return pool{
-		requests:       reqChan,
-		workerCount:    workerCount,
-		maxWorkers:     maxWorkers,
-		workerCountMux: sync.RWMutex{},
+		requests:   make(chan query),
+		refreshes:  make(chan query),
+		closed:     make(chan struct{}),
+		maxWorkers: maxWorkers,
+		limiter:    newLimiter(rateLimit, dayCap),
+		send:       http.DefaultClient.Do,
 	}
 }
 
 func (p *pool) doQuery() {
-	request, ok := <-p.requests
-	for ok == true { // Channel is not closed.  Continue
-		fmt.Printf("Sending query: %v\n", request.query)
-		response, err := http.Get(request.query)
-		request.answer <- api.Response{
-			Response: response,
-			Error:    err,
+	defer p.workers.Done()
+
+	request, ok := p.nextQuery()
+	for ok { // The pool is not closed.  Continue
+		request.answer <- p.do(request)
+		request, ok = p.nextQuery()
+	}
+}
+
+// do sends a query once the limiter allows it.  Refreshes wait behind the requests waiting on the limiter, and give
+// the slot they get to any request that found no worker free meanwhile.
+func (p *pool) do(q query) api.Response {
+	for {
+		err := p.limiter.wait(q.ctx, p.closed, q.refresh)
+		if err != nil {
+			return api.Response{Error: err}
 		}
+		if !q.refresh {
+			return p.sendQuery(q)
+		}
+
+		select {
+		case request := <-p.requests:
+			request.answer <- p.sendQuery(request)
+		default:
+			return p.sendQuery(q)
+		}
+	}
+}
+
+// sendQuery sends a query the limiter has let through.
+func (p *pool) sendQuery(q query) api.Response {
+	request, err := http.NewRequestWithContext(q.ctx, http.MethodGet, q.url, nil)
+	if err != nil {
+		return api.Response{Error: fmt.Errorf("could not build request %v: %w", q.request, err)}
+	}
+	response, err := p.send(request)
+	return api.Response{
+		Response: response,
+		Error:    err,
+	}
+}
 
-		time.Sleep(1*time.Minute + 3*time.Second) // Since we're rate-limited
-		request, ok = <-p.requests                // Get the next query.
+// nextQuery waits for the next query, preferring requests over refreshes.  It returns false once the pool is closed.
+func (p *pool) nextQuery() (query, bool) {
+	select {
+	case <-p.closed:
+		return query{}, false
+	default:
+	}
+
+	select {
+	case request := <-p.requests:
+		return request, true
+	default:
+	}
+
+	select {
+	case request := <-p.requests:
+		return request, true
+	case request := <-p.refreshes:
+		return request, true
+	case <-p.closed:
+		return query{}, false
 	}
 }
 
-func (p *pool) sendRequest(apiQuery string) api.Response {
+func (p *pool) addWorker() {
 	p.workerCountMux.Lock()
-	workerCount := p.workerCount
+	defer p.workerCountMux.Unlock()
 
-	if workerCount < p.maxWorkers {
-		fmt.Printf("Adding worker #%v\n", workerCount+1)
+	select {
+	case <-p.closed:
+		return
+	default:
+	}
+
+	if p.workerCount < p.maxWorkers {
+		p.workers.Add(1)
 		go p.doQuery()
 		p.workerCount++
 	}
-	p.workerCountMux.Unlock()
+}
+
+// sendRequest sends request to url, which carries the API key, and waits for the response.  It gives up if ctx is
+// done before the request is sent.
+func (p *pool) sendRequest(ctx context.Context, request Request, url string) api.Response {
+	p.addWorker()
 
-	answerChan := make(chan api.Response)
+	answerChan := make(chan api.Response, 1)
 	query := query{
-		query:  apiQuery,
-		answer: answerChan,
+		ctx:     ctx,
+		request: request,
+		url:     url,
+		answer:  answerChan,
+	}
+	select {
+	case p.requests <- query:
+		return <-answerChan
+	case <-ctx.Done():
+		return api.Response{Error: ctx.Err()}
+	case <-p.closed:
+		return api.Response{Error: ErrClosed}
+	}
+}
+
+// sendRefresh is sendRequest at low priority: a worker only takes it when no request is waiting, and it is only sent
+// when no request is waiting to be.  It returns false if the pool is closed before a worker takes it.
+func (p *pool) sendRefresh(request Request, url string) (api.Response, bool) {
+	p.addWorker()
+
+	answerChan := make(chan api.Response, 1)
+	query := query{
+		ctx:     context.Background(),
+		request: request,
+		url:     url,
+		answer:  answerChan,
+		refresh: true,
+	}
+	select {
+	case p.refreshes <- query:
+		return <-answerChan, true
+	case <-p.closed:
+		return api.Response{}, false
 	}
-	p.requests <- query
-	return <-answerChan
 }
 
+// close stops the workers, and waits for them to answer the requests they are sending.  Requests waiting on the
+// limiter fail with ErrClosed, as do those made later.
 func (p *pool) close() {
-	close(p.requests)
-	p.workerCount = 0
+	p.closeOnce.Do(func() {
+		close(p.closed)
+	})
+	p.workers.Wait()
 }
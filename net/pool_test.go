@@ -0,0 +1,415 @@
+package net
+
+import (
+	"context"
+	"errors"
+	"github.com/jay9909/alphavantage/api"
+	"io"
+	"net/http"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock whose time only moves when told to.
+type fakeClock struct {
+	mux    sync.Mutex
+	now    time.Time
+	timers []fakeTimer // Pending, in no particular order
+}
+
+type fakeTimer struct {
+	at   time.Time
+	fire chan time.Time
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	fire := make(chan time.Time, 1)
+	if d <= 0 {
+		fire <- c.now
+		return fire
+	}
+	c.timers = append(c.timers, fakeTimer{c.now.Add(d), fire})
+	return fire
+}
+
+// Advance moves the time forward by d, firing the timers that are due.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.now = c.now.Add(d)
+	c.fire()
+}
+
+// advanceToNextTimer moves the time forward to the earliest pending timer and fires it.
+func (c *fakeClock) advanceToNextTimer() {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	next := c.now
+	for i, timer := range c.timers {
+		if i == 0 || timer.at.Before(next) {
+			next = timer.at
+		}
+	}
+	if next.After(c.now) {
+		c.now = next
+	}
+	c.fire()
+}
+
+func (c *fakeClock) fire() {
+	pending := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.at.After(c.now) {
+			pending = append(pending, timer)
+		} else {
+			timer.fire <- c.now
+		}
+	}
+	c.timers = pending
+}
+
+func (c *fakeClock) pendingTimers() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	return len(c.timers)
+}
+
+// awaitTimers waits for goroutines to be waiting on count timers of the clock.
+func (c *fakeClock) awaitTimers(t *testing.T, count int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for c.pendingTimers() < count {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d timers pending, want %d", c.pendingTimers(), count)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// stubService answers every request with an empty JSON object, recording when it was sent.
+type stubService struct {
+	clock *fakeClock
+
+	mux  sync.Mutex
+	sent []time.Time
+	urls []string
+}
+
+func (s *stubService) send(request *http.Request) (*http.Response, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.sent = append(s.sent, s.clock.Now())
+	s.urls = append(s.urls, request.URL.String())
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}, nil
+}
+
+func (s *stubService) sentTimes() []time.Time {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return append([]time.Time(nil), s.sent...)
+}
+
+// sentAt returns when the request to url was sent, or the zero time if it was not.
+func (s *stubService) sentAt(url string) time.Time {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for i, sentUrl := range s.urls {
+		if sentUrl == url {
+			return s.sent[i]
+		}
+	}
+	return time.Time{}
+}
+
+func newTestPool(rateLimit, dayCap int, clock *fakeClock) (*pool, *stubService) {
+	service := &stubService{clock: clock}
+	p := newPool(rateLimit, dayCap)
+	p.limiter.clock = clock
+	p.send = service.send
+	return &p, service
+}
+
+var quoteRequest = NewRequest("GLOBAL_QUOTE", map[string]string{"symbol": "IBM"})
+
+const stubUrl = "http://avtest/query?function=GLOBAL_QUOTE&symbol=IBM&apikey=demo"
+
+func TestPoolSpacing(t *testing.T) {
+	start := time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC)
+	clock := newFakeClock(start)
+	p, service := newTestPool(2, 0, clock)
+	defer p.close()
+
+	finished := make(chan struct{})
+	var requests sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		requests.Add(1)
+		go func() {
+			defer requests.Done()
+			response := p.sendRequest(context.Background(), quoteRequest, stubUrl)
+			if response.Error != nil {
+				t.Errorf("request error = %v", response.Error)
+			}
+		}()
+	}
+	go func() {
+		requests.Wait()
+		close(finished)
+	}()
+
+	// Time only passes while every request is waiting on the limiter.
+	for done := false; !done; {
+		select {
+		case <-finished:
+			done = true
+		case <-time.After(time.Millisecond):
+			if clock.pendingTimers() > 0 {
+				clock.advanceToNextTimer()
+			}
+		}
+	}
+
+	sent := service.sentTimes()
+	sort.Slice(sent, func(i, j int) bool { return sent[i].Before(sent[j]) })
+	if len(sent) != 5 {
+		t.Fatalf("sent %d requests, want 5", len(sent))
+	}
+	for i := 2; i < len(sent); i++ {
+		if sent[i].Sub(sent[i-2]) < rateWindow {
+			t.Errorf("requests sent at %v and %v, more than 2 within %v", sent[i-2], sent[i], rateWindow)
+		}
+	}
+	if last := sent[4].Sub(start); last != 2*rateWindow {
+		t.Errorf("last request sent after %v, want %v", last, 2*rateWindow)
+	}
+}
+
+func TestPoolDailyCap(t *testing.T) {
+	// 6 am in New York, where the day starts at 4 or 5 am UTC.
+	clock := newFakeClock(time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC))
+	p, service := newTestPool(100, 3, clock)
+	defer p.close()
+
+	for i := 0; i < 3; i++ {
+		if response := p.sendRequest(context.Background(), quoteRequest, stubUrl); response.Error != nil {
+			t.Fatalf("request %d error = %v", i, response.Error)
+		}
+	}
+	response := p.sendRequest(context.Background(), quoteRequest, stubUrl)
+	if !errors.Is(response.Error, ErrDailyCapReached) {
+		t.Errorf("request over the cap error = %v, want ErrDailyCapReached", response.Error)
+	}
+
+	clock.Advance(19 * time.Hour) // 1 am the next day in New York
+	if response := p.sendRequest(context.Background(), quoteRequest, stubUrl); response.Error != nil {
+		t.Errorf("request the next day error = %v", response.Error)
+	}
+	if len(service.sentTimes()) != 4 {
+		t.Errorf("sent %d requests, want 4", len(service.sentTimes()))
+	}
+}
+
+func TestPoolCancellation(t *testing.T) {
+	clock := newFakeClock(time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC))
+	p, service := newTestPool(1, 0, clock)
+	defer p.close()
+
+	if response := p.sendRequest(context.Background(), quoteRequest, stubUrl); response.Error != nil {
+		t.Fatal(response.Error)
+	}
+
+	// The next request waits on the limiter until it is canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	answer := make(chan error)
+	go func() {
+		answer <- p.sendRequest(ctx, quoteRequest, stubUrl).Error
+	}()
+	clock.awaitTimers(t, 1)
+	cancel()
+	if err := <-answer; !errors.Is(err, context.Canceled) {
+		t.Errorf("canceled request error = %v, want context.Canceled", err)
+	}
+
+	if response := p.sendRequest(ctx, quoteRequest, stubUrl); !errors.Is(response.Error, context.Canceled) {
+		t.Errorf("request with a canceled context error = %v, want context.Canceled", response.Error)
+	}
+
+	// Canceled requests do not count against the limit.
+	clock.Advance(rateWindow)
+	if response := p.sendRequest(context.Background(), quoteRequest, stubUrl); response.Error != nil {
+		t.Errorf("request after the window error = %v", response.Error)
+	}
+	if len(service.sentTimes()) != 2 {
+		t.Errorf("sent %d requests, want 2", len(service.sentTimes()))
+	}
+}
+
+func TestPoolRefreshPriority(t *testing.T) {
+	start := time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC)
+	clock := newFakeClock(start)
+	p, service := newTestPool(2, 0, clock)
+	defer p.close()
+
+	// Fill the window with requests 10s apart, so that one slot frees up at a time.
+	_ = p.sendRequest(context.Background(), quoteRequest, stubUrl+"&first")
+	clock.Advance(10 * time.Second)
+	_ = p.sendRequest(context.Background(), quoteRequest, stubUrl+"&second")
+
+	// A refresh waits on the limiter first, then a request joins it.
+	refreshed := make(chan api.Response)
+	go func() {
+		response, _ := p.sendRefresh(quoteRequest, stubUrl+"&refresh")
+		refreshed <- response
+	}()
+	clock.awaitTimers(t, 1)
+	answered := make(chan api.Response)
+	go func() {
+		answered <- p.sendRequest(context.Background(), quoteRequest, stubUrl+"&request")
+	}()
+	clock.awaitTimers(t, 2)
+
+	clock.advanceToNextTimer()
+	if response := <-answered; response.Error != nil {
+		t.Fatal(response.Error)
+	}
+	clock.awaitTimers(t, 1)
+	clock.advanceToNextTimer()
+	if response := <-refreshed; response.Error != nil {
+		t.Fatal(response.Error)
+	}
+
+	if sent := service.sentAt(stubUrl + "&request"); !sent.Equal(start.Add(rateWindow)) {
+		t.Errorf("request sent at %v, want at the first free slot, %v", sent, start.Add(rateWindow))
+	}
+	if sent := service.sentAt(stubUrl + "&refresh"); !sent.Equal(start.Add(10*time.Second + rateWindow)) {
+		t.Errorf("refresh sent at %v, want after the request", sent)
+	}
+}
+
+func TestPoolRefreshesHoldingWorkers(t *testing.T) {
+	start := time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC)
+	clock := newFakeClock(start)
+	p, service := newTestPool(1, 0, clock)
+	defer p.close()
+
+	_ = p.sendRequest(context.Background(), quoteRequest, stubUrl+"&first")
+
+	// The only worker holds a refresh when a request comes in, so the request waits for a worker rather than on the
+	// limiter.
+	refreshed := make(chan api.Response)
+	go func() {
+		response, _ := p.sendRefresh(quoteRequest, stubUrl+"&refresh")
+		refreshed <- response
+	}()
+	clock.awaitTimers(t, 1)
+	answered := make(chan api.Response)
+	go func() {
+		answered <- p.sendRequest(context.Background(), quoteRequest, stubUrl+"&request")
+	}()
+	time.Sleep(20 * time.Millisecond) // Let the request reach the queue
+
+	// The refresh gives its slot to the request, and waits for the next one.
+	clock.advanceToNextTimer()
+	select {
+	case response := <-answered:
+		if response.Error != nil {
+			t.Fatal(response.Error)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the request was not sent in the first free slot")
+	}
+	clock.awaitTimers(t, 1)
+	clock.advanceToNextTimer()
+	if response := <-refreshed; response.Error != nil {
+		t.Fatal(response.Error)
+	}
+
+	if sent := service.sentAt(stubUrl + "&request"); !sent.Equal(start.Add(rateWindow)) {
+		t.Errorf("request sent at %v, want at the first free slot, %v", sent, start.Add(rateWindow))
+	}
+	if sent := service.sentAt(stubUrl + "&refresh"); !sent.Equal(start.Add(2 * rateWindow)) {
+		t.Errorf("refresh sent at %v, want in the next slot, %v", sent, start.Add(2*rateWindow))
+	}
+}
+
+func TestPoolShutdown(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+
+	clock := newFakeClock(time.Date(2023, 5, 18, 10, 0, 0, 0, time.UTC))
+	p, _ := newTestPool(3, 0, clock)
+
+	// Fill the window, then keep requests waiting on the limiter and on the workers.
+	for i := 0; i < 3; i++ {
+		if response := p.sendRequest(context.Background(), quoteRequest, stubUrl); response.Error != nil {
+			t.Fatal(response.Error)
+		}
+	}
+	answers := make(chan error)
+	for i := 0; i < 10; i++ {
+		go func() {
+			answers <- p.sendRequest(context.Background(), quoteRequest, stubUrl).Error
+		}()
+	}
+	clock.awaitTimers(t, 3)
+	refreshed := make(chan error)
+	go func() {
+		// Every worker is waiting on the limiter, so the refresh is still queued at close.
+		response, sent := p.sendRefresh(quoteRequest, stubUrl)
+		if !sent {
+			response.Error = ErrClosed
+		}
+		refreshed <- response.Error
+	}()
+
+	p.close()
+	for i := 0; i < 10; i++ {
+		if err := <-answers; !errors.Is(err, ErrClosed) {
+			t.Errorf("waiting request error = %v, want ErrClosed", err)
+		}
+	}
+	if err := <-refreshed; !errors.Is(err, ErrClosed) {
+		t.Errorf("waiting refresh error = %v, want ErrClosed", err)
+	}
+
+	if response := p.sendRequest(context.Background(), quoteRequest, stubUrl); !errors.Is(response.Error, ErrClosed) {
+		t.Errorf("request after close error = %v, want ErrClosed", response.Error)
+	}
+	p.close() // Closing twice is harmless
+
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > goroutines {
+		if time.Now().After(deadline) {
+			buffer := make([]byte, 1<<16)
+			t.Fatalf("%d goroutines left running, want %d:\n%s", runtime.NumGoroutine(), goroutines,
+				buffer[:runtime.Stack(buffer, true)])
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,219 @@
+package net
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+)
+
+// RecorderMode is what a Recorder does with each request.
+type RecorderMode int
+
+const (
+	// Replay answers every request from the fixture file, and fails requests it has no recording of.
+	Replay RecorderMode = iota
+	// Record sends every request to the real service and records the response, replacing earlier recordings.
+	Record
+	// ReplayOrRecord replays the requests the fixture file has, and records the others.
+	ReplayOrRecord
+)
+
+// ErrNotRecorded is returned by a replaying Recorder for requests its fixture file has no recording of.
+var ErrNotRecorded = errors.New("request not recorded")
+
+// Recorder is an http.RoundTripper that records Alpha Vantage responses into a fixture file and replays them, so
+// tests can run offline and deterministically.  Give it to a client with WithTransport, and call Save once the test
+// is done to write what was recorded.
+//
+// Requests are matched by function and parameters, whatever their order in the URL.  The API key is never recorded.
+// A request recorded several times is replayed in the same order, the last response repeating.
+type Recorder struct {
+	path      string
+	mode      RecorderMode
+	transport http.RoundTripper
+
+	mux        sync.Mutex
+	recordings map[string][]Recording
+	replayed   map[string]int // Number of responses already replayed per key
+	changed    bool
+}
+
+// Recording is a recorded request and its response, as stored in a fixture file.
+type Recording struct {
+	Function    string            `json:"function"`
+	Params      map[string]string `json:"params,omitempty"`
+	StatusCode  int               `json:"statusCode"`
+	ContentType string            `json:"contentType,omitempty"`
+	Body        string            `json:"body"`
+}
+
+// NewRecorder returns a Recorder using the fixture file at path, which need not exist when recording.  Recorded
+// requests are sent through transport, or http.DefaultTransport if it is nil.
+func NewRecorder(path string, mode RecorderMode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	recorder := &Recorder{
+		path:       path,
+		mode:       mode,
+		transport:  transport,
+		recordings: map[string][]Recording{},
+		replayed:   map[string]int{},
+	}
+
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) && mode != Replay {
+		return recorder, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read fixture file: %w", err)
+	}
+
+	var recordings []Recording
+	err = json.Unmarshal(contents, &recordings)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse fixture file %v: %w", path, err)
+	}
+	for _, recording := range recordings {
+		key := NewRequest(recording.Function, recording.Params).Key()
+		recorder.recordings[key] = append(recorder.recordings[key], recording)
+	}
+
+	return recorder, nil
+}
+
+func (r *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	query := request.URL.Query()
+	params := map[string]string{}
+	for name := range query {
+		params[name] = query.Get(name)
+	}
+	apiRequest := NewRequest(query.Get("function"), params)
+	key := apiRequest.Key()
+
+	if r.mode != Record {
+		recording, found := r.replay(key)
+		if found {
+			return recording.response(request), nil
+		}
+		if r.mode == Replay {
+			return nil, fmt.Errorf("%v: %w", key, ErrNotRecorded)
+		}
+	}
+
+	response, err := r.transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("could not read the response to record: %w", err)
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	params = apiRequest.Params()
+	if len(params) == 0 {
+		params = nil
+	}
+	r.record(key, Recording{
+		Function:    apiRequest.Function(),
+		Params:      params,
+		StatusCode:  response.StatusCode,
+		ContentType: response.Header.Get("Content-Type"),
+		Body:        string(body),
+	})
+
+	return response, nil
+}
+
+func (r *Recorder) replay(key string) (Recording, bool) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	recordings := r.recordings[key]
+	if len(recordings) == 0 {
+		return Recording{}, false
+	}
+
+	index := r.replayed[key]
+	if index >= len(recordings) {
+		index = len(recordings) - 1
+	}
+	r.replayed[key]++
+	return recordings[index], true
+}
+
+func (r *Recorder) record(key string, recording Recording) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	// The first recording of a key in Record mode replaces those of earlier runs.
+	if r.mode == Record && r.replayed[key] == 0 {
+		r.recordings[key] = nil
+	}
+	r.replayed[key]++
+	r.recordings[key] = append(r.recordings[key], recording)
+	r.changed = true
+}
+
+// Save writes the fixture file if anything was recorded.  Recordings are sorted by request, so that fixture files
+// change as little as possible between runs.
+func (r *Recorder) Save() error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if !r.changed {
+		return nil
+	}
+
+	keys := make([]string, 0, len(r.recordings))
+	for key := range r.recordings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	recordings := make([]Recording, 0, len(keys))
+	for _, key := range keys {
+		recordings = append(recordings, r.recordings[key]...)
+	}
+
+	contents, err := json.MarshalIndent(recordings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode recordings: %w", err)
+	}
+	err = os.WriteFile(r.path, append(contents, '\n'), 0644)
+	if err != nil {
+		return fmt.Errorf("could not write fixture file: %w", err)
+	}
+
+	r.changed = false
+	return nil
+}
+
+// response rebuilds the recorded response to request.
+func (recording Recording) response(request *http.Request) *http.Response {
+	header := http.Header{}
+	if recording.ContentType != "" {
+		header.Set("Content-Type", recording.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %v", recording.StatusCode, http.StatusText(recording.StatusCode)),
+		StatusCode:    recording.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(recording.Body))),
+		ContentLength: int64(len(recording.Body)),
+		Request:       request,
+	}
+}
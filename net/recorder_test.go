@@ -0,0 +1,106 @@
+package net
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func TestRecorder(t *testing.T) {
+	fixture := filepath.Join(t.TempDir(), "overview.json")
+
+	service := roundTripFunc(func(request *http.Request) (*http.Response, error) {
+		symbol := request.URL.Query().Get("symbol")
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"Symbol": "` + symbol + `"}`)),
+		}, nil
+	})
+
+	recorder, err := NewRecorder(fixture, Record, service)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := NewClient("SECRETKEY", 5, 500, WithTransport(recorder))
+	response := client.Query("OVERVIEW", map[string]string{"symbol": "IBM", "datatype": "json"})
+	if response.Error != nil {
+		t.Fatalf("recording error = %v", response.Error)
+	}
+	err = recorder.Save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contents, _ := os.ReadFile(fixture)
+	if strings.Contains(string(contents), "SECRETKEY") {
+		t.Errorf("the API key was recorded:\n%s", contents)
+	}
+
+	replayer, err := NewRecorder(fixture, Replay, roundTripFunc(func(*http.Request) (*http.Response, error) {
+		t.Error("a replaying recorder sent a request")
+		return nil, errors.New("unexpected request")
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client = NewClient("OTHERKEY", 5, 500, WithTransport(replayer))
+
+	// The params map is iterated in random order, and the fixture must match whatever the order in the URL.
+	for i := 0; i < 3; i++ {
+		response = client.Query("OVERVIEW", map[string]string{"datatype": "json", "symbol": "IBM"})
+		body, err := response.GetText()
+		if err != nil {
+			t.Fatalf("replay error = %v", err)
+		}
+		if body != `{"Symbol": "IBM"}` {
+			t.Errorf("replayed body = %q", body)
+		}
+	}
+
+	response = client.Query("OVERVIEW", map[string]string{"symbol": "MSFT"})
+	if !errors.Is(response.Error, ErrNotRecorded) {
+		t.Errorf("unrecorded request error = %v, want ErrNotRecorded", response.Error)
+	}
+}
+
+func TestRecorderReplaysInOrder(t *testing.T) {
+	fixture := filepath.Join(t.TempDir(), "quotes.json")
+	err := os.WriteFile(fixture, []byte(`[
+  {"function": "GLOBAL_QUOTE", "params": {"symbol": "IBM"}, "statusCode": 200, "body": "first"},
+  {"function": "GLOBAL_QUOTE", "params": {"symbol": "IBM"}, "statusCode": 200, "body": "second"}
+]`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder, err := NewRecorder(fixture, Replay, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var bodies []string
+	for i := 0; i < 3; i++ {
+		request, _ := http.NewRequest(http.MethodGet, baseUrl+"symbol=IBM&function=GLOBAL_QUOTE&apikey=demo", nil)
+		response, err := recorder.RoundTrip(request)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(response.Body)
+		bodies = append(bodies, string(body))
+	}
+
+	if strings.Join(bodies, ",") != "first,second,second" {
+		t.Errorf("replayed %v, want first, second, then second again", bodies)
+	}
+}
@@ -0,0 +1,89 @@
+package net
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Request is an Alpha Vantage request in canonical form: its function and parameters, without the API key.  Two
+// requests asking for the same thing are equal whatever the order their parameters were given in, so a Request gives
+// the same URL, cache key and log line every time.
+type Request struct {
+	function string
+	params   []param // Sorted by name
+}
+
+type param struct {
+	name  string
+	value string
+}
+
+// NewRequest returns the canonical form of a request for function with params.  Names and values are trimmed of
+// spaces, names are lowercased and the function is uppercased, as the service does not tell them apart.  Empty values
+// are left out, as are the function and apikey params.
+func NewRequest(function string, params map[string]string) Request {
+	request := Request{function: strings.ToUpper(strings.TrimSpace(function))}
+	for name, value := range params {
+		name, value = strings.ToLower(strings.TrimSpace(name)), strings.TrimSpace(value)
+		if value != "" && name != "" && name != "function" && name != "apikey" {
+			request.params = append(request.params, param{name, value})
+		}
+	}
+	sort.Slice(request.params, func(i, j int) bool {
+		return request.params[i].name < request.params[j].name
+	})
+	return request
+}
+
+// Function returns the function of the request, e.g. TIME_SERIES_DAILY.
+func (r Request) Function() string {
+	return r.function
+}
+
+// Params returns a copy of the parameters of the request, other than the function and API key.
+func (r Request) Params() map[string]string {
+	params := make(map[string]string, len(r.params))
+	for _, param := range r.params {
+		params[param.name] = param.value
+	}
+	return params
+}
+
+// Key identifies the request, e.g. SMA?interval=daily&symbol=IBM.  It is what responses are cached and recorded by.
+func (r Request) Key() string {
+	var key strings.Builder
+	key.WriteString(r.function)
+	for i, param := range r.params {
+		if i == 0 {
+			key.WriteByte('?')
+		} else {
+			key.WriteByte('&')
+		}
+		key.WriteString(url.QueryEscape(param.name))
+		key.WriteByte('=')
+		key.WriteString(url.QueryEscape(param.value))
+	}
+	return key.String()
+}
+
+// URL returns the URL sending the request to the service at baseURL, the address of its query endpoint, with the
+// given API key.
+func (r Request) URL(baseURL, apiKey string) string {
+	return strings.TrimSuffix(baseURL, "?") + "?" + r.String() + "&apikey=" + url.QueryEscape(apiKey)
+}
+
+// String returns the query string of the request without the API key, e.g. function=SMA&interval=daily&symbol=IBM,
+// which is safe to log.
+func (r Request) String() string {
+	var query strings.Builder
+	query.WriteString("function=")
+	query.WriteString(url.QueryEscape(r.function))
+	for _, param := range r.params {
+		query.WriteByte('&')
+		query.WriteString(url.QueryEscape(param.name))
+		query.WriteByte('=')
+		query.WriteString(url.QueryEscape(param.value))
+	}
+	return query.String()
+}
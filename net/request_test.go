@@ -0,0 +1,48 @@
+package net
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRequest(t *testing.T) {
+	first := NewRequest("SMA", map[string]string{"symbol": "IBM", "interval": "daily", "datatype": "", "apikey": "x"})
+	second := NewRequest("sma ", map[string]string{"Interval": "daily", " symbol": "IBM "})
+
+	if first.Key() != second.Key() {
+		t.Errorf("keys of the same request differ: %q and %q", first.Key(), second.Key())
+	}
+	if want := "SMA?interval=daily&symbol=IBM"; first.Key() != want {
+		t.Errorf("Key() = %q, want %q", first.Key(), want)
+	}
+	if want := "function=SMA&interval=daily&symbol=IBM"; first.String() != want {
+		t.Errorf("String() = %q, want %q", first.String(), want)
+	}
+
+	want := "https://www.alphavantage.co/query?function=SMA&interval=daily&symbol=IBM&apikey=demo"
+	for i := 0; i < 10; i++ {
+		// The params map is iterated in random order, which must not show in the URL.
+		request := NewRequest("SMA", map[string]string{"symbol": "IBM", "interval": "daily"})
+		if url := request.URL(baseUrl, "demo"); url != want {
+			t.Fatalf("URL() = %q, want %q", url, want)
+		}
+	}
+	local := "http://127.0.0.1:8080/query"
+	if url := first.URL(local, "demo"); url != local+"?"+first.String()+"&apikey=demo" {
+		t.Errorf("URL() with a base URL without ? = %q", url)
+	}
+}
+
+func TestRequestEscaping(t *testing.T) {
+	request := NewRequest("NEWS_SENTIMENT", map[string]string{"tickers": "COIN,CRYPTO:BTC", "topics": "earnings & ipo"})
+
+	if want := "NEWS_SENTIMENT?tickers=COIN%2CCRYPTO%3ABTC&topics=earnings+%26+ipo"; request.Key() != want {
+		t.Errorf("Key() = %q, want %q", request.Key(), want)
+	}
+	if params := request.Params(); params["topics"] != "earnings & ipo" || len(params) != 2 {
+		t.Errorf("Params() = %v", params)
+	}
+	if url := request.URL(baseUrl, "a&b"); !strings.HasSuffix(url, "&apikey=a%26b") {
+		t.Errorf("URL() did not escape the API key: %q", url)
+	}
+}
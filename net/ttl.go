@@ -0,0 +1,157 @@
+package net
+
+import (
+	"strings"
+	"time"
+)
+
+// TTLPolicy decides how long a response stays fresh in the cache.  It returns the time a response to function with
+// params, fetched at fetchedAt, expires.
+type TTLPolicy func(function string, params map[string]string, fetchedAt time.Time) time.Time
+
+// Default time to live of each kind of data.  Daily and longer series are instead fresh until the data of the next
+// market close is published.
+const (
+	QuoteTTL        = 1 * time.Minute
+	IntradayTTL     = 1 * time.Minute
+	FundamentalsTTL = 24 * time.Hour
+	MacroTTL        = 7 * 24 * time.Hour
+	UnknownTTL      = 1 * time.Minute // Functions DefaultTTL does not know about
+)
+
+// dataKind is how often the data of a function changes.
+type dataKind int
+
+const (
+	unknownData dataKind = iota
+	quoteData
+	intradayData
+	endOfDayData
+	fundamentalsData
+	macroData
+)
+
+var functionKinds = map[string]dataKind{
+	"GLOBAL_QUOTE":           quoteData,
+	"CURRENCY_EXCHANGE_RATE": quoteData,
+	"MARKET_STATUS":          quoteData,
+	"NEWS_SENTIMENT":         quoteData,
+
+	"TIME_SERIES_INTRADAY":          intradayData,
+	"TIME_SERIES_INTRADAY_EXTENDED": intradayData,
+	"FX_INTRADAY":                   intradayData,
+	"CRYPTO_INTRADAY":               intradayData,
+
+	"TIME_SERIES_DAILY":            endOfDayData,
+	"TIME_SERIES_DAILY_ADJUSTED":   endOfDayData,
+	"TIME_SERIES_WEEKLY":           endOfDayData,
+	"TIME_SERIES_WEEKLY_ADJUSTED":  endOfDayData,
+	"TIME_SERIES_MONTHLY":          endOfDayData,
+	"TIME_SERIES_MONTHLY_ADJUSTED": endOfDayData,
+	"FX_DAILY":                     endOfDayData,
+	"FX_WEEKLY":                    endOfDayData,
+	"FX_MONTHLY":                   endOfDayData,
+	"DIGITAL_CURRENCY_DAILY":       endOfDayData,
+	"DIGITAL_CURRENCY_WEEKLY":      endOfDayData,
+	"DIGITAL_CURRENCY_MONTHLY":     endOfDayData,
+
+	"OVERVIEW":          fundamentalsData,
+	"INCOME_STATEMENT":  fundamentalsData,
+	"BALANCE_SHEET":     fundamentalsData,
+	"CASH_FLOW":         fundamentalsData,
+	"EARNINGS":          fundamentalsData,
+	"LISTING_STATUS":    fundamentalsData,
+	"EARNINGS_CALENDAR": fundamentalsData,
+	"IPO_CALENDAR":      fundamentalsData,
+	"SYMBOL_SEARCH":     fundamentalsData,
+
+	// Commodity prices are published daily at best.
+	"WTI":             fundamentalsData,
+	"BRENT":           fundamentalsData,
+	"NATURAL_GAS":     fundamentalsData,
+	"COPPER":          fundamentalsData,
+	"ALUMINUM":        fundamentalsData,
+	"WHEAT":           fundamentalsData,
+	"CORN":            fundamentalsData,
+	"COTTON":          fundamentalsData,
+	"SUGAR":           fundamentalsData,
+	"COFFEE":          fundamentalsData,
+	"ALL_COMMODITIES": fundamentalsData,
+
+	"REAL_GDP":            macroData,
+	"REAL_GDP_PER_CAPITA": macroData,
+	"TREASURY_YIELD":      macroData,
+	"FEDERAL_FUNDS_RATE":  macroData,
+	"CPI":                 macroData,
+	"INFLATION":           macroData,
+	"RETAIL_SALES":        macroData,
+	"DURABLES":            macroData,
+	"UNEMPLOYMENT":        macroData,
+	"NONFARM_PAYROLL":     macroData,
+}
+
+// DefaultTTL is the TTLPolicy used unless the client is given another one.  Quotes and intraday series expire after
+// a minute, daily and longer series at the next market close, fundamentals after a day and economic indicators after
+// a week.  Technical indicators follow their interval parameter: intraday intervals expire after a minute and the
+// others at the next market close.
+func DefaultTTL(function string, params map[string]string, fetchedAt time.Time) time.Time {
+	kind, isKnown := functionKinds[function]
+	if !isKnown && params["interval"] != "" {
+		// Every technical indicator takes an interval, and no other unknown function does.
+		kind = endOfDayData
+		if strings.HasSuffix(params["interval"], "min") {
+			kind = intradayData
+		}
+	}
+
+	switch kind {
+	case quoteData:
+		return fetchedAt.Add(QuoteTTL)
+	case intradayData:
+		return fetchedAt.Add(IntradayTTL)
+	case endOfDayData:
+		return nextMarketClose(fetchedAt)
+	case fundamentalsData:
+		return fetchedAt.Add(FundamentalsTTL)
+	case macroData:
+		return fetchedAt.Add(MacroTTL)
+	default:
+		return fetchedAt.Add(UnknownTTL)
+	}
+}
+
+// FixedTTL returns a TTLPolicy that keeps every response fresh for ttl.
+func FixedTTL(ttl time.Duration) TTLPolicy {
+	return func(function string, params map[string]string, fetchedAt time.Time) time.Time {
+		return fetchedAt.Add(ttl)
+	}
+}
+
+// endOfDayHour and endOfDayMinute are when the data of a trading day is available, a little after the 16:00 close
+// in New York.
+const (
+	endOfDayHour   = 16
+	endOfDayMinute = 30
+)
+
+var newYork = loadNewYork()
+
+func loadNewYork() *time.Location {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		// No time zone database.  Eastern Standard Time is off by an hour in summer, which only makes the cache
+		// expire an hour later.
+		return time.FixedZone("EST", -5*60*60)
+	}
+	return location
+}
+
+// nextMarketClose returns the first time after t that the data of a weekday's close is published.
+func nextMarketClose(t time.Time) time.Time {
+	local := t.In(newYork)
+	published := time.Date(local.Year(), local.Month(), local.Day(), endOfDayHour, endOfDayMinute, 0, 0, newYork)
+	for !published.After(t) || published.Weekday() == time.Saturday || published.Weekday() == time.Sunday {
+		published = published.AddDate(0, 0, 1)
+	}
+	return published
+}
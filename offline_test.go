@@ -0,0 +1,62 @@
+package alphavantage
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/jay9909/alphavantage/net"
+)
+
+func TestOfflineGet(t *testing.T) {
+	fetchedAt := time.Date(2023, 5, 19, 16, 0, 0, 0, time.UTC)
+	quote := `{"Global Quote": {"01. symbol": "IBM", "05. price": "127.2600", "07. latest trading day": "2023-05-19"}}`
+	cache := net.NewMemoryCache(0, 0)
+	cache.Put(net.NewRequest("GLOBAL_QUOTE", map[string]string{"symbol": "IBM", "datatype": "json"}).Key(),
+		net.CacheEntry{Body: []byte(quote), ContentType: "application/json", Datatype: "json", FetchedAt: fetchedAt})
+	cache.Put(net.NewRequest("OVERVIEW", map[string]string{"symbol": "IBM"}).Key(),
+		net.CacheEntry{Body: []byte(`{"Symbol": "IBM"}`), Datatype: "json", FetchedAt: fetchedAt})
+
+	transport := &stubTransport{}
+	av := New("demo", 0, 0, net.WithCache(cache), net.Offline(), net.WithTransport(transport))
+	defer av.Close()
+
+	// The generated method and the hand-written one over it are answered from the cache, however old the entry.
+	response := av.GetOverview("IBM")
+	if response.Error != nil {
+		t.Fatalf("GetOverview error = %v", response.Error)
+	}
+	body, _ := io.ReadAll(response.Response.Body)
+	if string(body) != `{"Symbol": "IBM"}` {
+		t.Errorf("GetOverview body = %s", body)
+	}
+
+	globalQuote, err := av.GlobalQuote("IBM")
+	if err != nil || globalQuote.Symbol != "IBM" || globalQuote.Price != 127.26 {
+		t.Errorf("GlobalQuote = %+v, %v", globalQuote, err)
+	}
+
+	// A typed call of the same request finds the same entry.
+	response = av.QueryGlobalQuote(GlobalQuoteParams{Symbol: "IBM", Datatype: DataJSON})
+	if response.Error != nil {
+		t.Errorf("QueryGlobalQuote error = %v", response.Error)
+	} else {
+		_ = response.Response.Body.Close()
+	}
+
+	response = av.GetOverview("MSFT")
+	if !errors.Is(response.Error, net.ErrNotCached) {
+		t.Errorf("uncached GetOverview error = %v, want net.ErrNotCached", response.Error)
+	}
+
+	// Validation still happens first.
+	response = av.GetGlobalQuote("IBM", "xml")
+	if response.Error == nil || errors.Is(response.Error, net.ErrNotCached) {
+		t.Errorf("invalid GetGlobalQuote error = %v, want a validation error", response.Error)
+	}
+
+	if sent := transport.sent(); len(sent) != 0 {
+		t.Errorf("an offline client sent %q", sent)
+	}
+}
@@ -0,0 +1,45 @@
+package alphavantage
+
+import (
+	"strconv"
+	"time"
+)
+
+// The format helpers below convert typed parameter fields into query values.  Zero values become "", which
+// Client.Query leaves out of the request so that the service applies its default.
+
+func formatInt(value int) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.Itoa(value)
+}
+
+// formatRequiredInt formats a required integer, which has no default to fall back on.  It keeps 0, so that
+// validation reports the value as out of range rather than the parameter as missing.
+func formatRequiredInt(value int) string {
+	return strconv.Itoa(value)
+}
+
+func formatFloat(value float64) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// formatDate formats a calendar date as YYYY-MM-DD.
+func formatDate(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.Format("2006-01-02")
+}
+
+// formatDateTime formats a timestamp as YYYYMMDDTHHMM.
+func formatDateTime(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+	return value.Format("20060102T1504")
+}
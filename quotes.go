@@ -0,0 +1,21 @@
+package alphavantage
+
+import "github.com/jay9909/alphavantage/api"
+
+// GlobalQuote returns the typed result of GetGlobalQuote.
+func (av *Alphavantage) GlobalQuote(symbol string) (api.GlobalQuote, error) {
+	response := av.GetGlobalQuote(symbol, "json")
+	return response.GetGlobalQuote()
+}
+
+// SymbolSearch returns the typed best matches of GetSymbolSearch.
+func (av *Alphavantage) SymbolSearch(keywords string) ([]api.SymbolMatch, error) {
+	response := av.GetSymbolSearch(keywords, "json")
+	return response.GetSymbolMatches()
+}
+
+// MarketStatus returns the typed result of GetMarketStatus.
+func (av *Alphavantage) MarketStatus() (api.MarketStatus, error) {
+	response := av.GetMarketStatus()
+	return response.GetMarketStatus()
+}
@@ -0,0 +1,210 @@
+package alphavantage
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jay9909/alphavantage/api"
+)
+
+// paramRule checks a single non-empty parameter value.  It returns a description of the accepted values when the
+// value is rejected, or "" when it is acceptable.
+type paramRule func(value string) string
+
+func oneOf(allowed ...string) paramRule {
+	return func(value string) string {
+		for _, candidate := range allowed {
+			if value == candidate {
+				return ""
+			}
+		}
+		return "must be one of " + strings.Join(allowed, ", ")
+	}
+}
+
+func intBetween(min, max int) paramRule {
+	return func(value string) string {
+		number, err := strconv.Atoi(value)
+		if err != nil || number < min || number > max {
+			if max == maxInt {
+				return fmt.Sprintf("must be an integer of at least %d", min)
+			}
+			return fmt.Sprintf("must be an integer from %d to %d", min, max)
+		}
+		return ""
+	}
+}
+
+func positiveFloat(value string) string {
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number <= 0 {
+		return "must be a positive number"
+	}
+	return ""
+}
+
+func timeLayout(layout, readable string, earliest time.Time) paramRule {
+	return func(value string) string {
+		parsed, err := time.Parse(layout, value)
+		if err != nil {
+			return "must be formatted as " + readable
+		}
+		if parsed.Before(earliest) {
+			return "must not be earlier than " + earliest.Format(layout)
+		}
+		return ""
+	}
+}
+
+// listOf applies rule to each item of a comma-separated list.
+func listOf(rule paramRule) paramRule {
+	return func(value string) string {
+		for _, item := range strings.Split(value, ",") {
+			if reason := rule(item); reason != "" {
+				return fmt.Sprintf("has item %q, which %v", item, reason)
+			}
+		}
+		return ""
+	}
+}
+
+const maxInt = int(^uint(0) >> 1)
+
+var (
+	intradayIntervals  = oneOf("1min", "5min", "15min", "30min", "60min")
+	indicatorIntervals = oneOf("1min", "5min", "15min", "30min", "60min", "daily", "weekly", "monthly")
+	dailyIntervals     = oneOf("daily", "weekly", "monthly")
+	monthlyIntervals   = oneOf("monthly", "quarterly", "annual")
+)
+
+// intradaySlices accepts the values of TIME_SERIES_INTRADAY_EXTENDED's slice parameter: year1month1 through
+// year2month12.
+func intradaySlices(value string) string {
+	var year, month int
+	_, err := fmt.Sscanf(value, "year%dmonth%d", &year, &month)
+	if err != nil || value != fmt.Sprintf("year%dmonth%d", year, month) ||
+		year < 1 || year > 2 || month < 1 || month > 12 {
+		return "must be one of year1month1 through year2month12"
+	}
+	return ""
+}
+
+// paramRules is the curated table of checks applied to a parameter wherever it appears.  Parameters that are not
+// listed, such as symbol, are only checked for presence when required.
+var paramRules = map[string]paramRule{
+	"interval":    indicatorIntervals,
+	"series_type": oneOf("close", "open", "high", "low"),
+	"outputsize":  oneOf("compact", "full"),
+	"datatype":    oneOf("json", "csv"),
+	"adjusted":    oneOf("true", "false"),
+	"slice":       intradaySlices,
+	"state":       oneOf("active", "delisted"),
+	"horizon":     oneOf("3month", "6month", "12month"),
+	"maturity":    oneOf("3month", "2year", "5year", "7year", "10year", "30year"),
+	"sort":        oneOf("LATEST", "EARLIEST", "RELEVANCE"),
+	"limit":       intBetween(1, 1000),
+
+	"matype":       intBetween(0, 8),
+	"fastmatype":   intBetween(0, 8),
+	"slowmatype":   intBetween(0, 8),
+	"signalmatype": intBetween(0, 8),
+	"slowkmatype":  intBetween(0, 8),
+	"slowdmatype":  intBetween(0, 8),
+	"fastdmatype":  intBetween(0, 8),
+
+	"time_period":  intBetween(1, maxInt),
+	"fastperiod":   intBetween(1, maxInt),
+	"slowperiod":   intBetween(1, maxInt),
+	"signalperiod": intBetween(1, maxInt),
+	"fastkperiod":  intBetween(1, maxInt),
+	"slowkperiod":  intBetween(1, maxInt),
+	"slowdperiod":  intBetween(1, maxInt),
+	"fastdperiod":  intBetween(1, maxInt),
+	"timeperiod1":  intBetween(1, maxInt),
+	"timeperiod2":  intBetween(1, maxInt),
+	"timeperiod3":  intBetween(1, maxInt),
+
+	"fastlimit":    positiveFloat,
+	"slowlimit":    positiveFloat,
+	"nbdevup":      intBetween(1, maxInt),
+	"nbdevdn":      intBetween(1, maxInt),
+	"acceleration": positiveFloat,
+	"maximum":      positiveFloat,
+
+	"date":      timeLayout("2006-01-02", "YYYY-MM-DD", time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)),
+	"time_from": timeLayout("20060102T1504", "YYYYMMDDTHHMM", time.Time{}),
+	"time_to":   timeLayout("20060102T1504", "YYYYMMDDTHHMM", time.Time{}),
+}
+
+// functionRules overrides paramRules for the endpoints whose parameter accepts a narrower set of values.
+var functionRules = map[string]map[string]paramRule{
+	"TIME_SERIES_INTRADAY":          {"interval": intradayIntervals},
+	"TIME_SERIES_INTRADAY_EXTENDED": {"interval": intradayIntervals},
+	"CRYPTO_INTRADAY":               {"interval": intradayIntervals},
+	"FX_INTRADAY":                   {"interval": intradayIntervals},
+	"VWAP":                          {"interval": intradayIntervals},
+
+	"WTI":                {"interval": dailyIntervals},
+	"BRENT":              {"interval": dailyIntervals},
+	"NATURAL_GAS":        {"interval": dailyIntervals},
+	"TREASURY_YIELD":     {"interval": dailyIntervals},
+	"FEDERAL_FUNDS_RATE": {"interval": dailyIntervals},
+
+	"COPPER":          {"interval": monthlyIntervals},
+	"ALUMINUM":        {"interval": monthlyIntervals},
+	"WHEAT":           {"interval": monthlyIntervals},
+	"CORN":            {"interval": monthlyIntervals},
+	"COTTON":          {"interval": monthlyIntervals},
+	"SUGAR":           {"interval": monthlyIntervals},
+	"COFFEE":          {"interval": monthlyIntervals},
+	"ALL_COMMODITIES": {"interval": monthlyIntervals},
+
+	"REAL_GDP": {"interval": oneOf("quarterly", "annual")},
+	"CPI":      {"interval": oneOf("monthly", "semiannual")},
+}
+
+// validate checks the parameters of a request against requiredParams and the rules tables.  A value has to pass
+// both the rule generated from the documentation, if any, and the curated one.  Empty values are treated as absent,
+// matching Client.Query, which leaves them out of the request.
+func validate(function string, params map[string]string) error {
+	for _, name := range requiredParams[function] {
+		if params[name] == "" {
+			return &api.ValidationError{Function: function, Param: name, Reason: "is required"}
+		}
+	}
+
+	// Check in name order so that a request with several bad parameters always reports the same one.
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := params[name]
+		if value == "" {
+			continue
+		}
+
+		var rules []paramRule
+		if rule, ok := documentedRules[function][name]; ok {
+			rules = append(rules, rule)
+		}
+		if rule, ok := functionRules[function][name]; ok {
+			rules = append(rules, rule)
+		} else if rule, ok := paramRules[name]; ok {
+			rules = append(rules, rule)
+		}
+
+		for _, rule := range rules {
+			if reason := rule(value); reason != "" {
+				return &api.ValidationError{Function: function, Param: name, Value: value, Reason: reason}
+			}
+		}
+	}
+
+	return nil
+}
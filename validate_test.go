@@ -0,0 +1,118 @@
+package alphavantage
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jay9909/alphavantage/api"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		function string
+		params   map[string]string
+		want     *api.ValidationError // nil for a valid request
+	}{
+		{"valid", "SMA", map[string]string{"symbol": "IBM", "interval": "daily", "time_period": "20",
+			"series_type": "close", "datatype": ""}, nil},
+		{"unknown function", "NOT_A_FUNCTION", map[string]string{"symbol": "IBM"}, nil},
+
+		{"missing required", "SMA", map[string]string{"symbol": "IBM", "interval": "daily", "series_type": "close"},
+			&api.ValidationError{Param: "time_period", Reason: "is required"}},
+		{"empty required", "GLOBAL_QUOTE", map[string]string{"symbol": "", "datatype": "csv"},
+			&api.ValidationError{Param: "symbol", Reason: "is required"}},
+
+		{"enum", "SMA", map[string]string{"symbol": "IBM", "interval": "hourly", "time_period": "20",
+			"series_type": "close"}, &api.ValidationError{Param: "interval", Value: "hourly",
+			Reason: "must be one of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly"}},
+		{"narrower enum for the function", "TIME_SERIES_INTRADAY", map[string]string{"symbol": "IBM",
+			"interval": "daily"}, &api.ValidationError{Param: "interval", Value: "daily",
+			Reason: "must be one of 1min, 5min, 15min, 30min, 60min"}},
+		{"enum list", "NEWS_SENTIMENT", map[string]string{"topics": "ipo,weather"}, &api.ValidationError{
+			Param: "topics", Value: "ipo,weather", Reason: `has item "weather", which must be one of blockchain, ` +
+				"earnings, ipo, mergers_and_acquisitions, financial_markets, economy_fiscal, economy_monetary, " +
+				"economy_macro, energy_transportation, finance, life_sciences, manufacturing, real_estate, " +
+				"retail_wholesale, technology"}},
+
+		{"below range", "SMA", map[string]string{"symbol": "IBM", "interval": "daily", "time_period": "0",
+			"series_type": "close"}, &api.ValidationError{Param: "time_period", Value: "0",
+			Reason: "must be an integer of at least 1"}},
+		{"above range", "NEWS_SENTIMENT", map[string]string{"limit": "1001"}, &api.ValidationError{
+			Param: "limit", Value: "1001", Reason: "must be an integer from 1 to 1000"}},
+		{"not a number", "SAR", map[string]string{"symbol": "IBM", "interval": "daily", "acceleration": "fast"},
+			&api.ValidationError{Param: "acceleration", Value: "fast", Reason: "must be a positive number"}},
+
+		{"date format", "LISTING_STATUS", map[string]string{"date": "2023-5-19"}, &api.ValidationError{
+			Param: "date", Value: "2023-5-19", Reason: "must be formatted as YYYY-MM-DD"}},
+		{"date too early", "LISTING_STATUS", map[string]string{"date": "2009-12-31"}, &api.ValidationError{
+			Param: "date", Value: "2009-12-31", Reason: "must not be earlier than 2010-01-01"}},
+		{"date time format", "NEWS_SENTIMENT", map[string]string{"time_from": "2023-05-19"},
+			&api.ValidationError{Param: "time_from", Value: "2023-05-19", Reason: "must be formatted as YYYYMMDDTHHMM"}},
+		{"slice", "TIME_SERIES_INTRADAY_EXTENDED", map[string]string{"symbol": "IBM", "interval": "5min",
+			"slice": "year3month1"}, &api.ValidationError{Param: "slice", Value: "year3month1",
+			Reason: "must be one of year1month1 through year2month12"}},
+
+		// Several bad parameters report the first by name.
+		{"first bad parameter", "SMA", map[string]string{"symbol": "IBM", "interval": "hourly", "time_period": "-1",
+			"series_type": "median"}, &api.ValidationError{Param: "interval", Value: "hourly",
+			Reason: "must be one of 1min, 5min, 15min, 30min, 60min, daily, weekly, monthly"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validate(test.function, test.params)
+			if test.want == nil {
+				if err != nil {
+					t.Errorf("validate() = %v, want nil", err)
+				}
+				return
+			}
+
+			var validationErr *api.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("validate() = %v, want a *api.ValidationError", err)
+			}
+			test.want.Function = test.function
+			if *validationErr != *test.want {
+				t.Errorf("validate() = %+v, want %+v", *validationErr, *test.want)
+			}
+		})
+	}
+}
+
+func TestQueryValidation(t *testing.T) {
+	av, transport := newStubClient(t)
+
+	responses := map[string]api.Response{
+		"Get":   av.GetSma("IBM", "daily", "", "close", ""),
+		"Query": av.QuerySma(SmaParams{Symbol: "IBM", Interval: IntervalDaily, SeriesType: SeriesClose}),
+		"date": av.QueryNewsSentiment(NewsSentimentParams{
+			TimeFrom: time.Date(2023, 5, 19, 9, 30, 0, 0, time.UTC),
+			Limit:    5000,
+		}),
+	}
+	wantParams := map[string]string{"Get": "time_period", "Query": "time_period", "date": "limit"}
+
+	for name, response := range responses {
+		var validationErr *api.ValidationError
+		if !errors.As(response.Error, &validationErr) || validationErr.Param != wantParams[name] {
+			t.Errorf("%s error = %v, want a *api.ValidationError for %v", name, response.Error, wantParams[name])
+		}
+		if response.Response != nil {
+			t.Errorf("%s has a response although the request was invalid", name)
+		}
+	}
+
+	// A required integer left at zero is out of range, not missing.
+	var validationErr *api.ValidationError
+	if response := responses["Query"]; !errors.As(response.Error, &validationErr) || validationErr.Value != "0" ||
+		validationErr.Reason != "must be an integer of at least 1" {
+		t.Errorf("zero time_period error = %v, want it out of range", response.Error)
+	}
+
+	if sent := transport.sent(); len(sent) != 0 {
+		t.Errorf("sent %q, want invalid requests not to be sent", sent)
+	}
+}